@@ -0,0 +1,604 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/params"
+)
+
+// Node settings are read, from lowest to highest precedence, from the
+// defaults, the config file, SHELLCOIN_* environment variables and the
+// command line.  Every flag can be set in each of them: the key of a flag in
+// the config file is its name, its environment variable is its name in upper
+// case with "-" and "." replaced by "_", e.g. SHELLCOIN_WEB_INTERFACE_PORT.
+//
+// Besides the flags of Config, the knobs of the subsystems are flags named
+// "section.name", e.g. -visor.unconfirmed-max-age.  In the config file they
+// can also be nested in an object per section:
+//
+//	{
+//	    "port": 7100,
+//	    "visor": {
+//	        "unconfirmed-max-age": "24h"
+//	    }
+//	}
+
+const envPrefix = "SHELLCOIN_"
+
+var (
+	configFileName = coinName + ".json"
+	// Path of the config file, defaults to configFileName in the data directory
+	configFile string
+	// Print the effective config and exit
+	printConfig = false
+)
+
+// configSection is a subsystem config exposed as settings
+type configSection struct {
+	name string
+	get  func(dc *daemon.Config) interface{}
+}
+
+// configSections are the subsystem configs whose fields are settings.
+// Fields of nested structs are not settings, unless listed as their own
+// section
+var configSections = []configSection{
+	{"daemon", func(dc *daemon.Config) interface{} { return &dc.Daemon }},
+	{"pool", func(dc *daemon.Config) interface{} { return &dc.Pool }},
+	{"gnet", func(dc *daemon.Config) interface{} { return &dc.Pool.Gnet }},
+	{"peers", func(dc *daemon.Config) interface{} { return &dc.Peers }},
+	{"gateway", func(dc *daemon.Config) interface{} { return &dc.Gateway }},
+	{"visor", func(dc *daemon.Config) interface{} { return &dc.Visor }},
+	{"visor", func(dc *daemon.Config) interface{} { return &dc.Visor.Config }},
+}
+
+// derivedSettings are the subsystem fields set by configureDaemon from a
+// flag, mapped to that flag
+var derivedSettings = map[string]string{
+	"daemon.address":                      "address",
+	"daemon.port":                         "port",
+	"daemon.data-directory":               "data-dir",
+	"daemon.outgoing-rate":                "connection-rate",
+	"daemon.disable-networking":           "disable-networking",
+	"daemon.disable-outgoing-connections": "disable-outgoing",
+	"daemon.disable-incoming-connections": "disable-incoming",
+	"daemon.localhost-only":               "localhost-only",
+	"daemon.log-pings":                    "no-ping-log",
+	"daemon.disable-compression":          "disable-compression",
+	"daemon.shutdown-timeout":             "shutdown-timeout",
+	"gnet.address":                        "address",
+	"gnet.port":                           "port",
+	"gnet.dial-timeout":                   "pool.dial-timeout",
+	"peers.data-directory":                "data-dir",
+	"peers.disabled":                      "disable-pex",
+	"peers.sources":                       "peer-sources",
+	"peers.seed-file":                     "seed-file",
+	"peers.dns-seeds":                     "dns-seeds",
+	"peers.dns-seed-port":                 "port",
+	"visor.shutdown-timeout":              "shutdown-timeout",
+	"visor.parser-stop-timeout":           "shutdown-timeout",
+	"visor.is-master":                     "master",
+	"visor.genesis-timestamp":             "genesis-timestamp",
+	"visor.arbitrating":                   "arbitrating",
+	"visor.wallet-directory":              "wallet-dir",
+}
+
+// settingsFlags are the flags that are not settings
+var settingsFlags = map[string]bool{
+	"help":         true,
+	"config":       true,
+	"print-config": true,
+}
+
+// secretSettings are the settings that are not printed by -print-config
+var secretSettings = map[string]bool{
+	"api-keys": true,
+}
+
+// zeroSettings are the numeric settings that can be 0.  The other numeric
+// settings are intervals, timeouts, sizes and counts, which must be greater
+// than 0.
+var zeroSettings = map[string]bool{
+	"gnet.compression-min-size":     true,
+	"visor.genesis-coin-volume":     true,
+	"visor.txns-announce-max-delay": true,
+}
+
+// subsystemSetting is a field of a configSection
+type subsystemSetting struct {
+	key   string
+	usage string
+	value reflect.Value
+}
+
+// subsystemSettings returns the settings of dc, sorted by key
+func subsystemSettings(dc *daemon.Config) []subsystemSetting {
+	var settings []subsystemSetting
+	for _, s := range configSections {
+		v := reflect.ValueOf(s.get(dc)).Elem()
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" || !isSettingType(f.Type) {
+				continue
+			}
+
+			key := s.name + "." + kebabCase(f.Name)
+			if _, ok := derivedSettings[key]; ok {
+				continue
+			}
+
+			settings = append(settings, subsystemSetting{
+				key:   key,
+				usage: fmt.Sprintf("sets %s.%s", t, f.Name),
+				value: v.Field(i),
+			})
+		}
+	}
+
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].key < settings[j].key
+	})
+
+	return settings
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func isSettingType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.String
+	default:
+		return false
+	}
+}
+
+// kebabCase converts a field name to a setting name, e.g. IPCountsMax to
+// ip-counts-max
+func kebabCase(name string) string {
+	runes := []rune(name)
+	var b bytes.Buffer
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteByte('-')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// setValue parses s into v
+func setValue(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		v.Set(reflect.ValueOf(splitList(s)).Convert(v.Type()))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// formatValue returns v as written to the config file
+func formatValue(v reflect.Value) interface{} {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String()
+	}
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		return []string{}
+	}
+	return v.Interface()
+}
+
+// settingValue is the flag.Value of a subsystem setting.  The parsed values
+// are kept until they are applied to the daemon.Config by configureDaemon.
+type settingValue struct {
+	key    string
+	value  reflect.Value
+	values map[string]string
+}
+
+func (sv *settingValue) String() string {
+	if sv.value.IsValid() {
+		if s, ok := sv.values[sv.key]; ok {
+			return s
+		}
+		return fmt.Sprint(formatValue(sv.value))
+	}
+	return ""
+}
+
+func (sv *settingValue) Set(s string) error {
+	v := reflect.New(sv.value.Type()).Elem()
+	if err := setValue(v, s); err != nil {
+		return err
+	}
+	if err := checkSetting(sv.key, v); err != nil {
+		return err
+	}
+	sv.values[sv.key] = s
+	return nil
+}
+
+// checkSetting checks the range of the value v of the setting key
+func checkSetting(key string, v reflect.Value) error {
+	var sign int
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sign = signOf(float64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		sign = signOf(float64(v.Uint()))
+	case reflect.Float32, reflect.Float64:
+		sign = signOf(v.Float())
+	default:
+		return nil
+	}
+
+	switch {
+	case sign < 0:
+		return errors.New("must not be negative")
+	case sign == 0 && !zeroSettings[key]:
+		return errors.New("must be greater than 0")
+	}
+	return nil
+}
+
+func signOf(f float64) int {
+	switch {
+	case f < 0:
+		return -1
+	case f > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// registerSettings registers the subsystem settings as flags, with the
+// defaults of daemon.NewConfig.  The values set are stored in c.settings.
+func (c *Config) registerSettings(fs *flag.FlagSet) {
+	c.settings = make(map[string]string)
+	defaults := daemon.NewConfig()
+	for _, s := range subsystemSettings(&defaults) {
+		fs.Var(&settingValue{
+			key:    s.key,
+			value:  s.value,
+			values: c.settings,
+		}, s.key, s.usage)
+	}
+}
+
+// applySettings sets the subsystem settings of c on dc
+func (c *Config) applySettings(dc *daemon.Config) {
+	for _, s := range subsystemSettings(dc) {
+		if v, ok := c.settings[s.key]; ok {
+			// The value was validated by settingValue.Set
+			panicIfError(setValue(s.value, v), "Invalid %s", s.key)
+		}
+	}
+}
+
+// loadConfigFile sets the flags of fs to the values of the config file at
+// path.  A missing file is not an error unless required
+func loadConfigFile(fs *flag.FlagSet, path string, required bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && !required {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	d := json.NewDecoder(f)
+	d.UseNumber()
+	var values map[string]interface{}
+	if err := d.Decode(&values); err != nil {
+		return fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+
+	settings := make(map[string]string)
+	if err := flattenConfig("", values, settings); err != nil {
+		return fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+
+	for _, key := range sortedKeys(settings) {
+		if err := setSetting(fs, key, settings[key]); err != nil {
+			return fmt.Errorf("invalid config file %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenConfig converts the values of a config file to flag values keyed by
+// flag name
+func flattenConfig(prefix string, values map[string]interface{}, settings map[string]string) error {
+	for k, v := range values {
+		key := prefix + k
+		switch x := v.(type) {
+		case map[string]interface{}:
+			if prefix != "" {
+				return fmt.Errorf("%s: sections can't be nested", key)
+			}
+			if err := flattenConfig(key+".", x, settings); err != nil {
+				return err
+			}
+		case []interface{}:
+			items := make([]string, len(x))
+			for i, item := range x {
+				s, ok := item.(string)
+				if !ok {
+					return fmt.Errorf("%s: expected a list of strings", key)
+				}
+				items[i] = s
+			}
+			settings[key] = strings.Join(items, ",")
+		case string:
+			settings[key] = x
+		case json.Number:
+			settings[key] = x.String()
+		case bool:
+			settings[key] = strconv.FormatBool(x)
+		case nil:
+			return fmt.Errorf("%s: null is not a valid value", key)
+		}
+	}
+	return nil
+}
+
+// setSetting sets a flag of fs by name from the config file
+func setSetting(fs *flag.FlagSet, key, value string) error {
+	if key == "network" {
+		// The network selects the data directory the config file is in
+		return fmt.Errorf("network can only be set on the command line or by %s", envName(key))
+	}
+	if flagName, ok := derivedSettings[key]; ok {
+		return fmt.Errorf("%s is set by %s", key, flagName)
+	}
+	if settingsFlags[key] || fs.Lookup(key) == nil {
+		return fmt.Errorf("unknown setting %s", key)
+	}
+	if err := fs.Set(key, value); err != nil {
+		return fmt.Errorf("invalid %s %q: %v", key, value, err)
+	}
+	return nil
+}
+
+// envName returns the environment variable of a flag
+func envName(key string) string {
+	return envPrefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(key))
+}
+
+// loadEnv sets the flags of fs that have an environment variable set
+func loadEnv(fs *flag.FlagSet) error {
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || settingsFlags[f.Name] {
+			return
+		}
+		if v, ok := os.LookupEnv(envName(f.Name)); ok {
+			if e := fs.Set(f.Name, v); e != nil {
+				err = fmt.Errorf("invalid %s %q: %v", envName(f.Name), v, e)
+			}
+		}
+	})
+	return err
+}
+
+// loadSettings layers the config file and the environment under the command
+// line flags in args, which were already parsed into fs
+func loadSettings(fs *flag.FlagSet, args []string, dataDir string) error {
+	path := configFile
+	required := path != ""
+	if v, ok := os.LookupEnv(envName("config")); ok && !isFlagSet(fs, "config") {
+		path = v
+		required = true
+	}
+	if path == "" {
+		path = filepath.Join(dataDir, configFileName)
+	}
+
+	if err := loadConfigFile(fs, path, required); err != nil {
+		return err
+	}
+
+	if err := loadEnv(fs); err != nil {
+		return err
+	}
+
+	// The command line takes precedence
+	return fs.Parse(args)
+}
+
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// applyChainParams sets the values of the network selected by -network or
+// its environment variable.  The options a network has a value for are
+// defaults: the config file, the environment and the command line override
+// them.
+func (c *Config) applyChainParams(fs *flag.FlagSet) error {
+	if v, ok := os.LookupEnv(envName("network")); ok && !isFlagSet(fs, "network") {
+		c.Network = v
+	}
+
+	p, err := params.Get(c.Network)
+	if err != nil {
+		return err
+	}
+
+	cipher.SetAddressVersion(p.AddressVersion)
+	c.MessagePrefix = p.MessagePrefix
+	c.Regtest = p.Regtest
+	c.GenesisCoinVolume = p.GenesisCoinVolume
+	c.DefaultConnections = p.DefaultConnections
+
+	defaults := []struct {
+		name string
+		set  func()
+	}{
+		{"port", func() { c.Port = p.Port }},
+		{"web-interface-port", func() { c.WebInterfacePort = p.WebInterfacePort }},
+		{"rpc-interface-port", func() { c.RPCInterfacePort = p.RPCInterfacePort }},
+		{"metrics-interface-port", func() { c.MetricsInterfacePort = p.MetricsInterfacePort }},
+		{"data-dir", func() { c.DataDirectory += p.DataDirSuffix }},
+		{"dns-seeds", func() { c.DNSSeeds = strings.Join(p.DNSSeeds, ",") }},
+		{"genesis-address", func() { c.GenesisAddressStr = p.GenesisAddress }},
+		{"genesis-signature", func() { c.GenesisSignatureStr = p.GenesisSignature }},
+		{"genesis-timestamp", func() { c.GenesisTimestamp = p.GenesisTimestamp }},
+		{"master-public-key", func() { c.BlockchainPubkeyStr = p.BlockchainPubkey }},
+		{"master-secret-key", func() { c.BlockchainSeckeyStr = p.BlockchainSeckey }},
+	}
+	if p.Regtest {
+		// a regtest node is alone on its network
+		defaults = append(defaults, []struct {
+			name string
+			set  func()
+		}{
+			{"master", func() { c.RunMaster = true }},
+			{"disable-networking", func() { c.DisableNetworking = true }},
+			{"launch-browser", func() { c.LaunchBrowser = false }},
+		}...)
+	}
+
+	for _, d := range defaults {
+		if !isFlagSet(fs, d.name) {
+			d.set()
+		}
+	}
+
+	return nil
+}
+
+// createRegtestGenesis sets the key of the master, the genesis address and
+// the signature of the genesis block of a regtest network.  The genesis
+// coins are sent to the address of the master key.  Without a master key a
+// new one is generated, which only works with a new data directory.
+func (c *Config) createRegtestGenesis() {
+	if c.BlockchainSeckey == (cipher.SecKey{}) {
+		c.BlockchainPubkey, c.BlockchainSeckey = cipher.GenerateKeyPair()
+		logger.Info("Generated regtest master key %s", c.BlockchainPubkey.Hex())
+	}
+	c.BlockchainPubkey = cipher.PubKeyFromSecKey(c.BlockchainSeckey)
+	c.GenesisAddress = cipher.AddressFromPubKey(c.BlockchainPubkey)
+
+	gb, err := coin.NewGenesisBlock(c.GenesisAddress, c.GenesisCoinVolume, c.GenesisTimestamp)
+	panicIfError(err, "Invalid genesis block")
+	c.GenesisSignature = cipher.SignHash(gb.HashHeader(), c.BlockchainSeckey)
+
+	c.GenesisAddressStr = c.GenesisAddress.String()
+	c.GenesisSignatureStr = c.GenesisSignature.Hex()
+	c.BlockchainPubkeyStr = c.BlockchainPubkey.Hex()
+}
+
+// effectiveConfig returns the values of the flags of fs and the subsystem
+// settings of dc in the config file format
+func effectiveConfig(fs *flag.FlagSet, dc *daemon.Config) map[string]interface{} {
+	config := make(map[string]interface{})
+	fs.VisitAll(func(f *flag.Flag) {
+		if settingsFlags[f.Name] || strings.Contains(f.Name, ".") {
+			return
+		}
+		if secretSettings[f.Name] && f.Value.String() != "" {
+			config[f.Name] = "<redacted>"
+			return
+		}
+		if g, ok := f.Value.(flag.Getter); ok {
+			v := g.Get()
+			if d, ok := v.(time.Duration); ok {
+				v = d.String()
+			}
+			config[f.Name] = v
+		} else {
+			config[f.Name] = f.Value.String()
+		}
+	})
+
+	for _, s := range subsystemSettings(dc) {
+		parts := strings.SplitN(s.key, ".", 2)
+		section, ok := config[parts[0]].(map[string]interface{})
+		if !ok {
+			section = make(map[string]interface{})
+			config[parts[0]] = section
+		}
+		section[parts[1]] = formatValue(s.value)
+	}
+
+	return config
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
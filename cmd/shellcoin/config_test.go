@@ -0,0 +1,201 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/params"
+)
+
+func TestKebabCase(t *testing.T) {
+	for name, key := range map[string]string{
+		"Port":              "port",
+		"IPCountsMax":       "ip-counts-max",
+		"DNSSeedPort":       "dns-seed-port",
+		"UnconfirmedMaxAge": "unconfirmed-max-age",
+		"DBPath":            "db-path",
+		"MaxTxnAnnounceNum": "max-txn-announce-num",
+	} {
+		require.Equal(t, key, kebabCase(name))
+	}
+}
+
+func TestSettingsPrecedence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, configFileName)
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{
+		"port": 7200,
+		"web-interface-port": 7600,
+		"api-keys": ["explorer:0123456789abcdef:read", "admin:fedcba9876543210:admin"],
+		"daemon.ip-counts-max": 5,
+		"visor": {
+			"unconfirmed-max-age": "24h",
+			"max-block-size": 1024
+		}
+	}`), 0600))
+
+	os.Setenv("SHELLCOIN_PORT", "7300")
+	os.Setenv("SHELLCOIN_VISOR_MAX_BLOCK_SIZE", "2048")
+	defer os.Unsetenv("SHELLCOIN_PORT")
+	defer os.Unsetenv("SHELLCOIN_VISOR_MAX_BLOCK_SIZE")
+
+	var c Config
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	port := fs.Int("port", 7100, "")
+	webPort := fs.Int("web-interface-port", 7520, "")
+	apiKeys := fs.String("api-keys", "", "")
+	c.registerSettings(fs)
+
+	args := []string{"-daemon.ip-counts-max", "9"}
+	require.NoError(t, fs.Parse(args))
+	require.NoError(t, loadSettings(fs, args, dir))
+
+	require.Equal(t, 7300, *port)
+	require.Equal(t, 7600, *webPort)
+	require.Equal(t, "explorer:0123456789abcdef:read,admin:fedcba9876543210:admin", *apiKeys)
+
+	dc := daemon.NewConfig()
+	c.applySettings(&dc)
+	require.Equal(t, 9, dc.Daemon.IPCountsMax)
+	require.Equal(t, 24*time.Hour, dc.Visor.Config.UnconfirmedMaxAge)
+	require.Equal(t, 2048, dc.Visor.Config.MaxBlockSize)
+	require.Equal(t, daemon.NewConfig().Visor.Config.UnconfirmedRefreshRate, dc.Visor.Config.UnconfirmedRefreshRate)
+
+	config := effectiveConfig(fs, &dc)
+	require.Equal(t, 7300, config["port"])
+	require.Equal(t, "24h0m0s", config["visor"].(map[string]interface{})["unconfirmed-max-age"])
+	require.NotContains(t, config["daemon"], "port")
+	require.Equal(t, "<redacted>", config["api-keys"])
+}
+
+func TestLoadConfigFileErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var c Config
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Int("port", 7100, "")
+	c.registerSettings(fs)
+
+	path := filepath.Join(dir, configFileName)
+	require.NoError(t, loadConfigFile(fs, path, false))
+	require.Error(t, loadConfigFile(fs, path, true))
+
+	for content, msg := range map[string]string{
+		`{"daemon": {"port": 1}}`:              "daemon.port is set by port",
+		`{"nope": 1}`:                          "unknown setting nope",
+		`{"help": true}`:                       "unknown setting help",
+		`{"network": "testnet"}`:               "network can only be set",
+		`{"visor": {"max-block-size": "x"}}`:   `invalid visor.max-block-size "x"`,
+		`{"visor": {"gnet": {"port": 1}}}`:     "visor.gnet: sections can't be nested",
+		`{"peers": {"max": null}}`:             "peers.max: null is not a valid value",
+		`{"gnet": {"max-connections": [1]}}`:   "gnet.max-connections: expected a list of strings",
+		`{"gnet": {"max-connections": 1}, `:    "unexpected EOF",
+		`{"gnet": {"max-connections": "-1x"}}`: `invalid gnet.max-connections "-1x"`,
+		`{"pool": {"ping-rate": "0s"}}`:        "must be greater than 0",
+	} {
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+		err := loadConfigFile(fs, path, true)
+		require.Error(t, err, content)
+		require.Contains(t, err.Error(), msg, content)
+	}
+}
+
+func TestSettingRanges(t *testing.T) {
+	var c Config
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	c.registerSettings(fs)
+
+	for _, args := range [][]string{
+		{"-visor.unconfirmed-max-age", "0s"},
+		{"-pool.ping-rate", "-1s"},
+		{"-gnet.max-connections", "0"},
+		{"-peers.max", "-1"},
+		{"-gateway.buffer-size", "0"},
+		{"-visor.block-creation-interval", "0"},
+	} {
+		require.Error(t, fs.Parse(args), "%v", args)
+	}
+
+	require.NoError(t, fs.Parse([]string{
+		"-visor.txns-announce-max-delay", "0s",
+		"-gnet.compression-min-size", "0",
+		"-gnet.max-connections", "1",
+	}))
+
+	os.Setenv("SHELLCOIN_DAEMON_PRIVATE_RATE", "0s")
+	defer os.Unsetenv("SHELLCOIN_DAEMON_PRIVATE_RATE")
+	err := loadEnv(fs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be greater than 0")
+}
+
+func TestApplyChainParams(t *testing.T) {
+	defer cipher.SetAddressVersion(params.MainNet.AddressVersion)
+
+	c := Config{
+		Network:       params.MainNet.Name,
+		DataDirectory: ".shellcoin",
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.StringVar(&c.Network, "network", c.Network, "")
+	fs.StringVar(&c.DataDirectory, "data-dir", c.DataDirectory, "")
+	fs.IntVar(&c.Port, "port", c.Port, "")
+	fs.IntVar(&c.WebInterfacePort, "web-interface-port", c.WebInterfacePort, "")
+	fs.StringVar(&c.BlockchainSeckeyStr, "master-secret-key", c.BlockchainSeckeyStr, "")
+	fs.BoolVar(&c.RunMaster, "master", c.RunMaster, "")
+
+	require.NoError(t, fs.Parse([]string{"-network", "regtest", "-port", "9000"}))
+	require.NoError(t, c.applyChainParams(fs))
+
+	require.Equal(t, 9000, c.Port)
+	require.Equal(t, params.RegTest.WebInterfacePort, c.WebInterfacePort)
+	require.Equal(t, ".shellcoin-regtest", c.DataDirectory)
+	require.Equal(t, params.RegTest.BlockchainSeckey, c.BlockchainSeckeyStr)
+	require.Equal(t, params.RegTest.GenesisAddress, c.GenesisAddressStr)
+	require.Equal(t, params.RegTest.MessagePrefix, c.MessagePrefix)
+	require.Equal(t, params.RegTest.AddressVersion, cipher.AddressVersion())
+
+	require.True(t, c.Regtest)
+	require.True(t, c.RunMaster)
+	require.True(t, c.DisableNetworking)
+
+	c.Network = "nope"
+	require.Error(t, c.applyChainParams(flag.NewFlagSet("test", flag.ContinueOnError)))
+}
+
+func TestCreateRegtestGenesis(t *testing.T) {
+	pub, sec := cipher.GenerateDeterministicKeyPair([]byte("regtest genesis"))
+	c := Config{
+		GenesisTimestamp:  params.RegTest.GenesisTimestamp,
+		GenesisCoinVolume: params.RegTest.GenesisCoinVolume,
+		BlockchainSeckey:  sec,
+	}
+	c.createRegtestGenesis()
+
+	require.Equal(t, pub, c.BlockchainPubkey)
+	require.Equal(t, cipher.AddressFromPubKey(pub), c.GenesisAddress)
+	gb, err := coin.NewGenesisBlock(c.GenesisAddress, c.GenesisCoinVolume, c.GenesisTimestamp)
+	require.NoError(t, err)
+	require.NoError(t, cipher.VerifySignature(pub, c.GenesisSignature, gb.HashHeader()))
+
+	// without a key, one is generated
+	c = Config{GenesisCoinVolume: params.RegTest.GenesisCoinVolume}
+	c.createRegtestGenesis()
+	require.NotEqual(t, cipher.SecKey{}, c.BlockchainSeckey)
+	require.Equal(t, cipher.AddressFromSecKey(c.BlockchainSeckey), c.GenesisAddress)
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
@@ -12,18 +15,22 @@ import (
 	"path/filepath"
 	"runtime/debug"
 	"runtime/pprof"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/skycoin/skycoin/src/api/auth"
 	"github.com/skycoin/skycoin/src/api/webrpc"
 	"github.com/skycoin/skycoin/src/cipher"
 	"github.com/skycoin/skycoin/src/coin"
 	"github.com/skycoin/skycoin/src/daemon"
 	"github.com/skycoin/skycoin/src/gui"
+	"github.com/skycoin/skycoin/src/params"
 	"github.com/skycoin/skycoin/src/util/browser"
 	"github.com/skycoin/skycoin/src/util/cert"
 	"github.com/skycoin/skycoin/src/util/file"
 	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/metrics"
 	"github.com/skycoin/skycoin/src/visor"
 )
 
@@ -49,31 +56,30 @@ var (
 		"gnet",
 		"pex",
 		"webrpc",
-	}
-
-	//TODO: Move time and other genesis block settigns from visor, to here
-	GenesisSignatureStr = "133067c26b92641433dd6be12c3898d14646a07a29fe51547c69f76da0bbfd2973aa48d4cb41c282866c1bda09a979c2ccd9fd53ad8ac98fcbe9033d53bb75eb01"
-	GenesisAddressStr   = "EmQkiYpw14SHHkVFVeMqnPouPERKtvtF1A"
-	BlockchainPubkeyStr = "02af0b8addc4e0be5922e98a1d8ebd91cf5f034ccd8756f126f9714507fd178a78"
-	BlockchainSeckeyStr = ""
-
-	GenesisTimestamp  uint64 = 1489844528
-	GenesisCoinVolume uint64 = 300e12
-
-	//GenesisTimestamp: 1426562704,
-	//GenesisCoinVolume: 100e12, //100e6 * 10e6
-
-	DefaultConnections = []string{
-		"120.55.114.17:7100",
-		"97.64.46.87:7100",
+		"audit",
 	}
 )
 
 // Command line interface arguments
 
 type Config struct {
+	// Network to join, see params.Names
+	Network string
+	// Set by the network, written over the first bytes of the message IDs
+	MessagePrefix string
+	// Set by the network, blocks are created on request and the genesis
+	// block is created from the master key
+	Regtest bool
 	// Disable peer exchange
 	DisablePEX bool
+	// Comma separated list of the enabled peer discovery sources
+	PeerSources string
+	// Seed-list file of newline delimited peer addresses
+	SeedFile string
+	// Comma separated list of DNS seeds
+	DNSSeeds string
+	// Peers to connect to on first start, set by the network
+	DefaultConnections []string
 	// Don't make any outgoing connections
 	DisableOutgoingConnections bool
 	// Don't allowing incoming connections
@@ -100,10 +106,32 @@ type Config struct {
 	WebInterfaceCert  string
 	WebInterfaceKey   string
 	WebInterfaceHTTPS bool
+	// Comma separated Host header values accepted by the web interface,
+	// besides its address if it's a loopback address
+	WebInterfaceHosts string
+	// Comma separated origins of the pages allowed to call the web interface
+	// APIs from a browser
+	WebInterfaceCORS string
+	// Serve the requests that change the state of the node without CSRF token
+	DisableCSRF bool
 
 	RPCInterface     bool
 	RPCInterfacePort int
 	RPCInterfaceAddr string
+	// Expose the wallet methods on the rpc interface, they require APIKeys
+	RPCWallet bool
+
+	// API keys of the web interface and the rpc interface, as
+	// name:secret:scope+scope, comma separated.  The APIs don't require keys
+	// if empty.
+	APIKeys string
+	// File the rejected API requests are appended to
+	APIAuditLog string
+
+	// Serve metrics in the Prometheus text format on /metrics
+	MetricsInterface     bool
+	MetricsInterfacePort int
+	MetricsInterfaceAddr string
 
 	// Launch System Default Browser after client startup
 	LaunchBrowser bool
@@ -119,8 +147,14 @@ type Config struct {
 	ColorLog bool
 	// This is the value registered with flag, it is converted to LogLevel after parsing
 	LogLevel string
+	// Levels of modules that differ from LogLevel, e.g. "gnet=debug,pex=warning"
+	LogModuleLevels string
+	// Log output format, "text" or "json"
+	LogFormat string
 	// Disable "Reply to ping", "Received pong" log messages
 	DisablePingPong bool
+	// Don't compress messages sent to peers
+	DisableCompression bool
 
 	// Wallets
 	// Defaults to ${DataDirectory}/wallets/
@@ -128,9 +162,18 @@ type Config struct {
 
 	RunMaster bool
 
-	GenesisSignature cipher.Sig
-	GenesisTimestamp uint64
-	GenesisAddress   cipher.Address
+	// These are the values registered with flag, they are converted to
+	// GenesisSignature, GenesisAddress, BlockchainPubkey and BlockchainSeckey
+	// after parsing
+	GenesisSignatureStr string
+	GenesisAddressStr   string
+	BlockchainPubkeyStr string
+	BlockchainSeckeyStr string
+
+	GenesisSignature  cipher.Sig
+	GenesisTimestamp  uint64
+	GenesisAddress    cipher.Address
+	GenesisCoinVolume uint64
 
 	BlockchainPubkey cipher.PubKey
 	BlockchainSeckey cipher.SecKey
@@ -151,12 +194,37 @@ type Config struct {
 	Arbitrating  bool
 	RPCThreadNum uint // rpc number
 	Logtofile    bool
+	// The log file is rotated when it grows over LogMaxSize megabytes or gets
+	// older than LogMaxAge, LogMaxBackups rotated files are kept
+	LogMaxSize    int64
+	LogMaxAge     time.Duration
+	LogMaxBackups int
+
+	// How long shutdown waits for each subsystem, e.g. for the HTTP requests
+	// in progress to complete
+	ShutdownTimeout time.Duration
+
+	// Values of the subsystem settings that were set, by key
+	settings map[string]string
 }
 
 func (c *Config) register() {
 	flag.BoolVar(&help, "help", false, "Show help")
+	flag.StringVar(&c.Network, "network", c.Network,
+		fmt.Sprintf("Network to join, one of %s. Sets the defaults of the genesis, master key, ports, data directory and peers",
+			strings.Join(params.Names(), ", ")))
+	flag.StringVar(&configFile, "config", configFile,
+		fmt.Sprintf("config file, defaults to %s in the data directory", configFileName))
+	flag.BoolVar(&printConfig, "print-config", printConfig,
+		"print the effective config in the config file format and exit")
 	flag.BoolVar(&c.DisablePEX, "disable-pex", c.DisablePEX,
 		"disable PEX peer discovery")
+	flag.StringVar(&c.PeerSources, "peer-sources", c.PeerSources,
+		"Comma separated list of peer discovery sources: static,file,dns,exchange")
+	flag.StringVar(&c.SeedFile, "seed-file", c.SeedFile,
+		"File of newline delimited peer addresses, relative to the data directory if not absolute")
+	flag.StringVar(&c.DNSSeeds, "dns-seeds", c.DNSSeeds,
+		"Comma separated list of DNS seeds, as host or host:port")
 	flag.BoolVar(&c.DisableOutgoingConnections, "disable-outgoing",
 		c.DisableOutgoingConnections, "Don't make outgoing connections")
 	flag.BoolVar(&c.DisableIncomingConnections, "disable-incoming",
@@ -164,7 +232,7 @@ func (c *Config) register() {
 	flag.BoolVar(&c.DisableNetworking, "disable-networking",
 		c.DisableNetworking, "Disable all network activity")
 	flag.StringVar(&c.Address, "address", c.Address,
-		"IP Address to run application on. Leave empty to default to a public interface")
+		"IP Address to run application on, IPv6 addresses may be enclosed in brackets. Leave empty to default to a public interface")
 	flag.IntVar(&c.Port, "port", c.Port, "Port to run application on")
 	flag.BoolVar(&c.WebInterface, "web-interface", c.WebInterface,
 		"enable the web interface")
@@ -180,6 +248,13 @@ func (c *Config) register() {
 			"If not provided, will use key.pem in -data-directory")
 	flag.BoolVar(&c.WebInterfaceHTTPS, "web-interface-https",
 		c.WebInterfaceHTTPS, "enable HTTPS for web interface")
+	flag.StringVar(&c.WebInterfaceHosts, "web-interface-hosts", c.WebInterfaceHosts,
+		"comma separated Host header values accepted by the web interface, besides its address and, "+
+			"if it's a loopback address, the other names of the loopback address")
+	flag.StringVar(&c.WebInterfaceCORS, "web-interface-cors", c.WebInterfaceCORS,
+		"comma separated origins of the pages allowed to call the web interface APIs from a browser, e.g. https://dashboard.example.com")
+	flag.BoolVar(&c.DisableCSRF, "disable-csrf", c.DisableCSRF,
+		"serve the web interface requests that change the state of the node without CSRF token")
 
 	flag.BoolVar(&c.RPCInterface, "rpc-interface", c.RPCInterface,
 		"enable the rpc interface")
@@ -187,7 +262,23 @@ func (c *Config) register() {
 		"port to serve rpc interface on")
 	flag.StringVar(&c.RPCInterfaceAddr, "rpc-interface-addr", c.RPCInterfaceAddr,
 		"addr to serve rpc interface on")
+	flag.BoolVar(&c.RPCWallet, "rpc-wallet", c.RPCWallet,
+		"enable the wallet methods of the rpc interface, they require -api-keys")
+	flag.StringVar(&c.APIKeys, "api-keys", c.APIKeys,
+		"API keys of the web interface and the rpc interface, as name:secret:scope+scope, comma separated. "+
+			"Scopes are read, wallet-read, wallet-spend and admin. If empty, the APIs don't require keys")
+	flag.StringVar(&c.APIAuditLog, "api-audit-log", c.APIAuditLog,
+		"file the API requests rejected by -api-keys are appended to, as JSON lines")
+
+	flag.BoolVar(&c.MetricsInterface, "metrics-interface", c.MetricsInterface,
+		"serve metrics in the Prometheus text format on /metrics")
+	flag.IntVar(&c.MetricsInterfacePort, "metrics-interface-port", c.MetricsInterfacePort,
+		"port to serve metrics on")
+	flag.StringVar(&c.MetricsInterfaceAddr, "metrics-interface-addr", c.MetricsInterfaceAddr,
+		"addr to serve metrics on")
 	flag.UintVar(&c.RPCThreadNum, "rpc-thread-num", 5, "rpc thread number")
+	flag.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout,
+		"how long shutdown waits for each subsystem to stop, e.g. for requests in progress to complete")
 
 	flag.BoolVar(&c.LaunchBrowser, "launch-browser", c.LaunchBrowser,
 		"launch system default webbrowser at client startup")
@@ -196,7 +287,7 @@ func (c *Config) register() {
 	flag.StringVar(&c.DataDirectory, "data-dir", c.DataDirectory,
 		fmt.Sprintf("directory to store app data (defaults to ~/.%s)", coinName))
 	flag.StringVar(&c.ConnectTo, "connect-to", c.ConnectTo,
-		"connect to this ip only")
+		"connect to this host:port only, IPv6 addresses must be enclosed in brackets, e.g. [::1]:6000")
 	flag.BoolVar(&c.ProfileCPU, "profile-cpu", c.ProfileCPU,
 		"enable cpu profiling")
 	flag.StringVar(&c.ProfileCPUFile, "profile-cpu-file",
@@ -205,11 +296,23 @@ func (c *Config) register() {
 		"Run the http profiling interface")
 	flag.StringVar(&c.LogLevel, "log-level", c.LogLevel,
 		"Choices are: debug, info, notice, warning, error, critical")
+	flag.StringVar(&c.LogModuleLevels, "log-module-levels", c.LogModuleLevels,
+		"Log levels of modules that differ from -log-level, e.g. gnet=debug,pex=warning")
+	flag.StringVar(&c.LogFormat, "log-format", c.LogFormat,
+		"Log output format, text or json")
 	flag.BoolVar(&c.ColorLog, "color-log", c.ColorLog,
 		"Add terminal colors to log output")
 	flag.BoolVar(&c.DisablePingPong, "no-ping-log", false,
 		`disable "reply to ping" and "received pong" log messages`)
+	flag.BoolVar(&c.DisableCompression, "disable-compression", c.DisableCompression,
+		"Don't compress messages sent to peers")
 	flag.BoolVar(&c.Logtofile, "logtofile", false, "log to file")
+	flag.Int64Var(&c.LogMaxSize, "log-max-size", c.LogMaxSize,
+		"Rotate the log file when it grows over this many megabytes, 0 disables")
+	flag.DurationVar(&c.LogMaxAge, "log-max-age", c.LogMaxAge,
+		"Rotate the log file when it gets older than this, 0 disables")
+	flag.IntVar(&c.LogMaxBackups, "log-max-backups", c.LogMaxBackups,
+		"Number of rotated log files to keep, 0 keeps all")
 	flag.StringVar(&c.GUIDirectory, "gui-dir", c.GUIDirectory,
 		"static content directory for the html gui")
 
@@ -217,14 +320,14 @@ func (c *Config) register() {
 	flag.BoolVar(&c.RunMaster, "master", c.RunMaster,
 		"run the daemon as blockchain master server")
 
-	flag.StringVar(&BlockchainPubkeyStr, "master-public-key", BlockchainPubkeyStr,
+	flag.StringVar(&c.BlockchainPubkeyStr, "master-public-key", c.BlockchainPubkeyStr,
 		"public key of the master chain")
-	flag.StringVar(&BlockchainSeckeyStr, "master-secret-key", BlockchainSeckeyStr,
+	flag.StringVar(&c.BlockchainSeckeyStr, "master-secret-key", c.BlockchainSeckeyStr,
 		"secret key, set for master")
 
-	flag.StringVar(&GenesisAddressStr, "genesis-address", GenesisAddressStr,
+	flag.StringVar(&c.GenesisAddressStr, "genesis-address", c.GenesisAddressStr,
 		"genesis address")
-	flag.StringVar(&GenesisSignatureStr, "genesis-signature", GenesisSignatureStr,
+	flag.StringVar(&c.GenesisSignatureStr, "genesis-signature", c.GenesisSignatureStr,
 		"genesis block signature")
 	flag.Uint64Var(&c.GenesisTimestamp, "genesis-timestamp", c.GenesisTimestamp,
 		"genesis block timestamp")
@@ -240,8 +343,14 @@ func (c *Config) register() {
 }
 
 var devConfig Config = Config{
+	// The network sets the defaults of the genesis, master key, ports, data
+	// directory and peers, see applyChainParams
+	Network: params.MainNet.Name,
 	// Disable peer exchange
 	DisablePEX: true,
+	// Peer discovery sources
+	PeerSources: "static,file,dns,exchange",
+	SeedFile:    "seeds.txt",
 	// Don't make any outgoing connections
 	DisableOutgoingConnections: false,
 	// Don't allowing incoming connections
@@ -253,8 +362,6 @@ var devConfig Config = Config{
 	// Which address to serve on. Leave blank to automatically assign to a
 	// public interface
 	Address: "",
-	//gnet uses this for TCP incoming and outgoing
-	Port: 7100,
 
 	MaxConnections: 16,
 	// How often to make outgoing connections, in seconds
@@ -263,7 +370,6 @@ var devConfig Config = Config{
 	//AddressVersion: "test",
 	// Remote web interface
 	WebInterface:             true,
-	WebInterfacePort:         7520,
 	WebInterfaceAddr:         "127.0.0.1",
 	WebInterfaceCert:         "",
 	WebInterfaceKey:          "",
@@ -271,9 +377,13 @@ var devConfig Config = Config{
 	PrintWebInterfaceAddress: false,
 
 	RPCInterface:     true,
-	RPCInterfacePort: 7530,
 	RPCInterfaceAddr: "127.0.0.1",
-	RPCThreadNum:     5,
+
+	MetricsInterface:     false,
+	MetricsInterfaceAddr: "127.0.0.1",
+	RPCThreadNum:         5,
+
+	ShutdownTimeout: time.Second * 10,
 
 	LaunchBrowser: true,
 	// Data directory holds app data -- defaults to ~/.skycoin
@@ -281,8 +391,12 @@ var devConfig Config = Config{
 	// Web GUI static resources
 	GUIDirectory: "./src/gui/static/",
 	// Logging
-	ColorLog: true,
-	LogLevel: "DEBUG",
+	ColorLog:      true,
+	LogLevel:      "DEBUG",
+	LogFormat:     "text",
+	LogMaxSize:    100,
+	LogMaxAge:     24 * time.Hour,
+	LogMaxBackups: 7,
 
 	// Wallets
 	WalletDirectory: "",
@@ -293,7 +407,6 @@ var devConfig Config = Config{
 	BlockchainSeckey: cipher.SecKey{},
 
 	GenesisAddress:   cipher.Address{},
-	GenesisTimestamp: GenesisTimestamp,
 	GenesisSignature: cipher.Sig{},
 
 	/* Developer options */
@@ -309,40 +422,79 @@ var devConfig Config = Config{
 	ConnectTo: "",
 }
 
-func (c *Config) Parse() {
+// Parse reads the config from the command line, the environment and the
+// config file.  The data directory is not created.
+func (c *Config) Parse() error {
 	c.register()
+	c.registerSettings(flag.CommandLine)
 	flag.Parse()
 	if help {
 		flag.Usage()
 		os.Exit(0)
 	}
+
+	if err := c.applyChainParams(flag.CommandLine); err != nil {
+		return err
+	}
+
+	// The data directory is needed to find the config file
+	dataDir := c.DataDirectory
+	if v, ok := os.LookupEnv(envName("data-dir")); ok && !isFlagSet(flag.CommandLine, "data-dir") {
+		dataDir = v
+	}
+	dataDir, err := file.ResolveDataDir(dataDir)
+	if err != nil {
+		return err
+	}
+
+	if err := loadSettings(flag.CommandLine, os.Args[1:], dataDir); err != nil {
+		return err
+	}
+
+	if err := c.validate(); err != nil {
+		return err
+	}
+
 	c.postProcess()
+	return nil
+}
+
+// validate checks the ranges of the flags of Config.  The subsystem settings
+// are checked when they are set, by checkSetting.
+func (c *Config) validate() error {
+	if c.ShutdownTimeout <= 0 {
+		return errors.New("shutdown-timeout must be greater than 0")
+	}
+	if c.OutgoingConnectionsRate < 0 {
+		return errors.New("connection-rate must not be negative")
+	}
+	return nil
 }
 
 func (c *Config) postProcess() {
 	var err error
-	if GenesisSignatureStr != "" {
-		c.GenesisSignature, err = cipher.SigFromHex(GenesisSignatureStr)
+	if c.GenesisSignatureStr != "" {
+		c.GenesisSignature, err = cipher.SigFromHex(c.GenesisSignatureStr)
 		panicIfError(err, "Invalid Signature")
 	}
-	if GenesisAddressStr != "" {
-		c.GenesisAddress, err = cipher.DecodeBase58Address(GenesisAddressStr)
+	if c.GenesisAddressStr != "" {
+		c.GenesisAddress, err = cipher.DecodeBase58Address(c.GenesisAddressStr)
 		panicIfError(err, "Invalid Address")
 	}
-	if BlockchainPubkeyStr != "" {
-		c.BlockchainPubkey, err = cipher.PubKeyFromHex(BlockchainPubkeyStr)
+	if c.BlockchainPubkeyStr != "" {
+		c.BlockchainPubkey, err = cipher.PubKeyFromHex(c.BlockchainPubkeyStr)
 		panicIfError(err, "Invalid Pubkey")
 	}
-	if BlockchainSeckeyStr != "" {
-		c.BlockchainSeckey, err = cipher.SecKeyFromHex(BlockchainSeckeyStr)
+	if c.BlockchainSeckeyStr != "" {
+		c.BlockchainSeckey, err = cipher.SecKeyFromHex(c.BlockchainSeckeyStr)
 		panicIfError(err, "Invalid Seckey")
-		BlockchainSeckeyStr = ""
+		c.BlockchainSeckeyStr = ""
 	}
-	if BlockchainSeckeyStr != "" {
-		c.BlockchainSeckey = cipher.SecKey{}
+	if c.Regtest {
+		c.createRegtestGenesis()
 	}
 
-	c.DataDirectory, err = file.InitDataDir(c.DataDirectory)
+	c.DataDirectory, err = file.ResolveDataDir(c.DataDirectory)
 	panicIfError(err, "Invalid DataDirectory")
 
 	if c.WebInterfaceCert == "" {
@@ -384,12 +536,18 @@ func printProgramStatus() {
 	}
 }
 
+// catchInterrupt closes quit on SIGINT or SIGTERM.  A second signal exits
+// immediately, in case the shutdown hangs.
 func catchInterrupt(quit chan<- struct{}) {
 	sigchan := make(chan os.Signal, 1)
-	signal.Notify(sigchan, os.Interrupt)
-	<-sigchan
-	signal.Stop(sigchan)
+	signal.Notify(sigchan, os.Interrupt, syscall.SIGTERM)
+	sig := <-sigchan
+	logger.Info("Received %v, shutting down", sig)
 	close(quit)
+
+	sig = <-sigchan
+	logger.Critical("Received %v during shutdown, exiting immediately", sig)
+	os.Exit(1)
 }
 
 // Catches SIGUSR1 and prints internal program state
@@ -406,39 +564,49 @@ func catchDebug() {
 }
 
 // init logging settings
-func initLogging(dataDir string, level string, color, logtofile bool) (func(), error) {
+func initLogging(c *Config) (func(), error) {
 	logCfg := logging.DevLogConfig(logModules)
 	logCfg.Format = logFormat
-	logCfg.Colors = color
-	logCfg.Level = level
+	logCfg.Colors = c.ColorLog
+	logCfg.Level = c.LogLevel
+
+	switch c.LogFormat {
+	case "text":
+	case "json":
+		logCfg.JSON = true
+	default:
+		return nil, fmt.Errorf("invalid -log-format %s, expected text or json", c.LogFormat)
+	}
 
-	var fd *os.File
-	if logtofile {
-		logDir := filepath.Join(dataDir, "logs")
+	moduleLevels, err := logging.ParseModuleLevels(c.LogModuleLevels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -log-module-levels: %v", err)
+	}
+	logCfg.ModuleLevels = moduleLevels
+
+	var rf *logging.RotatingFile
+	if c.Logtofile {
+		logDir := filepath.Join(c.DataDirectory, "logs")
 		if err := createDirIfNotExist(logDir); err != nil {
 			log.Println("initial logs folder failed", err)
 			return nil, fmt.Errorf("init log folder fail, %v", err)
 		}
 
-		// open log file
-		tf := "2006-01-02-030405"
-		logfile := filepath.Join(logDir,
-			fmt.Sprintf("%s-v%s.log", time.Now().Format(tf), Version))
-		var err error
-		fd, err = os.OpenFile(logfile, os.O_RDWR|os.O_CREATE, 0666)
+		logfile := filepath.Join(logDir, coinName+".log")
+		rf, err = logging.OpenRotatingFile(logfile, c.LogMaxSize*1024*1024, c.LogMaxAge, c.LogMaxBackups)
 		if err != nil {
 			return nil, err
 		}
 
-		logCfg.Output = io.MultiWriter(os.Stdout, fd)
+		logCfg.Output = io.MultiWriter(os.Stdout, rf)
 	}
 
 	logCfg.InitLogger()
 
 	return func() {
 		logger.Info("Log file closed")
-		if fd != nil {
-			fd.Close()
+		if rf != nil {
+			rf.Close()
 		}
 	}, nil
 }
@@ -459,22 +627,42 @@ func initProfiling(httpProf, profileCPU bool, profileCPUFile string) {
 	}
 }
 
+// splitList splits a comma separated list, dropping empty items
+func splitList(s string) []string {
+	var items []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
 func configureDaemon(c *Config) daemon.Config {
 	//cipher.SetAddressVersion(c.AddressVersion)
 	dc := daemon.NewConfig()
 	dc.Peers.DataDirectory = c.DataDirectory
 	dc.Peers.Disabled = c.DisablePEX
+	dc.Peers.Sources = splitList(c.PeerSources)
+	dc.Peers.SeedFile = c.SeedFile
+	dc.Peers.DNSSeeds = splitList(c.DNSSeeds)
+	dc.Peers.DNSSeedPort = c.Port
 	dc.Daemon.DisableOutgoingConnections = c.DisableOutgoingConnections
 	dc.Daemon.DisableIncomingConnections = c.DisableIncomingConnections
 	dc.Daemon.DisableNetworking = c.DisableNetworking
 	dc.Daemon.Port = c.Port
-	dc.Daemon.Address = c.Address
+	dc.Daemon.Address = strings.TrimSuffix(strings.TrimPrefix(c.Address, "["), "]")
 	dc.Daemon.LocalhostOnly = c.LocalhostOnly
 	dc.Daemon.OutgoingMax = c.MaxConnections
 	dc.Daemon.DataDirectory = c.DataDirectory
 	dc.Daemon.LogPings = !c.DisablePingPong
+	dc.Daemon.DisableCompression = c.DisableCompression
+	dc.Daemon.ShutdownTimeout = c.ShutdownTimeout
+	dc.Visor.ShutdownTimeout = c.ShutdownTimeout
+	dc.Visor.Config.ParserStopTimeout = c.ShutdownTimeout
 
-	daemon.DefaultConnections = DefaultConnections
+	daemon.DefaultConnections = c.DefaultConnections
+	dc.Messages.NetworkPrefix = c.MessagePrefix
 
 	if c.OutgoingConnectionsRate == 0 {
 		c.OutgoingConnectionsRate = time.Millisecond
@@ -482,6 +670,7 @@ func configureDaemon(c *Config) daemon.Config {
 	dc.Daemon.OutgoingRate = c.OutgoingConnectionsRate
 
 	dc.Visor.Config.IsMaster = c.RunMaster
+	dc.Visor.Regtest = c.Regtest
 
 	dc.Visor.Config.BlockchainPubkey = c.BlockchainPubkey
 	dc.Visor.Config.BlockchainSeckey = c.BlockchainSeckey
@@ -489,7 +678,7 @@ func configureDaemon(c *Config) daemon.Config {
 	dc.Visor.Config.GenesisAddress = c.GenesisAddress
 	dc.Visor.Config.GenesisSignature = c.GenesisSignature
 	dc.Visor.Config.GenesisTimestamp = c.GenesisTimestamp
-	dc.Visor.Config.GenesisCoinVolume = GenesisCoinVolume
+	dc.Visor.Config.GenesisCoinVolume = c.GenesisCoinVolume
 	dc.Visor.Config.DBPath = c.DBPath
 	dc.Visor.Config.Arbitrating = c.Arbitrating
 	dc.Visor.Config.WalletDirectory = c.WalletDirectory
@@ -497,6 +686,8 @@ func configureDaemon(c *Config) daemon.Config {
 		Version: Version,
 		Commit:  Commit,
 	}
+
+	c.applySettings(&dc)
 	return dc
 }
 
@@ -509,6 +700,14 @@ func Run(c *Config) {
 		}
 	}()
 
+	if printConfig {
+		dc := configureDaemon(c)
+		b, err := json.MarshalIndent(effectiveConfig(flag.CommandLine, &dc), "", "    ")
+		panicIfError(err, "Encode config failed")
+		fmt.Println(string(b))
+		return
+	}
+
 	c.GUIDirectory = file.ResolveResourceDirectory(c.GUIDirectory)
 
 	scheme := "http"
@@ -524,9 +723,14 @@ func Run(c *Config) {
 		return
 	}
 
+	if _, err := file.InitDataDir(c.DataDirectory); err != nil {
+		fmt.Println(err)
+		return
+	}
+
 	initProfiling(c.HTTPProf, c.ProfileCPU, c.ProfileCPUFile)
 
-	closelog, err := initLogging(c.DataDirectory, c.LogLevel, c.ColorLog, c.Logtofile)
+	closelog, err := initLogging(c)
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -546,6 +750,13 @@ func Run(c *Config) {
 		return
 	}
 
+	keys, closeAudit, err := initAPIKeys(c)
+	if err != nil {
+		logger.Error("%v", err)
+		return
+	}
+	defer closeAudit()
+
 	errC := make(chan error, 1)
 
 	go func() {
@@ -556,28 +767,66 @@ func Run(c *Config) {
 	// start the webrpc
 	if c.RPCInterface {
 		rpcAddr := fmt.Sprintf("%v:%v", c.RPCInterfaceAddr, c.RPCInterfacePort)
-		rpc, err := webrpc.New(rpcAddr, d.Gateway)
+		rpc, err = webrpc.New(rpcAddr, d.Gateway)
 		if err != nil {
 			logger.Error("%v", err)
 			return
 		}
 		rpc.ChanBuffSize = 1000
 		rpc.WorkerNum = c.RPCThreadNum
+		rpc.ShutdownTimeout = c.ShutdownTimeout
+		rpc.Keys = keys
+		if c.Regtest {
+			if err := rpc.EnableRegtest(); err != nil {
+				logger.Error("%v", err)
+				return
+			}
+		}
+		if c.RPCWallet {
+			if err := rpc.EnableWallet(); err != nil {
+				logger.Error("%v", err)
+				return
+			}
+		}
 
 		go func() {
 			errC <- rpc.Run()
 		}()
 	}
 
+	var metricsServer *http.Server
+	// start the metrics interface
+	if c.MetricsInterface {
+		metricsAddr := fmt.Sprintf("%v:%v", c.MetricsInterfaceAddr, c.MetricsInterfacePort)
+		metricsServer, err = startMetricsInterface(metricsAddr, d, errC)
+		if err != nil {
+			logger.Error("%v", err)
+			return
+		}
+	}
+
 	// Debug only - forces connection on start.  Violates thread safety.
 	if c.ConnectTo != "" {
-		if err := d.Pool.Pool.Connect(c.ConnectTo); err != nil {
-			logger.Error("Force connect %s failed, %v", c.ConnectTo, err)
+		addr, err := daemon.ResolveAddr(c.ConnectTo)
+		if err != nil {
+			logger.Error("Resolve %s failed, %v", c.ConnectTo, err)
+			return
+		}
+
+		if err := d.Pool.Pool.Connect(addr); err != nil {
+			logger.Error("Force connect %s failed, %v", addr, err)
 			return
 		}
 	}
 
 	if c.WebInterface {
+		muxConfig := gui.MuxConfig{
+			Keys:        keys,
+			DisableCSRF: c.DisableCSRF,
+			Hosts:       splitList(c.WebInterfaceHosts),
+			CORSOrigins: splitList(c.WebInterfaceCORS),
+		}
+
 		var err error
 		if c.WebInterfaceHTTPS {
 			// Verify cert/key parameters, and if neither exist, create them
@@ -590,9 +839,9 @@ func Run(c *Config) {
 				return
 			}
 
-			err = gui.LaunchWebInterfaceHTTPS(host, c.GUIDirectory, d, c.WebInterfaceCert, c.WebInterfaceKey)
+			err = gui.LaunchWebInterfaceHTTPS(host, c.GUIDirectory, d.Gateway, muxConfig, c.WebInterfaceCert, c.WebInterfaceKey)
 		} else {
-			err = gui.LaunchWebInterface(host, c.GUIDirectory, d)
+			err = gui.LaunchWebInterface(host, c.GUIDirectory, d.Gateway, muxConfig)
 		}
 
 		if err != nil {
@@ -649,17 +898,100 @@ func Run(c *Config) {
 
 	logger.Info("Shutting down...")
 
+	// Stop accepting API requests and let the ones in progress complete
+	// before the daemon they use goes away
 	if rpc != nil {
-		rpc.Shutdown()
+		logger.Info("Shutting down webrpc")
+		if err := rpc.Shutdown(); err != nil {
+			logger.Error("webrpc shutdown failed: %v", err)
+		}
+	}
+	logger.Info("Shutting down web interface")
+	gui.Shutdown(c.ShutdownTimeout)
+	if metricsServer != nil {
+		logger.Info("Shutting down metrics interface")
+		metricsServer.Close()
 	}
-	gui.Shutdown()
 	d.Shutdown()
 	closelog()
 	logger.Info("Goodbye")
 }
 
+// initAPIKeys creates the keys of the APIs and opens the audit log.  The keys
+// are nil if none are configured.
+func initAPIKeys(c *Config) (*auth.Keys, func(), error) {
+	if c.APIKeys == "" {
+		if c.WebInterface && !isLoopback(c.WebInterfaceAddr) {
+			logger.Warning("The web interface is served on %s without -api-keys", c.WebInterfaceAddr)
+		}
+		if c.RPCInterface && !isLoopback(c.RPCInterfaceAddr) {
+			logger.Warning("The rpc interface is served on %s without -api-keys", c.RPCInterfaceAddr)
+		}
+		return nil, func() {}, nil
+	}
+
+	ks, err := auth.ParseKeys(c.APIKeys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid -api-keys: %v", err)
+	}
+
+	keys, err := auth.NewKeys(ks)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid -api-keys: %v", err)
+	}
+
+	if c.APIAuditLog == "" {
+		return keys, func() {}, nil
+	}
+
+	f, err := os.OpenFile(c.APIAuditLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open -api-audit-log failed: %v", err)
+	}
+	keys.SetAuditLog(f)
+
+	return keys, func() {
+		keys.SetAuditLog(nil)
+		f.Close()
+	}, nil
+}
+
+// isLoopback returns true if addr is localhost or a loopback IP address
+func isLoopback(addr string) bool {
+	if addr == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.IsLoopback()
+}
+
+// startMetricsInterface serves the metrics on http://addr/metrics
+func startMetricsInterface(addr string, d *daemon.Daemon, errC chan<- error) (*http.Server, error) {
+	metrics.OnCollect(d.CollectMetrics)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Starting metrics interface on http://%s/metrics", addr)
+	srv := &http.Server{Handler: mux}
+	go func() {
+		if err := srv.Serve(l); err != http.ErrServerClosed {
+			errC <- err
+		}
+	}()
+	return srv, nil
+}
+
 func main() {
-	devConfig.Parse()
+	if err := devConfig.Parse(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 	Run(&devConfig)
 }
 
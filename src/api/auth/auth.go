@@ -0,0 +1,281 @@
+// Package auth authenticates the requests of the web interface and webrpc
+// with API keys, and checks the scopes the keys are granted
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skycoin/skycoin/src/util/logging"
+)
+
+var logger = logging.MustGetLogger("audit")
+
+// Scope is a set of operations a key is granted
+type Scope string
+
+const (
+	// ScopePublic operations don't require a key
+	ScopePublic Scope = ""
+	// ScopeRead is granted the read-only queries of the blockchain, the
+	// unconfirmed transactions and the network
+	ScopeRead Scope = "read"
+	// ScopeWalletRead is granted reading the wallets and their balances
+	ScopeWalletRead Scope = "wallet-read"
+	// ScopeWalletSpend is granted changing the wallets, spending from them
+	// and broadcasting transactions
+	ScopeWalletSpend Scope = "wallet-spend"
+	// ScopeAdmin is granted every operation, including the node settings
+	ScopeAdmin Scope = "admin"
+)
+
+// MinSecretLen is the minimum length of the secret of a key
+const MinSecretLen = 16
+
+var (
+	// ErrUnauthenticated is returned for a request without valid credentials
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrForbidden is returned for a request whose key isn't granted the scope
+	ErrForbidden = errors.New("forbidden")
+)
+
+var scopes = map[Scope]bool{
+	ScopeRead:        true,
+	ScopeWalletRead:  true,
+	ScopeWalletSpend: true,
+	ScopeAdmin:       true,
+}
+
+// Key is an API key
+type Key struct {
+	Name   string
+	Secret string
+	Scopes []Scope
+}
+
+// Allows returns true if the key is granted scope.  The admin scope is
+// granted every scope.
+func (k *Key) Allows(scope Scope) bool {
+	if scope == ScopePublic {
+		return true
+	}
+
+	for _, s := range k.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseKey parses a key written as name:secret:scope+scope
+func ParseKey(s string) (Key, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 {
+		return Key{}, errors.New("expected name:secret:scopes")
+	}
+
+	k := Key{
+		Name:   parts[0],
+		Secret: parts[1],
+	}
+	for _, scope := range strings.Split(parts[2], "+") {
+		k.Scopes = append(k.Scopes, Scope(scope))
+	}
+
+	return k, nil
+}
+
+// ParseKeys parses a comma separated list of keys
+func ParseKeys(s string) ([]Key, error) {
+	var keys []Key
+	for _, ks := range strings.Split(s, ",") {
+		ks = strings.TrimSpace(ks)
+		if ks == "" {
+			continue
+		}
+
+		k, err := ParseKey(ks)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+
+	return keys, nil
+}
+
+// Keys authenticates requests with the API keys
+type Keys struct {
+	keys []Key
+	// sha256 of the secrets, compared in constant time
+	hashes [][sha256.Size]byte
+
+	auditMu sync.Mutex
+	audit   io.Writer
+}
+
+// NewKeys validates keys and creates the Keys that authenticate with them
+func NewKeys(keys []Key) (*Keys, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no keys")
+	}
+
+	ks := &Keys{}
+	names := make(map[string]bool, len(keys))
+	// a Bearer token is matched by secret only, so two keys can't share one
+	secrets := make(map[[sha256.Size]byte]string, len(keys))
+	for _, k := range keys {
+		switch {
+		case k.Name == "":
+			return nil, errors.New("key without name")
+		case strings.ContainsAny(k.Name, ":,"):
+			return nil, fmt.Errorf("key %s: name can't contain ':' or ','", k.Name)
+		case names[k.Name]:
+			return nil, fmt.Errorf("key %s is duplicated", k.Name)
+		case len(k.Secret) < MinSecretLen:
+			return nil, fmt.Errorf("key %s: secret must be at least %d characters", k.Name, MinSecretLen)
+		case len(k.Scopes) == 0:
+			return nil, fmt.Errorf("key %s has no scopes", k.Name)
+		}
+
+		for _, s := range k.Scopes {
+			if !scopes[s] {
+				return nil, fmt.Errorf("key %s: invalid scope %q", k.Name, s)
+			}
+		}
+
+		h := sha256.Sum256([]byte(k.Secret))
+		if name, ok := secrets[h]; ok {
+			return nil, fmt.Errorf("key %s has the secret of key %s", k.Name, name)
+		}
+
+		names[k.Name] = true
+		secrets[h] = k.Name
+		ks.keys = append(ks.keys, k)
+		ks.hashes = append(ks.hashes, h)
+	}
+
+	return ks, nil
+}
+
+// SetAuditLog sets the writer the rejected requests are written to, as JSON
+// lines, in addition to the audit log module
+func (ks *Keys) SetAuditLog(w io.Writer) {
+	ks.auditMu.Lock()
+	defer ks.auditMu.Unlock()
+	ks.audit = w
+}
+
+// Authenticate returns the key of the request's credentials, sent as
+// "Authorization: Bearer <secret>" or as HTTP basic auth with the key name as
+// user.  Returns nil if the request has no credentials, and ErrUnauthenticated
+// if they are invalid.
+func (ks *Keys) Authenticate(r *http.Request) (*Key, error) {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(auth, "Bearer ") {
+		if k := ks.match("", auth[len("Bearer "):]); k != nil {
+			return k, nil
+		}
+		return nil, ErrUnauthenticated
+	}
+
+	if name, secret, ok := r.BasicAuth(); ok {
+		if k := ks.match(name, secret); k != nil {
+			return k, nil
+		}
+	}
+
+	return nil, ErrUnauthenticated
+}
+
+// match returns the key with secret, and with name if it is not empty.  All
+// the keys are compared so that the time doesn't depend on which one matches.
+func (ks *Keys) match(name, secret string) *Key {
+	h := sha256.Sum256([]byte(secret))
+	var key *Key
+	for i := range ks.keys {
+		ok := subtle.ConstantTimeCompare(h[:], ks.hashes[i][:]) == 1
+		if ok && (name == "" || name == ks.keys[i].Name) {
+			key = &ks.keys[i]
+		}
+	}
+	return key
+}
+
+// Authorize checks that the request is authenticated with a key granted
+// scope.  resource is the route or the method the request is for, rejected
+// requests are recorded in the audit log.
+func (ks *Keys) Authorize(r *http.Request, resource string, scope Scope) (*Key, error) {
+	if scope == ScopePublic {
+		return nil, nil
+	}
+
+	k, err := ks.Authenticate(r)
+	if err == nil && k == nil {
+		err = ErrUnauthenticated
+	}
+	if err != nil {
+		ks.Reject(r, nil, resource, scope, err)
+		return nil, err
+	}
+
+	if !k.Allows(scope) {
+		ks.Reject(r, k, resource, scope, ErrForbidden)
+		return k, ErrForbidden
+	}
+
+	return k, nil
+}
+
+// AuditRecord is a rejected request
+type AuditRecord struct {
+	Time     time.Time `json:"time"`
+	Remote   string    `json:"remote"`
+	Key      string    `json:"key,omitempty"`
+	Resource string    `json:"resource"`
+	Scope    Scope     `json:"scope"`
+	Reason   string    `json:"reason"`
+}
+
+// Reject records a request rejected with err in the audit log.  key is the
+// key the request was authenticated with, nil if it wasn't.
+func (ks *Keys) Reject(r *http.Request, key *Key, resource string, scope Scope, err error) {
+	rec := AuditRecord{
+		Time:     time.Now().UTC(),
+		Remote:   r.RemoteAddr,
+		Resource: resource,
+		Scope:    scope,
+		Reason:   err.Error(),
+	}
+	if key != nil {
+		rec.Key = key.Name
+	}
+
+	logger.Warning("Rejected %s request from %s: %v", resource, rec.Remote, err,
+		logging.F("remote", rec.Remote), logging.F("key", rec.Key),
+		logging.F("resource", resource), logging.F("scope", string(scope)))
+
+	ks.auditMu.Lock()
+	defer ks.auditMu.Unlock()
+	if ks.audit == nil {
+		return
+	}
+
+	b, _ := json.Marshal(rec)
+	if _, err := ks.audit.Write(append(b, '\n')); err != nil {
+		logger.Error("Write audit log failed: %v", err)
+	}
+}
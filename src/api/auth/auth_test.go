@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeys(t *testing.T) {
+	keys, err := ParseKeys("explorer:0123456789abcdef:read, bot:fedcba9876543210:wallet-read+wallet-spend,")
+	require.NoError(t, err)
+	require.Equal(t, []Key{
+		{Name: "explorer", Secret: "0123456789abcdef", Scopes: []Scope{ScopeRead}},
+		{Name: "bot", Secret: "fedcba9876543210", Scopes: []Scope{ScopeWalletRead, ScopeWalletSpend}},
+	}, keys)
+
+	_, err = ParseKeys("explorer:0123456789abcdef")
+	require.Error(t, err)
+}
+
+func TestNewKeys(t *testing.T) {
+	cases := []struct {
+		name string
+		keys []Key
+		err  string
+	}{
+		{"no keys", nil, "no keys"},
+		{"no name", []Key{{Secret: "0123456789abcdef", Scopes: []Scope{ScopeRead}}}, "key without name"},
+		{"short secret", []Key{{Name: "a", Secret: "short", Scopes: []Scope{ScopeRead}}}, "key a: secret must be at least 16 characters"},
+		{"no scopes", []Key{{Name: "a", Secret: "0123456789abcdef"}}, "key a has no scopes"},
+		{"invalid scope", []Key{{Name: "a", Secret: "0123456789abcdef", Scopes: []Scope{"write"}}}, `key a: invalid scope "write"`},
+		{"duplicated", []Key{
+			{Name: "a", Secret: "0123456789abcdef", Scopes: []Scope{ScopeRead}},
+			{Name: "a", Secret: "fedcba9876543210", Scopes: []Scope{ScopeRead}},
+		}, "key a is duplicated"},
+		{"duplicated secret", []Key{
+			{Name: "a", Secret: "0123456789abcdef", Scopes: []Scope{ScopeRead}},
+			{Name: "b", Secret: "0123456789abcdef", Scopes: []Scope{ScopeAdmin}},
+		}, "key b has the secret of key a"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewKeys(tc.keys)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestKeyAllows(t *testing.T) {
+	k := Key{Scopes: []Scope{ScopeWalletRead}}
+	require.True(t, k.Allows(ScopePublic))
+	require.True(t, k.Allows(ScopeWalletRead))
+	require.False(t, k.Allows(ScopeRead))
+	require.False(t, k.Allows(ScopeWalletSpend))
+
+	admin := Key{Scopes: []Scope{ScopeAdmin}}
+	require.True(t, admin.Allows(ScopeWalletSpend))
+}
+
+func TestAuthorize(t *testing.T) {
+	keys, err := NewKeys([]Key{
+		{Name: "reader", Secret: "reader-secret-0123", Scopes: []Scope{ScopeRead}},
+		{Name: "admin", Secret: "admin-secret-01234", Scopes: []Scope{ScopeAdmin}},
+	})
+	require.NoError(t, err)
+
+	audit := &bytes.Buffer{}
+	keys.SetAuditLog(audit)
+
+	bearer := func(secret string) func(*http.Request) {
+		return func(r *http.Request) {
+			r.Header.Set("Authorization", "Bearer "+secret)
+		}
+	}
+	basic := func(name, secret string) func(*http.Request) {
+		return func(r *http.Request) {
+			r.SetBasicAuth(name, secret)
+		}
+	}
+
+	cases := []struct {
+		name  string
+		auth  func(*http.Request)
+		scope Scope
+		key   string
+		err   error
+	}{
+		{"public", nil, ScopePublic, "", nil},
+		{"no credentials", nil, ScopeRead, "", ErrUnauthenticated},
+		{"bearer", bearer("reader-secret-0123"), ScopeRead, "reader", nil},
+		{"bearer wrong secret", bearer("reader-secret-012"), ScopeRead, "", ErrUnauthenticated},
+		{"bearer forbidden", bearer("reader-secret-0123"), ScopeWalletSpend, "reader", ErrForbidden},
+		{"basic", basic("admin", "admin-secret-01234"), ScopeWalletSpend, "admin", nil},
+		{"basic other key's secret", basic("admin", "reader-secret-0123"), ScopeRead, "", ErrUnauthenticated},
+		{"unknown scheme", func(r *http.Request) { r.Header.Set("Authorization", "Token x") }, ScopeRead, "", ErrUnauthenticated},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/wallet/spend", nil)
+			if tc.auth != nil {
+				tc.auth(r)
+			}
+
+			k, err := keys.Authorize(r, "/wallet/spend", tc.scope)
+			require.Equal(t, tc.err, err)
+			if tc.key == "" {
+				require.Nil(t, k)
+			} else {
+				require.Equal(t, tc.key, k.Name)
+			}
+		})
+	}
+
+	var recs []AuditRecord
+	d := json.NewDecoder(audit)
+	for d.More() {
+		var rec AuditRecord
+		require.NoError(t, d.Decode(&rec))
+		recs = append(recs, rec)
+	}
+
+	require.Len(t, recs, 5)
+	require.Equal(t, "/wallet/spend", recs[0].Resource)
+	require.Equal(t, ScopeRead, recs[0].Scope)
+	require.Equal(t, "unauthenticated", recs[0].Reason)
+	require.Equal(t, "192.0.2.1:1234", recs[0].Remote)
+	require.Equal(t, "reader", recs[2].Key)
+	require.Equal(t, "forbidden", recs[2].Reason)
+}
@@ -48,6 +48,16 @@ func TestLoadConfig(t *testing.T) {
 		require.Equal(t, cfg.RpcAddress, val)
 	})
 
+	t.Run("set RPC_TOKEN", func(t *testing.T) {
+		val := "0123456789abcdef"
+		os.Setenv("RPC_TOKEN", val)
+		defer os.Unsetenv("RPC_TOKEN")
+
+		cfg, err := LoadConfig()
+		require.NoError(t, err)
+		require.Equal(t, cfg.RpcToken, val)
+	})
+
 	t.Run("set WALLET_DIR", func(t *testing.T) {
 		val := "/home/foo/bar"
 		os.Setenv("WALLET_DIR", val)
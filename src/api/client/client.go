@@ -0,0 +1,449 @@
+// Package client is a client of the REST API of the web interface, served
+// under /api/v1
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/gui"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+const apiPrefix = "/api/v1"
+
+// Client calls the REST API of a node.  The calls are canceled when their
+// context is done.
+type Client struct {
+	// Addr is the URL of the web interface, e.g. http://127.0.0.1:7520
+	Addr string
+	// Token is the secret of an API key, sent as a bearer token.  The POST
+	// requests of a client without token get a CSRF token first.
+	Token string
+	// HTTPClient sends the requests, http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// NewClient creates a client of the web interface at addr
+func NewClient(addr string) *Client {
+	return &Client{Addr: strings.TrimSuffix(addr, "/")}
+}
+
+// APIError is an error response of the API
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	Message    string
+}
+
+func (e APIError) Error() string {
+	return fmt.Sprintf("%d %s", e.StatusCode, e.Message)
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// do sends a request to path and decodes the JSON response into v, unless v
+// is nil
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body interface{}, v interface{}) error {
+	rsp, err := c.send(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if v == nil {
+		_, err := io.Copy(ioutil.Discard, rsp.Body)
+		return err
+	}
+
+	if err := json.NewDecoder(rsp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode response of %s failed: %v", path, err)
+	}
+	return nil
+}
+
+// send sends a request to path, with body encoded as JSON if it's not nil.
+// The response is returned if its status is 200 OK, otherwise its APIError.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, body interface{}) (*http.Response, error) {
+	u := c.Addr + apiPrefix + path
+	if len(query) != 0 {
+		u += "?" + query.Encode()
+	}
+
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, u, r)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	} else if method == http.MethodPost {
+		token, err := c.CSRF(ctx)
+		switch err := err.(type) {
+		case nil:
+			req.Header.Set("X-CSRF-Token", token)
+		case APIError:
+			// CSRF tokens are disabled
+			if err.StatusCode != http.StatusNotFound {
+				return nil, err
+			}
+		default:
+			return nil, err
+		}
+	}
+
+	rsp, err := c.httpClient().Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		defer rsp.Body.Close()
+		return nil, readError(rsp)
+	}
+
+	return rsp, nil
+}
+
+// readError returns the APIError of an error response
+func readError(rsp *http.Response) error {
+	b, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	var e gui.ErrorResponse
+	if err := json.Unmarshal(b, &e); err != nil || e.Error.Code == 0 {
+		return APIError{
+			StatusCode: rsp.StatusCode,
+			Message:    strings.TrimSpace(string(b)),
+		}
+	}
+
+	return APIError{
+		StatusCode: rsp.StatusCode,
+		Message:    e.Error.Message,
+	}
+}
+
+// CSRF returns a CSRF token
+func (c *Client) CSRF(ctx context.Context) (string, error) {
+	var rsp gui.CSRFResponse
+	if err := c.do(ctx, http.MethodGet, "/csrf", nil, nil, &rsp); err != nil {
+		return "", err
+	}
+	return rsp.Token, nil
+}
+
+// Version returns the version of the node
+func (c *Client) Version(ctx context.Context) (*visor.BuildInfo, error) {
+	var bi visor.BuildInfo
+	if err := c.do(ctx, http.MethodGet, "/version", nil, nil, &bi); err != nil {
+		return nil, err
+	}
+	return &bi, nil
+}
+
+// Outputs returns the unspent outputs of addrs or with hashes, all of them
+// if both are empty
+func (c *Client) Outputs(ctx context.Context, addrs, hashes []string) (*visor.ReadableOutputSet, error) {
+	q := url.Values{}
+	if len(addrs) != 0 {
+		q.Set("addrs", strings.Join(addrs, ","))
+	}
+	if len(hashes) != 0 {
+		q.Set("hashes", strings.Join(hashes, ","))
+	}
+
+	var outs visor.ReadableOutputSet
+	if err := c.do(ctx, http.MethodGet, "/outputs", q, nil, &outs); err != nil {
+		return nil, err
+	}
+	return &outs, nil
+}
+
+// Balance returns the confirmed and predicted balance of addrs
+func (c *Client) Balance(ctx context.Context, addrs []string) (*wallet.BalancePair, error) {
+	q := url.Values{"addrs": {strings.Join(addrs, ",")}}
+	var bal wallet.BalancePair
+	if err := c.do(ctx, http.MethodGet, "/balance", q, nil, &bal); err != nil {
+		return nil, err
+	}
+	return &bal, nil
+}
+
+// AddressPending returns the outputs of addrs spent and received by the
+// unconfirmed transactions, and the transactions
+func (c *Client) AddressPending(ctx context.Context, addrs []string) (*visor.PendingTxns, error) {
+	q := url.Values{"addrs": {strings.Join(addrs, ",")}}
+	var pending visor.PendingTxns
+	if err := c.do(ctx, http.MethodGet, "/address_pending", q, nil, &pending); err != nil {
+		return nil, err
+	}
+	return &pending, nil
+}
+
+// BlockchainMetadata returns the head block and the unspent output and
+// unconfirmed transaction counts
+func (c *Client) BlockchainMetadata(ctx context.Context) (*visor.BlockchainMetadata, error) {
+	var bm visor.BlockchainMetadata
+	if err := c.do(ctx, http.MethodGet, "/blockchain/metadata", nil, nil, &bm); err != nil {
+		return nil, err
+	}
+	return &bm, nil
+}
+
+// BlockchainProgress returns the sync progress of the blockchain
+func (c *Client) BlockchainProgress(ctx context.Context) (*daemon.BlockchainProgress, error) {
+	var bp daemon.BlockchainProgress
+	if err := c.do(ctx, http.MethodGet, "/blockchain/progress", nil, nil, &bp); err != nil {
+		return nil, err
+	}
+	return &bp, nil
+}
+
+// BlockByHash returns the block with hash
+func (c *Client) BlockByHash(ctx context.Context, hash string) (*visor.ReadableBlock, error) {
+	return c.block(ctx, url.Values{"hash": {hash}})
+}
+
+// BlockBySeq returns the block with seq
+func (c *Client) BlockBySeq(ctx context.Context, seq uint64) (*visor.ReadableBlock, error) {
+	return c.block(ctx, url.Values{"seq": {strconv.FormatUint(seq, 10)}})
+}
+
+func (c *Client) block(ctx context.Context, q url.Values) (*visor.ReadableBlock, error) {
+	var b visor.ReadableBlock
+	if err := c.do(ctx, http.MethodGet, "/block", q, nil, &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Blocks returns the blocks from seq start to end
+func (c *Client) Blocks(ctx context.Context, start, end uint64) (*visor.ReadableBlocks, error) {
+	q := url.Values{
+		"start": {strconv.FormatUint(start, 10)},
+		"end":   {strconv.FormatUint(end, 10)},
+	}
+
+	var bs visor.ReadableBlocks
+	if err := c.do(ctx, http.MethodGet, "/blocks", q, nil, &bs); err != nil {
+		return nil, err
+	}
+	return &bs, nil
+}
+
+// LastBlocks returns the last n blocks
+func (c *Client) LastBlocks(ctx context.Context, n uint64) (*visor.ReadableBlocks, error) {
+	q := url.Values{"num": {strconv.FormatUint(n, 10)}}
+	var bs visor.ReadableBlocks
+	if err := c.do(ctx, http.MethodGet, "/last_blocks", q, nil, &bs); err != nil {
+		return nil, err
+	}
+	return &bs, nil
+}
+
+// AddressTransactions returns the transactions of addr
+func (c *Client) AddressTransactions(ctx context.Context, addr string) ([]gui.ReadableTransaction, error) {
+	q := url.Values{"address": {addr}}
+	var txns []gui.ReadableTransaction
+	if err := c.do(ctx, http.MethodGet, "/explorer/address", q, nil, &txns); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// CoinSupply returns the coin supply
+func (c *Client) CoinSupply(ctx context.Context) (*gui.CoinSupply, error) {
+	var cs gui.CoinSupply
+	if err := c.do(ctx, http.MethodGet, "/coin_supply", nil, nil, &cs); err != nil {
+		return nil, err
+	}
+	return &cs, nil
+}
+
+// NetworkConnection returns the connection to the peer at addr
+func (c *Client) NetworkConnection(ctx context.Context, addr string) (*daemon.Connection, error) {
+	q := url.Values{"addr": {addr}}
+	var conn daemon.Connection
+	if err := c.do(ctx, http.MethodGet, "/network/connection", q, nil, &conn); err != nil {
+		return nil, err
+	}
+	return &conn, nil
+}
+
+// NetworkConnections returns the connections
+func (c *Client) NetworkConnections(ctx context.Context) (*daemon.Connections, error) {
+	var conns daemon.Connections
+	if err := c.do(ctx, http.MethodGet, "/network/connections", nil, nil, &conns); err != nil {
+		return nil, err
+	}
+	return &conns, nil
+}
+
+// NetworkDefaultConnections returns the default peers
+func (c *Client) NetworkDefaultConnections(ctx context.Context) ([]string, error) {
+	return c.addrs(ctx, "/network/default_connections")
+}
+
+// NetworkTrustedConnections returns the trusted peers
+func (c *Client) NetworkTrustedConnections(ctx context.Context) ([]string, error) {
+	return c.addrs(ctx, "/network/connections/trust")
+}
+
+// NetworkExchangeableConnections returns the peers that can be exchanged
+// with other peers
+func (c *Client) NetworkExchangeableConnections(ctx context.Context) ([]string, error) {
+	return c.addrs(ctx, "/network/connections/exchange")
+}
+
+func (c *Client) addrs(ctx context.Context, path string) ([]string, error) {
+	var addrs []string
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &addrs); err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+// NetworkCompression returns the compression stats of sent messages, by
+// message type
+func (c *Client) NetworkCompression(ctx context.Context) (map[string]gnet.CompressionStat, error) {
+	var stats map[string]gnet.CompressionStat
+	if err := c.do(ctx, http.MethodGet, "/network/compression", nil, nil, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// PendingTransactions returns the unconfirmed transactions
+func (c *Client) PendingTransactions(ctx context.Context) ([]visor.ReadableUnconfirmedTxn, error) {
+	var txns []visor.ReadableUnconfirmedTxn
+	if err := c.do(ctx, http.MethodGet, "/pending_txs", nil, nil, &txns); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// Transaction returns the transaction with txid
+func (c *Client) Transaction(ctx context.Context, txid string) (*visor.TransactionResult, error) {
+	q := url.Values{"txid": {txid}}
+	var txn visor.TransactionResult
+	if err := c.do(ctx, http.MethodGet, "/transaction", q, nil, &txn); err != nil {
+		return nil, err
+	}
+	return &txn, nil
+}
+
+// RawTransaction returns the transaction with txid, hex encoded
+func (c *Client) RawTransaction(ctx context.Context, txid string) (string, error) {
+	q := url.Values{"txid": {txid}}
+	var rawtx string
+	if err := c.do(ctx, http.MethodGet, "/rawtx", q, nil, &rawtx); err != nil {
+		return "", err
+	}
+	return rawtx, nil
+}
+
+// InjectTransaction broadcasts the hex encoded transaction rawtx and returns
+// its id
+func (c *Client) InjectTransaction(ctx context.Context, rawtx string) (string, error) {
+	body := struct {
+		Rawtx string `json:"rawtx"`
+	}{rawtx}
+
+	var txid string
+	if err := c.do(ctx, http.MethodPost, "/inject_transaction", nil, body, &txid); err != nil {
+		return "", err
+	}
+	return txid, nil
+}
+
+// ResendUnconfirmedTransactions announces the unconfirmed transactions to
+// the peers again
+func (c *Client) ResendUnconfirmedTransactions(ctx context.Context) (*daemon.ResendResult, error) {
+	var rlt daemon.ResendResult
+	if err := c.do(ctx, http.MethodPost, "/resend_unconfirmed_txns", nil, nil, &rlt); err != nil {
+		return nil, err
+	}
+	return &rlt, nil
+}
+
+// UxOut returns the output with uxid
+func (c *Client) UxOut(ctx context.Context, uxid string) (*historydb.UxOutJSON, error) {
+	q := url.Values{"uxid": {uxid}}
+	var ux historydb.UxOutJSON
+	if err := c.do(ctx, http.MethodGet, "/uxout", q, nil, &ux); err != nil {
+		return nil, err
+	}
+	return &ux, nil
+}
+
+// AddressUxOuts returns the outputs addr received, spent or not
+func (c *Client) AddressUxOuts(ctx context.Context, addr string) ([]historydb.UxOutJSON, error) {
+	q := url.Values{"address": {addr}}
+	var uxs []historydb.UxOutJSON
+	if err := c.do(ctx, http.MethodGet, "/address_uxouts", q, nil, &uxs); err != nil {
+		return nil, err
+	}
+	return uxs, nil
+}
+
+// LogLevels returns the log level of each module, "" is the level of the
+// modules without their own level
+func (c *Client) LogLevels(ctx context.Context) (map[string]string, error) {
+	var levels map[string]string
+	if err := c.do(ctx, http.MethodGet, "/logging/levels", nil, nil, &levels); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+// SetLogLevel sets the log level of module, of the modules without their own
+// level if module is "", and returns the log levels
+func (c *Client) SetLogLevel(ctx context.Context, module, level string) (map[string]string, error) {
+	body := struct {
+		Module string `json:"module"`
+		Level  string `json:"level"`
+	}{module, level}
+
+	var levels map[string]string
+	if err := c.do(ctx, http.MethodPost, "/logging/levels", nil, body, &levels); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
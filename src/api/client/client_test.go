@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/gui"
+	"github.com/skycoin/skycoin/src/testutil/harness"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+// newTestServer serves the web interface of the master of a new cluster
+func newTestServer(t *testing.T, c gui.MuxConfig) (*harness.Cluster, *httptest.Server, func()) {
+	cluster := harness.New(t, 1, nil)
+
+	dir, err := ioutil.TempDir("", "client")
+	require.NoError(t, err)
+
+	mux := gui.NewGUIMux(dir, cluster.Master().Daemon.Gateway, c)
+	srv := httptest.NewServer(mux)
+	return cluster, srv, func() {
+		mux.Close()
+		srv.Close()
+		cluster.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestClient(t *testing.T) {
+	cluster, srv, done := newTestServer(t, gui.MuxConfig{})
+	defer done()
+
+	c := NewClient(srv.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := c.Version(ctx)
+	require.NoError(t, err)
+
+	// the POST requests get a CSRF token
+	seed, err := c.NewSeed(ctx)
+	require.NoError(t, err)
+	w, err := c.CreateWallet(ctx, seed, "client")
+	require.NoError(t, err)
+	id := w.Meta["filename"]
+	require.Len(t, w.Entries, 1)
+
+	addrs, err := c.NewAddresses(ctx, id, 2)
+	require.NoError(t, err)
+	require.Len(t, addrs, 2)
+	require.NoError(t, c.UpdateWallet(ctx, id, "updated"))
+
+	wlt, err := c.Wallet(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "updated", wlt.Meta["label"])
+	require.Len(t, wlt.Entries, 3)
+
+	wlts, err := c.Wallets(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, w := range wlts {
+		ids = append(ids, w.Meta["filename"])
+	}
+	require.Contains(t, ids, id)
+
+	folder, err := c.WalletFolder(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, folder)
+
+	// blocks
+	from := w.Entries[0].Address
+	fundTxn, fundBlock := cluster.FundAddress(cipher.MustDecodeBase58Address(from), 10e6)
+
+	bm, err := c.BlockchainMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, fundBlock.Seq(), bm.Head.BkSeq)
+
+	_, err = c.BlockchainProgress(ctx)
+	require.NoError(t, err)
+
+	b, err := c.BlockBySeq(ctx, fundBlock.Seq())
+	require.NoError(t, err)
+	require.Equal(t, fundBlock.HashHeader().Hex(), b.Head.BlockHash)
+
+	b, err = c.BlockByHash(ctx, fundBlock.HashHeader().Hex())
+	require.NoError(t, err)
+	require.Equal(t, fundBlock.Seq(), b.Head.BkSeq)
+
+	bs, err := c.Blocks(ctx, 0, fundBlock.Seq())
+	require.NoError(t, err)
+	require.Len(t, bs.Blocks, int(fundBlock.Seq())+1)
+
+	bs, err = c.LastBlocks(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, bs.Blocks, 1)
+	require.Equal(t, fundBlock.Seq(), bs.Blocks[0].Head.BkSeq)
+
+	_, err = c.CoinSupply(ctx)
+	require.NoError(t, err)
+
+	// balances and outputs
+	bal, err := c.WalletBalance(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10e6), bal.Confirmed.Coins)
+
+	bal, err = c.Balance(ctx, []string{from})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10e6), bal.Confirmed.Coins)
+
+	outs, err := c.Outputs(ctx, []string{from}, nil)
+	require.NoError(t, err)
+	require.Len(t, outs.HeadOutputs, 1)
+
+	uxs, err := c.AddressUxOuts(ctx, from)
+	require.NoError(t, err)
+	require.Len(t, uxs, 1)
+
+	ux, err := c.UxOut(ctx, uxs[0].Uxid)
+	require.NoError(t, err)
+	require.Equal(t, from, ux.OwnerAddress)
+
+	txns, err := c.AddressTransactions(ctx, from)
+	require.NoError(t, err)
+	require.Len(t, txns, 1)
+	require.Equal(t, fundTxn.Hash().Hex(), txns[0].Hash)
+
+	// transactions
+	rlt, err := c.Spend(ctx, id, addrs[0], 1e6)
+	require.NoError(t, err)
+	txid := rlt.Transaction.Hash
+
+	pending, err := c.PendingTransactions(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, txid, pending[0].Txn.Hash)
+
+	wtxns, err := c.WalletTransactions(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, wtxns, 1)
+
+	ap, err := c.AddressPending(ctx, []string{from})
+	require.NoError(t, err)
+	require.Len(t, ap.Transactions, 1)
+	require.Equal(t, txid, ap.Transactions[0].Transaction.Hash)
+	require.Len(t, ap.Addresses, 1)
+	require.Len(t, ap.Addresses[0].Spends, 1)
+	require.Equal(t, txid, ap.Addresses[0].Spends[0].Txid)
+	require.Equal(t, uint64(10e6), ap.Addresses[0].Outgoing.Coins)
+
+	txn, err := c.Transaction(ctx, txid)
+	require.NoError(t, err)
+	require.True(t, txn.Status.Unconfirmed)
+
+	rawtx, err := c.RawTransaction(ctx, txid)
+	require.NoError(t, err)
+	injected, err := c.InjectTransaction(ctx, rawtx)
+	require.NoError(t, err)
+	require.Equal(t, txid, injected)
+
+	_, err = c.ResendUnconfirmedTransactions(ctx)
+	require.NoError(t, err)
+
+	// network
+	_, err = c.NetworkConnections(ctx)
+	require.NoError(t, err)
+	_, err = c.NetworkDefaultConnections(ctx)
+	require.NoError(t, err)
+	_, err = c.NetworkTrustedConnections(ctx)
+	require.NoError(t, err)
+	_, err = c.NetworkExchangeableConnections(ctx)
+	require.NoError(t, err)
+	_, err = c.NetworkCompression(ctx)
+	require.NoError(t, err)
+
+	_, err = c.LogLevels(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, c.ReloadWallets(ctx))
+
+	// errors
+	_, err = c.Wallet(ctx, "missing.wlt")
+	require.Equal(t, APIError{http.StatusNotFound, "Not Found - wallet missing.wlt doesn't exist"}, err)
+
+	_, err = c.NetworkConnection(ctx, "127.0.0.1:1")
+	require.Equal(t, APIError{http.StatusNotFound, "Not Found"}, err)
+
+	_, err = c.Transaction(ctx, "abc")
+	require.IsType(t, APIError{}, err)
+	require.Equal(t, http.StatusBadRequest, err.(APIError).StatusCode)
+
+	// the calls end with their context
+	canceled, cancelNow := context.WithCancel(ctx)
+	cancelNow()
+	_, err = c.Version(canceled)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestClientAuth(t *testing.T) {
+	keys, err := auth.NewKeys([]auth.Key{
+		{Name: "reader", Secret: "reader-secret-0123", Scopes: []auth.Scope{auth.ScopeRead}},
+		{Name: "spender", Secret: "spender-secret-012", Scopes: []auth.Scope{auth.ScopeWalletSpend}},
+	})
+	require.NoError(t, err)
+
+	_, srv, done := newTestServer(t, gui.MuxConfig{Keys: keys})
+	defer done()
+
+	ctx := context.Background()
+	c := NewClient(srv.URL)
+	_, err = c.Version(ctx)
+	require.Equal(t, APIError{http.StatusUnauthorized, "Unauthorized"}, err)
+
+	c.Token = "reader-secret-0123"
+	_, err = c.Version(ctx)
+	require.NoError(t, err)
+	_, err = c.NewSeed(ctx)
+	require.Equal(t, APIError{http.StatusForbidden, "Forbidden"}, err)
+
+	// a bearer token doesn't need a CSRF token
+	c.Token = "spender-secret-012"
+	_, err = c.CreateWallet(ctx, "seed", "label")
+	require.NoError(t, err)
+}
+
+func TestEvents(t *testing.T) {
+	cluster, srv, done := newTestServer(t, gui.MuxConfig{})
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	c := NewClient(srv.URL)
+	_, err := c.Events(ctx, EventsFilter{})
+	require.IsType(t, APIError{}, err)
+
+	s, err := c.Events(ctx, EventsFilter{Blocks: true})
+	require.NoError(t, err)
+	defer s.Close()
+
+	sb := cluster.GenerateBlocks(1)[0]
+
+	e, err := s.Next()
+	require.NoError(t, err)
+	require.Equal(t, "block", e.Type)
+	require.Equal(t, "1", e.ID)
+
+	var b visor.ReadableBlock
+	require.NoError(t, json.Unmarshal(e.Data, &b))
+	require.Equal(t, sb.HashHeader().Hex(), b.Head.BlockHash)
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EventsFilter selects the events of a stream
+type EventsFilter struct {
+	// Blocks and Txns select the new block and unconfirmed transaction events
+	Blocks bool
+	Txns   bool
+	// Txids are the transactions whose confirmation is sent
+	Txids []string
+	// Addrs are the addresses whose activity is sent
+	Addrs []string
+	// Since is the block seq to resume from, if set
+	Since *uint64
+}
+
+func (f EventsFilter) query() url.Values {
+	q := url.Values{}
+	if f.Blocks {
+		q.Set("blocks", "true")
+	}
+	if f.Txns {
+		q.Set("txns", "true")
+	}
+	if len(f.Txids) != 0 {
+		q.Set("txids", strings.Join(f.Txids, ","))
+	}
+	if len(f.Addrs) != 0 {
+		q.Set("addrs", strings.Join(f.Addrs, ","))
+	}
+	if f.Since != nil {
+		q.Set("since", strconv.FormatUint(*f.Since, 10))
+	}
+	return q
+}
+
+// Event is an event of a stream.  Data is decoded into the type of the
+// event: a visor.ReadableBlock for block events, a visor.ReadableTransaction
+// for txn events, a gui.ConfirmedEvent, a gui.AddressEvent or a
+// gui.StreamErrorEvent.
+type Event struct {
+	// ID is the seq of the block of the event, if it's the last event of
+	// the block
+	ID   string
+	Type string
+	Data json.RawMessage
+}
+
+// EventStream reads the events of a stream
+type EventStream struct {
+	body io.ReadCloser
+	r    *bufio.Reader
+}
+
+// Events opens a stream of the events selected by f.  The stream is closed
+// when ctx is done or by Close.
+func (c *Client) Events(ctx context.Context, f EventsFilter) (*EventStream, error) {
+	rsp, err := c.send(ctx, http.MethodGet, "/events", f.query(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventStream{
+		body: rsp.Body,
+		r:    bufio.NewReader(rsp.Body),
+	}, nil
+}
+
+// Next returns the next event.  Returns io.EOF when the node closed the
+// stream.
+func (s *EventStream) Next() (*Event, error) {
+	var e Event
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line != "" {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			// end of the event, events without data are keep-alives
+			if e.Data != nil {
+				return &e, nil
+			}
+			e = Event{}
+		case strings.HasPrefix(line, ":"):
+			// comment
+		case strings.HasPrefix(line, "id: "):
+			e.ID = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			e.Type = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			e.Data = json.RawMessage(strings.TrimPrefix(line, "data: "))
+		}
+	}
+}
+
+// Close closes the stream
+func (s *EventStream) Close() error {
+	return s.body.Close()
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/skycoin/skycoin/src/gui"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+// Wallet returns the wallet id
+func (c *Client) Wallet(ctx context.Context, id string) (*wallet.Wallet, error) {
+	q := url.Values{"id": {id}}
+	var w wallet.Wallet
+	if err := c.do(ctx, http.MethodGet, "/wallet", q, nil, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// Wallets returns the loaded wallets
+func (c *Client) Wallets(ctx context.Context) ([]wallet.ReadableWallet, error) {
+	var ws []wallet.ReadableWallet
+	if err := c.do(ctx, http.MethodGet, "/wallets", nil, nil, &ws); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// WalletBalance returns the confirmed and predicted balance of the wallet id
+func (c *Client) WalletBalance(ctx context.Context, id string) (*wallet.BalancePair, error) {
+	q := url.Values{"id": {id}}
+	var bal wallet.BalancePair
+	if err := c.do(ctx, http.MethodGet, "/wallet/balance", q, nil, &bal); err != nil {
+		return nil, err
+	}
+	return &bal, nil
+}
+
+// WalletTransactions returns the unconfirmed transactions of the wallet id
+func (c *Client) WalletTransactions(ctx context.Context, id string) ([]visor.UnconfirmedTxnResult, error) {
+	q := url.Values{"id": {id}}
+	var txns []visor.UnconfirmedTxnResult
+	if err := c.do(ctx, http.MethodGet, "/wallet/transactions", q, nil, &txns); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// WalletFolder returns the wallet directory of the node
+func (c *Client) WalletFolder(ctx context.Context) (string, error) {
+	var f gui.WalletFolder
+	if err := c.do(ctx, http.MethodGet, "/wallets/folder_name", nil, nil, &f); err != nil {
+		return "", err
+	}
+	return f.Address, nil
+}
+
+// NewSeed returns a new random seed
+func (c *Client) NewSeed(ctx context.Context) (string, error) {
+	var rsp gui.NewSeedResponse
+	if err := c.do(ctx, http.MethodGet, "/wallet/new_seed", nil, nil, &rsp); err != nil {
+		return "", err
+	}
+	return rsp.Seed, nil
+}
+
+// CreateWallet creates a wallet from seed
+func (c *Client) CreateWallet(ctx context.Context, seed, label string) (*wallet.ReadableWallet, error) {
+	body := struct {
+		Seed  string `json:"seed"`
+		Label string `json:"label"`
+	}{seed, label}
+
+	var w wallet.ReadableWallet
+	if err := c.do(ctx, http.MethodPost, "/wallet/create", nil, body, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// NewAddresses creates n addresses in the wallet id
+func (c *Client) NewAddresses(ctx context.Context, id string, n int) ([]string, error) {
+	body := struct {
+		ID  string `json:"id"`
+		Num int    `json:"num"`
+	}{id, n}
+
+	var rsp gui.NewAddressesResponse
+	if err := c.do(ctx, http.MethodPost, "/wallet/new_address", nil, body, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Addresses, nil
+}
+
+// UpdateWallet changes the label of the wallet id
+func (c *Client) UpdateWallet(ctx context.Context, id, label string) error {
+	body := struct {
+		ID    string `json:"id"`
+		Label string `json:"label"`
+	}{id, label}
+
+	return c.do(ctx, http.MethodPost, "/wallet/update", nil, body, nil)
+}
+
+// Spend sends coins, in droplets, from the wallet id to dst
+func (c *Client) Spend(ctx context.Context, id, dst string, coins uint64) (*gui.SpendResult, error) {
+	body := struct {
+		ID    string `json:"id"`
+		Dst   string `json:"dst"`
+		Coins uint64 `json:"coins"`
+	}{id, dst, coins}
+
+	var rlt gui.SpendResult
+	if err := c.do(ctx, http.MethodPost, "/wallet/spend", nil, body, &rlt); err != nil {
+		return nil, err
+	}
+	return &rlt, nil
+}
+
+// ReloadWallets loads and unloads the wallets of the wallet directory
+func (c *Client) ReloadWallets(ctx context.Context) error {
+	return c.do(ctx, http.MethodPost, "/wallets/reload", nil, nil, nil)
+}
@@ -0,0 +1,319 @@
+package webrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+var ErrJSONUnmarshal = errors.New("json unmarshal failed")
+
+type Client struct {
+	Addr string
+	// Token is the secret of an API key, sent in the Authorization header
+	Token    string
+	reqIdCtr int
+}
+
+// Call is a method call sent in a batch
+type Call struct {
+	Method string
+	Params interface{}
+	// The result of the call is decoded into Result
+	Result interface{}
+	// Err is the error of the call
+	Err error
+}
+
+// Batch sends the calls in one request.  The result or the error of each
+// call is set in the call; the returned error is set if the request failed.
+func (c *Client) Batch(calls ...*Call) error {
+	reqs := make([]*Request, len(calls))
+	pending := make(map[ID]*Call, len(calls))
+	for i, call := range calls {
+		c.reqIdCtr++
+		req, err := NewRequest(call.Method, call.Params, strconv.Itoa(c.reqIdCtr))
+		if err != nil {
+			return err
+		}
+		reqs[i] = req
+		pending[req.ID] = call
+	}
+
+	rsps, err := doBatch(reqs, c.Addr, c.Token)
+	if err != nil {
+		return err
+	}
+
+	// the responses of a batch can be in any order
+	for _, rsp := range rsps {
+		call, ok := pending[rsp.ID]
+		if !ok {
+			continue
+		}
+		delete(pending, rsp.ID)
+
+		if rsp.Error != nil {
+			call.Err = rsp.Error
+			continue
+		}
+		call.Err = decodeJson(rsp.Result, call.Result)
+	}
+
+	for _, call := range pending {
+		call.Err = errors.New("no response to the call")
+	}
+	return nil
+}
+
+func (c *Client) Do(obj interface{}, method string, params interface{}) error {
+	c.reqIdCtr++
+	req, err := NewRequest(method, params, strconv.Itoa(c.reqIdCtr))
+	if err != nil {
+		return err
+	}
+
+	rsp, err := do(req, c.Addr, c.Token)
+	if err != nil {
+		return err
+	}
+
+	if rsp.Error != nil {
+		return rsp.Error
+	}
+
+	return decodeJson(rsp.Result, obj)
+}
+
+func (c *Client) GetUnspentOutputs(addrs []string) (*OutputsResult, error) {
+	outputs := OutputsResult{}
+	if err := c.Do(&outputs, "get_outputs", addrs); err != nil {
+		return nil, err
+	}
+
+	return &outputs, nil
+}
+
+// Returns TxId
+func (c *Client) InjectTransaction(rawtx string) (string, error) {
+	params := []string{rawtx}
+	rlt := TxIDJson{}
+
+	if err := c.Do(&rlt, "inject_transaction", params); err != nil {
+		return "", err
+	}
+
+	return rlt.Txid, nil
+}
+
+func (c *Client) GetStatus() (*StatusResult, error) {
+	status := StatusResult{}
+	if err := c.Do(&status, "get_status", nil); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func (c *Client) GetTransactionByID(txid string) (*TxnResult, error) {
+	txn := TxnResult{}
+	if err := c.Do(&txn, "get_transaction", []string{txid}); err != nil {
+		return nil, err
+	}
+
+	return &txn, nil
+}
+
+func (c *Client) GetAddressUxOuts(addrs []string) ([]AddrUxoutResult, error) {
+	uxouts := []AddrUxoutResult{}
+	if err := c.Do(&uxouts, "get_address_uxouts", addrs); err != nil {
+		return nil, err
+	}
+
+	return uxouts, nil
+}
+
+// GetAddressPending returns the outputs of addrs spent and received by the
+// unconfirmed transactions, and the transactions
+func (c *Client) GetAddressPending(addrs []string) (*visor.PendingTxns, error) {
+	pending := visor.PendingTxns{}
+	if err := c.Do(&pending, "get_address_pending", addrs); err != nil {
+		return nil, err
+	}
+
+	return &pending, nil
+}
+
+func (c *Client) GetBlocks(start, end uint64) (*visor.ReadableBlocks, error) {
+	param := []uint64{start, end}
+	blocks := visor.ReadableBlocks{}
+
+	if err := c.Do(&blocks, "get_blocks", param); err != nil {
+		return nil, err
+	}
+
+	return &blocks, nil
+}
+
+func (c *Client) GetBlocksBySeq(ss []uint64) (*visor.ReadableBlocks, error) {
+	blocks := visor.ReadableBlocks{}
+
+	if err := c.Do(&blocks, "get_blocks_by_seq", ss); err != nil {
+		return nil, err
+	}
+
+	return &blocks, nil
+}
+
+func (c *Client) GetLastBlocks(n uint64) (*visor.ReadableBlocks, error) {
+	param := []uint64{n}
+	blocks := visor.ReadableBlocks{}
+	if err := c.Do(&blocks, "get_lastblocks", param); err != nil {
+		return nil, err
+	}
+
+	return &blocks, nil
+}
+
+// CreateWallet creates a wallet from seed
+func (c *Client) CreateWallet(seed, label string) (*wallet.ReadableWallet, error) {
+	wlt := wallet.ReadableWallet{}
+	if err := c.Do(&wlt, "create_wallet", []string{seed, label}); err != nil {
+		return nil, err
+	}
+
+	return &wlt, nil
+}
+
+// NewAddresses generates n addresses in the wallet
+func (c *Client) NewAddresses(wltID string, n int) ([]string, error) {
+	rlt := NewAddressesResult{}
+	if err := c.Do(&rlt, "new_addresses", []interface{}{wltID, n}); err != nil {
+		return nil, err
+	}
+
+	return rlt.Addresses, nil
+}
+
+// GetWalletBalance returns the confirmed and predicted balance of the wallet
+func (c *Client) GetWalletBalance(wltID string) (*wallet.BalancePair, error) {
+	b := wallet.BalancePair{}
+	if err := c.Do(&b, "get_wallet_balance", []string{wltID}); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// Spend sends droplets from the wallet to dst
+func (c *Client) Spend(wltID, dst string, droplets uint64) (*SpendResult, error) {
+	rlt := SpendResult{}
+	if err := c.Do(&rlt, "spend", []interface{}{wltID, dst, droplets}); err != nil {
+		return nil, err
+	}
+
+	return &rlt, nil
+}
+
+// GetWalletUnconfirmedTxns returns the unconfirmed transactions of the wallet
+func (c *Client) GetWalletUnconfirmedTxns(wltID string) ([]visor.UnconfirmedTxnResult, error) {
+	txns := []visor.UnconfirmedTxnResult{}
+	if err := c.Do(&txns, "get_wallet_unconfirmed_txns", []string{wltID}); err != nil {
+		return nil, err
+	}
+
+	return txns, nil
+}
+
+// ReloadWallets loads and unloads the wallets of the wallet directory
+func (c *Client) ReloadWallets() error {
+	var rlt string
+	return c.Do(&rlt, "reload_wallets", nil)
+}
+
+// post sends v to the webrpc service, with token in the Authorization header
+// if it is set
+func post(v interface{}, rpcAddress, token string) (*http.Response, error) {
+	d, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/webrpc", rpcAddress), bytes.NewBuffer(d))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return http.DefaultClient.Do(req)
+}
+
+// Do send request to web
+func Do(req *Request, rpcAddress string) (*Response, error) {
+	return do(req, rpcAddress, "")
+}
+
+func do(req *Request, rpcAddress, token string) (*Response, error) {
+	rsp, err := post(req, rpcAddress, token)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	res := Response{}
+	if err := json.NewDecoder(rsp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DoBatch sends a batch of requests to web
+func DoBatch(reqs []*Request, rpcAddress string) ([]Response, error) {
+	return doBatch(reqs, rpcAddress, "")
+}
+
+func doBatch(reqs []*Request, rpcAddress, token string) ([]Response, error) {
+	rsp, err := post(reqs, rpcAddress, token)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(rsp.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	// a batch that is rejected as a whole gets a single error response
+	if len(raw) > 0 && raw[0] == '{' {
+		res := Response{}
+		if err := json.Unmarshal(raw, &res); err != nil {
+			return nil, err
+		}
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		return nil, errors.New("invalid batch response")
+	}
+
+	var res []Response
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func decodeJson(data []byte, obj interface{}) error {
+	if err := json.NewDecoder(bytes.NewBuffer(data)).Decode(obj); err != nil {
+		return ErrJSONUnmarshal
+	}
+	return nil
+}
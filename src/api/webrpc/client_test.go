@@ -46,6 +46,7 @@ func TestClient(t *testing.T) {
 		{"get blocks", testClientGetBlocks},
 		{"get blocks by seq", testClientGetBlocksBySeq},
 		{"get last block", testClientGetLastBlocks},
+		{"batch", testClientBatch},
 	}
 
 	for _, f := range testFuncs {
@@ -205,3 +206,20 @@ func testClientGetLastBlocks(t *testing.T, c *Client, s *WebRPC, gw *fakeGateway
 	require.Len(t, blocks.Blocks, 1)
 	require.Equal(t, decodeBlock(blockString), blocks)
 }
+
+func testClientBatch(t *testing.T, c *Client, s *WebRPC, gw *fakeGateway) {
+	var last, blocks visor.ReadableBlocks
+	var status StatusResult
+	calls := []*Call{
+		{Method: "get_lastblocks", Params: []uint64{1}, Result: &last},
+		{Method: "get_blocks", Params: []uint64{1, 1}, Result: &blocks},
+		{Method: "no_such_method", Result: &status},
+	}
+
+	require.NoError(t, c.Batch(calls...))
+	require.NoError(t, calls[0].Err)
+	require.Equal(t, decodeBlock(blockString), &last)
+	require.NoError(t, calls[1].Err)
+	require.Equal(t, decodeBlock(blockString), &blocks)
+	require.Equal(t, errCodeMethodNotFound, calls[2].Err.(*RPCError).Code)
+}
@@ -48,6 +48,33 @@ func (m *GatewayerMock) GetAddrUxOuts(p0 cipher.Address) ([]*historydb.UxOutJSON
 
 }
 
+// GetAddressesPending mocked method
+func (m *GatewayerMock) GetAddressesPending(p0 []cipher.Address) (*visor.PendingTxns, error) {
+
+	ret := m.Called(p0)
+
+	var r0 *visor.PendingTxns
+	switch res := ret.Get(0).(type) {
+	case nil:
+	case *visor.PendingTxns:
+		r0 = res
+	default:
+		panic(fmt.Sprintf("unexpected type: %v", res))
+	}
+
+	var r1 error
+	switch res := ret.Get(1).(type) {
+	case nil:
+	case error:
+		r1 = res
+	default:
+		panic(fmt.Sprintf("unexpected type: %v", res))
+	}
+
+	return r0, r1
+
+}
+
 // GetBlocks mocked method
 func (m *GatewayerMock) GetBlocks(p0 uint64, p1 uint64) (*visor.ReadableBlocks, error) {
 
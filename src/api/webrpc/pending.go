@@ -0,0 +1,40 @@
+package webrpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+func getAddressPendingHandler(req Request, gateway Gatewayer) Response {
+	var params []string
+	if err := req.DecodeParams(&params); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(params) == 0 {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+	if len(params) > visor.MaxPendingAddresses {
+		return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("at most %d addresses can be queried", visor.MaxPendingAddresses))
+	}
+
+	addrs := make([]cipher.Address, len(params))
+	for i, p := range params {
+		a, err := cipher.DecodeBase58Address(strings.Trim(p, " "))
+		if err != nil {
+			return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("invalid address: %v", p))
+		}
+		addrs[i] = a
+	}
+
+	pending, err := gateway.GetAddressesPending(addrs)
+	if err != nil {
+		logger.Error("get pending of addresses failed: %v", err)
+		return makeErrorResponse(errCodeInternalError, errMsgInternalError)
+	}
+
+	return makeSuccessResponse(req.ID, pending)
+}
@@ -0,0 +1,78 @@
+package webrpc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+func TestGetAddressPendingHandler(t *testing.T) {
+	addrs := []cipher.Address{testutil.MakeAddress(), testutil.MakeAddress()}
+	failed := testutil.MakeAddress()
+	pending := &visor.PendingTxns{
+		Addresses: []visor.AddressPending{
+			{
+				Address:  addrs[0].String(),
+				Spends:   []visor.PendingOutput{{ReadableOutput: visor.ReadableOutput{Address: addrs[0].String(), Coins: "1.000000"}, Txid: "abc"}},
+				Receipts: []visor.PendingOutput{},
+				Outgoing: wallet.NewBalance(1e6, 0),
+			},
+			{
+				Address:  addrs[1].String(),
+				Spends:   []visor.PendingOutput{},
+				Receipts: []visor.PendingOutput{},
+			},
+		},
+		Transactions: []visor.TransactionResult{},
+	}
+
+	m := NewGatewayerMock()
+	m.On("GetAddressesPending", addrs).Return(pending, nil)
+	m.On("GetAddressesPending", []cipher.Address{failed}).Return(nil, errors.New("db closed"))
+
+	tests := []struct {
+		name   string
+		params string
+		want   Response
+	}{
+		{
+			"addresses",
+			`["` + addrs[0].String() + `", " ` + addrs[1].String() + `"]`,
+			makeSuccessResponse("1", pending),
+		},
+		{
+			"gateway error",
+			`["` + failed.String() + `"]`,
+			makeErrorResponse(errCodeInternalError, errMsgInternalError),
+		},
+		{
+			"invalid address",
+			`["abc"]`,
+			makeErrorResponse(errCodeInvalidParams, "invalid address: abc"),
+		},
+		{
+			"no address",
+			`[]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+		{
+			"too many addresses",
+			`[` + strings.Repeat(`"`+addrs[0].String()+`",`, visor.MaxPendingAddresses) + `"` + addrs[0].String() + `"]`,
+			makeErrorResponse(errCodeInvalidParams, "at most 1000 addresses can be queried"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "get_address_pending", Params: []byte(tt.params)}
+			require.Equal(t, tt.want, getAddressPendingHandler(req, m))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package webrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+// RegtestGatewayer is implemented by the gateway of a node on a regtest
+// network, which creates blocks on request
+type RegtestGatewayer interface {
+	Gatewayer
+	GenerateBlocks(n int) ([]coin.SignedBlock, error)
+	FundAddress(addr cipher.Address, coins uint64) (coin.Transaction, coin.SignedBlock, error)
+	AdvanceTime(d time.Duration) (uint64, error)
+}
+
+// FundAddressResult result struct of fund_address
+type FundAddressResult struct {
+	Txid  string               `json:"txid"`
+	Block *visor.ReadableBlock `json:"block"`
+}
+
+// AdvanceTimeResult result struct of advance_time
+type AdvanceTimeResult struct {
+	Time uint64 `json:"time"`
+}
+
+// EnableRegtest registers the methods that create blocks and move the clock
+// of a regtest node.  They must not be enabled on other networks.
+func (rpc *WebRPC) EnableRegtest() error {
+	handles := map[string]HandlerFunc{
+		// create blocks right away
+		"generate_blocks": regtestHandler(generateBlocksHandler),
+		// send coins from the genesis address
+		"fund_address": regtestHandler(fundAddressHandler),
+		// move the clock of new blocks forward
+		"advance_time": regtestHandler(advanceTimeHandler),
+	}
+
+	for path, handle := range handles {
+		if err := rpc.HandleFunc(path, handle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// regtestHandler adapts h to a HandlerFunc, the method is not found if the
+// gateway doesn't support regtest
+func regtestHandler(h func(Request, RegtestGatewayer) Response) HandlerFunc {
+	return func(req Request, gateway Gatewayer) Response {
+		gw, ok := gateway.(RegtestGatewayer)
+		if !ok {
+			return makeErrorResponse(errCodeMethodNotFound, errMsgMethodNotFound)
+		}
+		return h(req, gw)
+	}
+}
+
+// request params: [number], at most daemon.MaxGenerateBlocks
+func generateBlocksHandler(req Request, gateway RegtestGatewayer) Response {
+	var num []int
+	if err := req.DecodeParams(&num); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(num) != 1 || num[0] <= 0 || num[0] > daemon.MaxGenerateBlocks {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	sbs, err := gateway.GenerateBlocks(num[0])
+	if err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("generate blocks failed: %v", err))
+	}
+
+	blocks := visor.ReadableBlocks{Blocks: make([]visor.ReadableBlock, 0, len(sbs))}
+	for i := range sbs {
+		b, err := visor.NewReadableBlock(&sbs[i].Block)
+		if err != nil {
+			logger.Error("%v", err)
+			return makeErrorResponse(errCodeInternalError, errMsgInternalError)
+		}
+		blocks.Blocks = append(blocks.Blocks, *b)
+	}
+
+	return makeSuccessResponse(req.ID, blocks)
+}
+
+// request params: [address, droplets]
+func fundAddressHandler(req Request, gateway RegtestGatewayer) Response {
+	var params []json.RawMessage
+	if err := req.DecodeParams(&params); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(params) != 2 {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	var addrStr string
+	var coins uint64
+	if err := json.Unmarshal(params[0], &addrStr); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+	if err := json.Unmarshal(params[1], &coins); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	addr, err := cipher.DecodeBase58Address(addrStr)
+	if err != nil {
+		return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("invalid address: %v", err))
+	}
+
+	txn, sb, err := gateway.FundAddress(addr, coins)
+	if err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("fund address failed: %v", err))
+	}
+
+	b, err := visor.NewReadableBlock(&sb.Block)
+	if err != nil {
+		logger.Error("%v", err)
+		return makeErrorResponse(errCodeInternalError, errMsgInternalError)
+	}
+
+	return makeSuccessResponse(req.ID, FundAddressResult{
+		Txid:  txn.Hash().Hex(),
+		Block: b,
+	})
+}
+
+// request params: [seconds]
+func advanceTimeHandler(req Request, gateway RegtestGatewayer) Response {
+	var seconds []int64
+	if err := req.DecodeParams(&seconds); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(seconds) != 1 || seconds[0] < 0 {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	now, err := gateway.AdvanceTime(time.Duration(seconds[0]) * time.Second)
+	if err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("advance time failed: %v", err))
+	}
+
+	return makeSuccessResponse(req.ID, AdvanceTimeResult{Time: now})
+}
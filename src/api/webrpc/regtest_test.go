@@ -0,0 +1,167 @@
+package webrpc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+// regtestGatewayMock mocks the RegtestGatewayer methods
+type regtestGatewayMock struct {
+	*GatewayerMock
+}
+
+func (m regtestGatewayMock) GenerateBlocks(n int) ([]coin.SignedBlock, error) {
+	ret := m.Called(n)
+	sbs, _ := ret.Get(0).([]coin.SignedBlock)
+	return sbs, ret.Error(1)
+}
+
+func (m regtestGatewayMock) FundAddress(addr cipher.Address, coins uint64) (coin.Transaction, coin.SignedBlock, error) {
+	ret := m.Called(addr, coins)
+	return ret.Get(0).(coin.Transaction), ret.Get(1).(coin.SignedBlock), ret.Error(2)
+}
+
+func (m regtestGatewayMock) AdvanceTime(d time.Duration) (uint64, error) {
+	ret := m.Called(d)
+	return ret.Get(0).(uint64), ret.Error(1)
+}
+
+func makeRegtestBlock(t *testing.T, addr cipher.Address) (coin.Transaction, coin.SignedBlock) {
+	b, err := coin.NewGenesisBlock(addr, 100e6, 1000)
+	require.NoError(t, err)
+	return b.Body.Transactions[0], coin.SignedBlock{Block: *b}
+}
+
+func TestRegtestMethodsNotFound(t *testing.T) {
+	rpc := setupWebRPC(t)
+	require.NoError(t, rpc.EnableRegtest())
+	require.Error(t, rpc.EnableRegtest())
+
+	// the gateway doesn't implement RegtestGatewayer
+	req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "generate_blocks", Params: []byte(`[1]`)}
+	res := rpc.handlers["generate_blocks"](req, rpc.Gateway)
+	require.Equal(t, makeErrorResponse(errCodeMethodNotFound, errMsgMethodNotFound), res)
+}
+
+func TestGenerateBlocksHandler(t *testing.T) {
+	pub, _ := cipher.GenerateDeterministicKeyPair([]byte("seed"))
+	addr := cipher.AddressFromPubKey(pub)
+	_, sb := makeRegtestBlock(t, addr)
+	rb, err := visor.NewReadableBlock(&sb.Block)
+	require.NoError(t, err)
+
+	m := regtestGatewayMock{NewGatewayerMock()}
+	m.On("GenerateBlocks", 1).Return([]coin.SignedBlock{sb}, nil)
+	m.On("GenerateBlocks", 2).Return(nil, errors.New("No transactions"))
+
+	tests := []struct {
+		name   string
+		params string
+		want   Response
+	}{
+		{
+			"normal",
+			`[1]`,
+			makeSuccessResponse("1", visor.ReadableBlocks{Blocks: []visor.ReadableBlock{*rb}}),
+		},
+		{
+			"gateway error",
+			`[2]`,
+			makeErrorResponse(errCodeInternalError, "generate blocks failed: No transactions"),
+		},
+		{
+			"zero blocks",
+			`[0]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+		{
+			"too many blocks",
+			`[1001]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+		{
+			"invalid params",
+			`["a"]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "generate_blocks", Params: []byte(tt.params)}
+			require.Equal(t, tt.want, generateBlocksHandler(req, m))
+		})
+	}
+}
+
+func TestFundAddressHandler(t *testing.T) {
+	pub, _ := cipher.GenerateDeterministicKeyPair([]byte("seed"))
+	addr := cipher.AddressFromPubKey(pub)
+	txn, sb := makeRegtestBlock(t, addr)
+	rb, err := visor.NewReadableBlock(&sb.Block)
+	require.NoError(t, err)
+
+	m := regtestGatewayMock{NewGatewayerMock()}
+	m.On("FundAddress", addr, uint64(10e6)).Return(txn, sb, nil)
+	m.On("FundAddress", addr, uint64(1)).Return(coin.Transaction{}, coin.SignedBlock{}, errors.New("invalid amount"))
+
+	tests := []struct {
+		name   string
+		params string
+		want   Response
+	}{
+		{
+			"normal",
+			`["` + addr.String() + `", 10000000]`,
+			makeSuccessResponse("1", FundAddressResult{
+				Txid:  txn.Hash().Hex(),
+				Block: rb,
+			}),
+		},
+		{
+			"gateway error",
+			`["` + addr.String() + `", 1]`,
+			makeErrorResponse(errCodeInternalError, "fund address failed: invalid amount"),
+		},
+		{
+			"invalid address",
+			`["abc", 10000000]`,
+			makeErrorResponse(errCodeInvalidParams, "invalid address: Invalid address length"),
+		},
+		{
+			"invalid coins",
+			`["` + addr.String() + `", "10"]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+		{
+			"missing coins",
+			`["` + addr.String() + `"]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "fund_address", Params: []byte(tt.params)}
+			require.Equal(t, tt.want, fundAddressHandler(req, m))
+		})
+	}
+}
+
+func TestAdvanceTimeHandler(t *testing.T) {
+	m := regtestGatewayMock{NewGatewayerMock()}
+	m.On("AdvanceTime", time.Hour).Return(uint64(1500000000), nil)
+
+	req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "advance_time", Params: []byte(`[3600]`)}
+	require.Equal(t, makeSuccessResponse("1", AdvanceTimeResult{Time: 1500000000}), advanceTimeHandler(req, m))
+
+	req.Params = []byte(`[-1]`)
+	require.Equal(t, makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams), advanceTimeHandler(req, m))
+}
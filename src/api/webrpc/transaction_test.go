@@ -27,7 +27,7 @@ func decodeRawTransaction(rawTxStr string) *visor.Transaction {
 		panic(fmt.Sprintf("invalid raw transaction:%v", err))
 	}
 
-	tx := coin.TransactionDeserialize(rawTx)
+	tx := coin.MustTransactionDeserialize(rawTx)
 	return &visor.Transaction{
 		Txn: tx,
 		Status: visor.TransactionStatus{
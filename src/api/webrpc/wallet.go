@@ -0,0 +1,245 @@
+package webrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+// WalletGatewayer is implemented by the gateway of a node that manages the
+// wallets of its wallet directory
+type WalletGatewayer interface {
+	Gatewayer
+	NewWallet(wltName string, options ...wallet.Option) (wallet.Wallet, error)
+	NewAddresses(wltID string, n int) ([]cipher.Address, error)
+	GetWalletBalance(wltID string) (wallet.BalancePair, error)
+	Spend(wltID string, amt wallet.Balance, dest cipher.Address) (*coin.Transaction, error)
+	GetWalletUnconfirmedTxns(wltID string) ([]visor.UnconfirmedTxnResult, error)
+	ReloadWallets() error
+}
+
+// NewAddressesResult result struct of new_addresses
+type NewAddressesResult struct {
+	Addresses []string `json:"addresses"`
+}
+
+// SpendResult result struct of spend
+type SpendResult struct {
+	Balance     wallet.BalancePair         `json:"balance"`
+	Transaction *visor.ReadableTransaction `json:"txn"`
+}
+
+// EnableWallet registers the methods that create wallets and spend from them.
+// They require the Keys to be set, the keys must be granted the wallet scopes.
+func (rpc *WebRPC) EnableWallet() error {
+	if rpc.Keys == nil {
+		return errors.New("the wallet methods require API keys")
+	}
+
+	handles := map[string]HandlerFunc{
+		// create a wallet from a seed
+		"create_wallet": walletHandler(createWalletHandler),
+		// generate addresses in a wallet
+		"new_addresses": walletHandler(newAddressesHandler),
+		// get the confirmed and predicted balance of a wallet
+		"get_wallet_balance": walletHandler(getWalletBalanceHandler),
+		// send coins from a wallet
+		"spend": walletHandler(spendHandler),
+		// get the unconfirmed transactions of a wallet
+		"get_wallet_unconfirmed_txns": walletHandler(getWalletUnconfirmedTxnsHandler),
+		// load and unload the wallets of the wallet directory
+		"reload_wallets": walletHandler(reloadWalletsHandler),
+	}
+
+	for path, handle := range handles {
+		if err := rpc.HandleFunc(path, handle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walletHandler adapts h to a HandlerFunc, the method is not found if the
+// gateway doesn't manage wallets
+func walletHandler(h func(Request, WalletGatewayer) Response) HandlerFunc {
+	return func(req Request, gateway Gatewayer) Response {
+		gw, ok := gateway.(WalletGatewayer)
+		if !ok {
+			return makeErrorResponse(errCodeMethodNotFound, errMsgMethodNotFound)
+		}
+		return h(req, gw)
+	}
+}
+
+// decodeWalletID decodes the params of the methods whose only param is a wallet id
+func decodeWalletID(req Request) (string, bool) {
+	var params []string
+	if err := req.DecodeParams(&params); err != nil {
+		return "", false
+	}
+
+	if len(params) != 1 || params[0] == "" {
+		return "", false
+	}
+
+	return params[0], true
+}
+
+// request params: [seed, label]
+func createWalletHandler(req Request, gateway WalletGatewayer) Response {
+	var params []string
+	if err := req.DecodeParams(&params); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(params) != 2 || params[0] == "" || params[1] == "" {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	// the wallet name may dup, rename it till no conflict.
+	for {
+		wlt, err := gateway.NewWallet(wallet.NewWalletFilename(), wallet.OptSeed(params[0]), wallet.OptLabel(params[1]))
+		if err != nil {
+			if strings.Contains(err.Error(), "renaming") {
+				continue
+			}
+			return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("create wallet failed: %v", err))
+		}
+
+		return makeSuccessResponse(req.ID, wallet.NewReadableWallet(wlt))
+	}
+}
+
+// request params: [wallet id] or [wallet id, number]
+func newAddressesHandler(req Request, gateway WalletGatewayer) Response {
+	var params []json.RawMessage
+	if err := req.DecodeParams(&params); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(params) != 1 && len(params) != 2 {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	var wltID string
+	if err := json.Unmarshal(params[0], &wltID); err != nil || wltID == "" {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	// the number of addresses to create, default is 1
+	n := 1
+	if len(params) == 2 {
+		if err := json.Unmarshal(params[1], &n); err != nil || n <= 0 {
+			return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+		}
+	}
+
+	addrs, err := gateway.NewAddresses(wltID, n)
+	if err != nil {
+		return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("new addresses failed: %v", err))
+	}
+
+	rlt := NewAddressesResult{Addresses: make([]string, 0, len(addrs))}
+	for _, a := range addrs {
+		rlt.Addresses = append(rlt.Addresses, a.String())
+	}
+
+	return makeSuccessResponse(req.ID, rlt)
+}
+
+// request params: [wallet id]
+func getWalletBalanceHandler(req Request, gateway WalletGatewayer) Response {
+	wltID, ok := decodeWalletID(req)
+	if !ok {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	b, err := gateway.GetWalletBalance(wltID)
+	if err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("get wallet balance failed: %v", err))
+	}
+
+	return makeSuccessResponse(req.ID, b)
+}
+
+// request params: [wallet id, address, droplets]
+func spendHandler(req Request, gateway WalletGatewayer) Response {
+	var params []json.RawMessage
+	if err := req.DecodeParams(&params); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	if len(params) != 3 {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	var wltID, dstStr string
+	var coins uint64
+	if err := json.Unmarshal(params[0], &wltID); err != nil || wltID == "" {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+	if err := json.Unmarshal(params[1], &dstStr); err != nil {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+	if err := json.Unmarshal(params[2], &coins); err != nil || coins == 0 {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	dst, err := cipher.DecodeBase58Address(dstStr)
+	if err != nil {
+		return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("invalid address: %v", err))
+	}
+
+	txn, err := gateway.Spend(wltID, wallet.NewBalance(coins, 0), dst)
+	if err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("spend failed: %v", err))
+	}
+
+	rbTxn, err := visor.NewReadableTransaction(&visor.Transaction{Txn: *txn})
+	if err != nil {
+		logger.Error("%v", err)
+		return makeErrorResponse(errCodeInternalError, errMsgInternalError)
+	}
+
+	// the transaction is already broadcast, the balance is informational
+	b, err := gateway.GetWalletBalance(wltID)
+	if err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("get wallet balance failed: %v", err))
+	}
+
+	return makeSuccessResponse(req.ID, SpendResult{
+		Balance:     b,
+		Transaction: rbTxn,
+	})
+}
+
+// request params: [wallet id]
+func getWalletUnconfirmedTxnsHandler(req Request, gateway WalletGatewayer) Response {
+	wltID, ok := decodeWalletID(req)
+	if !ok {
+		return makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams)
+	}
+
+	txns, err := gateway.GetWalletUnconfirmedTxns(wltID)
+	if err != nil {
+		return makeErrorResponse(errCodeInvalidParams, fmt.Sprintf("get wallet unconfirmed transactions failed: %v", err))
+	}
+
+	return makeSuccessResponse(req.ID, txns)
+}
+
+// request params: none
+func reloadWalletsHandler(req Request, gateway WalletGatewayer) Response {
+	if err := gateway.ReloadWallets(); err != nil {
+		return makeErrorResponse(errCodeInternalError, fmt.Sprintf("reload wallets failed: %v", err))
+	}
+
+	return makeSuccessResponse(req.ID, "success")
+}
@@ -0,0 +1,295 @@
+package webrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+var _ WalletGatewayer = &daemon.Gateway{}
+
+// walletGatewayMock mocks the WalletGatewayer methods
+type walletGatewayMock struct {
+	*GatewayerMock
+}
+
+func (m walletGatewayMock) NewWallet(wltName string, options ...wallet.Option) (wallet.Wallet, error) {
+	ret := m.Called(wltName, options)
+	return ret.Get(0).(wallet.Wallet), ret.Error(1)
+}
+
+func (m walletGatewayMock) NewAddresses(wltID string, n int) ([]cipher.Address, error) {
+	ret := m.Called(wltID, n)
+	addrs, _ := ret.Get(0).([]cipher.Address)
+	return addrs, ret.Error(1)
+}
+
+func (m walletGatewayMock) GetWalletBalance(wltID string) (wallet.BalancePair, error) {
+	ret := m.Called(wltID)
+	return ret.Get(0).(wallet.BalancePair), ret.Error(1)
+}
+
+func (m walletGatewayMock) Spend(wltID string, amt wallet.Balance, dest cipher.Address) (*coin.Transaction, error) {
+	ret := m.Called(wltID, amt, dest)
+	txn, _ := ret.Get(0).(*coin.Transaction)
+	return txn, ret.Error(1)
+}
+
+func (m walletGatewayMock) GetWalletUnconfirmedTxns(wltID string) ([]visor.UnconfirmedTxnResult, error) {
+	ret := m.Called(wltID)
+	txns, _ := ret.Get(0).([]visor.UnconfirmedTxnResult)
+	return txns, ret.Error(1)
+}
+
+func (m walletGatewayMock) ReloadWallets() error {
+	return m.Called().Error(0)
+}
+
+func makeTestKeys(t *testing.T) *auth.Keys {
+	keys, err := auth.NewKeys([]auth.Key{
+		{Name: "reader", Secret: "reader-secret-0123", Scopes: []auth.Scope{auth.ScopeRead}},
+		{Name: "wallet", Secret: "wallet-secret-0123", Scopes: []auth.Scope{auth.ScopeWalletRead, auth.ScopeWalletSpend}},
+		{Name: "admin", Secret: "admin-secret-01234", Scopes: []auth.Scope{auth.ScopeAdmin}},
+	})
+	require.NoError(t, err)
+	return keys
+}
+
+func TestEnableWallet(t *testing.T) {
+	// the wallet methods are disabled by default
+	rpc := setupWebRPC(t)
+	require.NotContains(t, rpc.handlers, "reload_wallets")
+	require.Error(t, rpc.EnableWallet())
+
+	rpc.Keys = makeTestKeys(t)
+	require.NoError(t, rpc.EnableWallet())
+	require.Error(t, rpc.EnableWallet())
+
+	// the gateway doesn't implement WalletGatewayer
+	req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "reload_wallets"}
+	res := rpc.handlers["reload_wallets"](req, rpc.Gateway)
+	require.Equal(t, makeErrorResponse(errCodeMethodNotFound, errMsgMethodNotFound), res)
+}
+
+func TestAuthorization(t *testing.T) {
+	m := walletGatewayMock{NewGatewayerMock()}
+	m.On("ReloadWallets").Return(nil)
+	m.On("GetWalletBalance", "test.wlt").Return(wallet.BalancePair{}, nil)
+
+	rpc, err := New(testWebRPCAddr, m)
+	require.NoError(t, err)
+	rpc.WorkerNum = 1
+	rpc.ChanBuffSize = 2
+
+	audit := &bytes.Buffer{}
+	rpc.Keys = makeTestKeys(t)
+	rpc.Keys.SetAuditLog(audit)
+	require.NoError(t, rpc.EnableWallet())
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- rpc.Run()
+	}()
+	defer func() {
+		require.NoError(t, rpc.Shutdown())
+		require.NoError(t, <-errC)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	unauthorized := &RPCError{Code: errCodeUnauthorized, Message: errMsgUnauthorized}
+	forbidden := &RPCError{Code: errCodeForbidden, Message: errMsgForbidden}
+
+	tests := []struct {
+		name       string
+		token      string
+		balanceErr error
+		reloadErr  error
+	}{
+		{"admin", "admin-secret-01234", nil, nil},
+		{"wallet", "wallet-secret-0123", nil, forbidden},
+		{"reader", "reader-secret-0123", forbidden, forbidden},
+		{"no token", "", unauthorized, unauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{Addr: rpc.Addr, Token: tt.token}
+			_, err := c.GetWalletBalance("test.wlt")
+			require.Equal(t, tt.balanceErr, err)
+
+			// the calls of a batch are authorized by the key of the batch
+			var rlt string
+			call := &Call{Method: "reload_wallets", Result: &rlt}
+			require.NoError(t, c.Batch(call))
+			require.Equal(t, tt.reloadErr, call.Err)
+		})
+	}
+
+	// a request with an invalid key is rejected as a whole
+	c := &Client{Addr: rpc.Addr, Token: "wrong-secret-0123"}
+	_, err = c.GetStatus()
+	require.Equal(t, unauthorized, err)
+
+	// the rejected requests are in the audit log
+	var recs []auth.AuditRecord
+	d := json.NewDecoder(audit)
+	for d.More() {
+		var rec auth.AuditRecord
+		require.NoError(t, d.Decode(&rec))
+		recs = append(recs, rec)
+	}
+	require.Len(t, recs, 6)
+	require.Equal(t, "wallet", recs[0].Key)
+	require.Equal(t, "reload_wallets", recs[0].Resource)
+	require.Equal(t, auth.ScopeAdmin, recs[0].Scope)
+	require.Equal(t, "forbidden", recs[0].Reason)
+	require.Equal(t, "webrpc", recs[5].Resource)
+	require.Equal(t, "unauthenticated", recs[5].Reason)
+}
+
+func TestCreateWalletHandler(t *testing.T) {
+	wlt, err := wallet.NewWallet("test.wlt", wallet.OptSeed("seed"), wallet.OptLabel("label"))
+	require.NoError(t, err)
+
+	m := walletGatewayMock{NewGatewayerMock()}
+	m.On("NewWallet", mock.Anything, mock.Anything).Return(*wlt, nil)
+
+	req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "create_wallet", Params: []byte(`["seed", "label"]`)}
+	require.Equal(t, makeSuccessResponse("1", wallet.NewReadableWallet(*wlt)), createWalletHandler(req, m))
+
+	req.Params = []byte(`["seed"]`)
+	require.Equal(t, makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams), createWalletHandler(req, m))
+}
+
+func TestNewAddressesHandler(t *testing.T) {
+	addrs := []cipher.Address{testutil.MakeAddress(), testutil.MakeAddress()}
+
+	m := walletGatewayMock{NewGatewayerMock()}
+	m.On("NewAddresses", "test.wlt", 1).Return(addrs[:1], nil)
+	m.On("NewAddresses", "test.wlt", 2).Return(addrs, nil)
+	m.On("NewAddresses", "none.wlt", 1).Return(nil, errors.New("wallet none.wlt doesn't exist"))
+
+	tests := []struct {
+		name   string
+		params string
+		want   Response
+	}{
+		{
+			"default number",
+			`["test.wlt"]`,
+			makeSuccessResponse("1", NewAddressesResult{Addresses: []string{addrs[0].String()}}),
+		},
+		{
+			"two addresses",
+			`["test.wlt", 2]`,
+			makeSuccessResponse("1", NewAddressesResult{Addresses: []string{addrs[0].String(), addrs[1].String()}}),
+		},
+		{
+			"gateway error",
+			`["none.wlt"]`,
+			makeErrorResponse(errCodeInvalidParams, "new addresses failed: wallet none.wlt doesn't exist"),
+		},
+		{
+			"zero addresses",
+			`["test.wlt", 0]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+		{
+			"missing wallet id",
+			`[]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "new_addresses", Params: []byte(tt.params)}
+			require.Equal(t, tt.want, newAddressesHandler(req, m))
+		})
+	}
+}
+
+func TestSpendHandler(t *testing.T) {
+	dst := testutil.MakeAddress()
+	txn := coin.Transaction{}
+	txn.PushOutput(dst, 1e6, 0)
+	rbTxn, err := visor.NewReadableTransaction(&visor.Transaction{Txn: txn})
+	require.NoError(t, err)
+	bal := wallet.BalancePair{
+		Confirmed: wallet.NewBalance(10e6, 0),
+		Predicted: wallet.NewBalance(9e6, 0),
+	}
+
+	m := walletGatewayMock{NewGatewayerMock()}
+	m.On("Spend", "test.wlt", wallet.NewBalance(1e6, 0), dst).Return(&txn, nil)
+	m.On("Spend", "test.wlt", wallet.NewBalance(100e6, 0), dst).Return(nil, errors.New("balance is not sufficient"))
+	m.On("GetWalletBalance", "test.wlt").Return(bal, nil)
+
+	tests := []struct {
+		name   string
+		params string
+		want   Response
+	}{
+		{
+			"normal",
+			`["test.wlt", "` + dst.String() + `", 1000000]`,
+			makeSuccessResponse("1", SpendResult{Balance: bal, Transaction: rbTxn}),
+		},
+		{
+			"gateway error",
+			`["test.wlt", "` + dst.String() + `", 100000000]`,
+			makeErrorResponse(errCodeInternalError, "spend failed: balance is not sufficient"),
+		},
+		{
+			"invalid address",
+			`["test.wlt", "abc", 1000000]`,
+			makeErrorResponse(errCodeInvalidParams, "invalid address: Invalid address length"),
+		},
+		{
+			"zero coins",
+			`["test.wlt", "` + dst.String() + `", 0]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+		{
+			"missing coins",
+			`["test.wlt", "` + dst.String() + `"]`,
+			makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "spend", Params: []byte(tt.params)}
+			require.Equal(t, tt.want, spendHandler(req, m))
+		})
+	}
+}
+
+func TestGetWalletBalanceHandler(t *testing.T) {
+	bal := wallet.BalancePair{
+		Confirmed: wallet.NewBalance(10e6, 10),
+		Predicted: wallet.NewBalance(10e6, 10),
+	}
+
+	m := walletGatewayMock{NewGatewayerMock()}
+	m.On("GetWalletBalance", "test.wlt").Return(bal, nil)
+
+	req := Request{ID: "1", Jsonrpc: jsonRPC, Method: "get_wallet_balance", Params: []byte(`["test.wlt"]`)}
+	require.Equal(t, makeSuccessResponse("1", bal), getWalletBalanceHandler(req, m))
+
+	req.Params = []byte(`["test.wlt", "other.wlt"]`)
+	require.Equal(t, makeErrorResponse(errCodeInvalidParams, errMsgInvalidParams), getWalletBalanceHandler(req, m))
+}
@@ -0,0 +1,563 @@
+package webrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"encoding/json"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+	wh "github.com/skycoin/skycoin/src/util/http"
+
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/metrics"
+
+	"bytes"
+	"strings"
+)
+
+var (
+	requestSeconds = metrics.NewHistogramVec("shellcoin_webrpc_request_duration_seconds",
+		"Latency of webrpc requests by method and status", nil, "method", "status")
+)
+
+var (
+	errCodeParseError     = -32700 // Parse error	Invalid JSON was received by the server. An error occurred on the server while parsing the JSON text.
+	errCodeInvalidRequest = -32600 // Invalid Request	The JSON sent is not a valid Request object.
+	errCodeMethodNotFound = -32601 // Method not found	The method does not exist / is not available.
+	errCodeInvalidParams  = -32602 // Invalid params	Invalid method parameter(s).
+	errCodeInternalError  = -32603 // Internal error	Internal JSON-RPC error.
+
+	errMsgParseError     = "Parse error"
+	errMsgInvalidRequest = "Invalid Request"
+	errMsgMethodNotFound = "Method not found"
+	errMsgInvalidParams  = "Invalid params"
+	errMsgInternalError  = "Internal error"
+
+	errMsgNotPost = "only support http POST"
+
+	errMsgInvalidJsonrpc = "invalid jsonrpc"
+	errMsgEmptyBatch     = "empty batch"
+	errMsgBatchTooLarge  = "batch too large"
+	errMsgBodyTooLarge   = "request too large"
+	errMsgMissingMethod  = "missing method"
+
+	// -32000 to -32099	Server error	Reserved for implementation-defined server-errors.
+	errCodeUnauthorized = -32001 // The request has no valid API key.
+	errCodeForbidden    = -32002 // The API key of the request isn't granted the scope of the method.
+
+	errMsgUnauthorized = "Unauthorized"
+	errMsgForbidden    = "Forbidden"
+
+	jsonRPC = "2.0"
+)
+
+var logger = logging.MustGetLogger("webrpc")
+
+// ID is the id of a request, a string, a number or null, kept in its JSON
+// encoding.  A request without id is a notification, its ID is empty.
+type ID string
+
+// nullID is the id of the responses to requests whose id can't be determined
+const nullID ID = "null"
+
+// StringID returns the ID of the string id s
+func StringID(s string) ID {
+	b, _ := json.Marshal(s)
+	return ID(b)
+}
+
+// MarshalJSON encodes the id, the empty ID is encoded as null
+func (id ID) MarshalJSON() ([]byte, error) {
+	if id == "" {
+		return []byte(nullID), nil
+	}
+	return []byte(id), nil
+}
+
+// UnmarshalJSON decodes a string, number or null id
+func (id *ID) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch v.(type) {
+	case string, float64, nil:
+		*id = ID(b)
+		return nil
+	default:
+		return errors.New("id must be a string, a number or null")
+	}
+}
+
+// Request rpc request struct
+type Request struct {
+	ID      ID              `json:"id,omitempty"`
+	Jsonrpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// IsNotification returns true if the request has no id, in which case no
+// response is sent
+func (r *Request) IsNotification() bool {
+	return r.ID == ""
+}
+
+// RPCError response error
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e RPCError) Error() string {
+	return fmt.Sprintf("%s [code: %d]", e.Message, e.Code)
+}
+
+// Response rpc response struct
+type Response struct {
+	ID      ID              `json:"id"`
+	Jsonrpc string          `json:"jsonrpc"`
+	Error   *RPCError       `json:"error,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+}
+
+// NewRequest create new webrpc request.
+func NewRequest(method string, params interface{}, id string) (*Request, error) {
+	var p json.RawMessage
+	if params != nil {
+		var err error
+		p, err = json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Request{
+		Jsonrpc: jsonRPC,
+		Method:  method,
+		Params:  p,
+		ID:      StringID(id),
+	}, nil
+}
+
+// DecodeParams decodes request params to specific value.
+func (r *Request) DecodeParams(v interface{}) error {
+	return json.NewDecoder(bytes.NewBuffer(r.Params)).Decode(v)
+}
+
+func makeSuccessResponse(id ID, result interface{}) Response {
+	rlt, _ := json.Marshal(result)
+	return Response{
+		ID:      id,
+		Result:  rlt,
+		Jsonrpc: jsonRPC,
+	}
+}
+
+// makeErrorResponse creates an error response without id, the id of the
+// request is set by the Handler
+func makeErrorResponse(code int, msgs ...string) Response {
+	msg := strings.Join(msgs[:], "\n")
+	return Response{
+		ID:      nullID,
+		Error:   &RPCError{Code: code, Message: msg},
+		Jsonrpc: jsonRPC,
+	}
+}
+
+// methodScopes are the scopes a key must be granted to call the methods
+var methodScopes = map[string]auth.Scope{
+	"get_status":          auth.ScopeRead,
+	"get_blocks_by_seq":   auth.ScopeRead,
+	"get_lastblocks":      auth.ScopeRead,
+	"get_blocks":          auth.ScopeRead,
+	"get_outputs":         auth.ScopeRead,
+	"get_transaction":     auth.ScopeRead,
+	"get_address_uxouts":  auth.ScopeRead,
+	"get_address_pending": auth.ScopeRead,
+	"inject_transaction":  auth.ScopeWalletSpend,
+
+	"get_wallet_balance":          auth.ScopeWalletRead,
+	"get_wallet_unconfirmed_txns": auth.ScopeWalletRead,
+	"create_wallet":               auth.ScopeWalletSpend,
+	"new_addresses":               auth.ScopeWalletSpend,
+	"spend":                       auth.ScopeWalletSpend,
+	"reload_wallets":              auth.ScopeAdmin,
+
+	"generate_blocks": auth.ScopeAdmin,
+	"fund_address":    auth.ScopeAdmin,
+	"advance_time":    auth.ScopeAdmin,
+}
+
+// methodScope returns the scope of method, the methods missing from
+// methodScopes require the admin scope
+func methodScope(method string) auth.Scope {
+	if scope, ok := methodScopes[method]; ok {
+		return scope
+	}
+	return auth.ScopeAdmin
+}
+
+type operation func(rpc *WebRPC)
+
+// HandlerFunc represents the function type for processing the request
+type HandlerFunc func(req Request, gateway Gatewayer) Response
+
+// WebRPC manage the web rpc state and handles
+type WebRPC struct {
+	Addr         string // service address
+	Gateway      Gatewayer
+	WorkerNum    uint
+	ChanBuffSize uint // size of ops channel
+	// Maximum number of requests in a batch
+	MaxBatchSize int
+	// Maximum size in bytes of a request body, larger bodies are rejected
+	// with an invalid request error without being read in full
+	MaxBodySize int64
+	// How long Shutdown waits for the requests in progress to complete
+	ShutdownTimeout time.Duration
+	// Keys authenticate the requests, each method requires a key granted its
+	// scope.  Every method is served if Keys is nil.
+	Keys *auth.Keys
+
+	ops      chan operation // request channel
+	mux      *http.ServeMux
+	handlers map[string]HandlerFunc
+	server   *http.Server
+	quit     chan struct{}
+}
+
+func New(addr string, gw Gatewayer) (*WebRPC, error) {
+	rpc := &WebRPC{
+		Addr:            addr,
+		Gateway:         gw,
+		WorkerNum:       5,
+		ChanBuffSize:    1000,
+		MaxBatchSize:    1000,
+		MaxBodySize:     4 << 20,
+		ShutdownTimeout: 5 * time.Second,
+		quit:            make(chan struct{}),
+		mux:             http.NewServeMux(),
+		handlers:        make(map[string]HandlerFunc),
+	}
+	rpc.server = &http.Server{Handler: rpc}
+
+	rpc.mux.HandleFunc("/webrpc", rpc.Handler)
+
+	if err := rpc.initHandlers(); err != nil {
+		return nil, err
+	}
+
+	return rpc, nil
+}
+
+// initHandlers initialize webrpc handlers
+func (rpc *WebRPC) initHandlers() error {
+	handles := map[string]HandlerFunc{
+		// get service status
+		"get_status": getStatusHandler,
+		// get blocks by seq
+		"get_blocks_by_seq": getBlocksBySeqHandler,
+		// get last N blocks
+		"get_lastblocks": getLastBlocksHandler,
+		// get blocks in specific seq range
+		"get_blocks": getBlocksHandler,
+		// get unspent outputs of address
+		"get_outputs": getOutputsHandler,
+		// get transaction by txid
+		"get_transaction": getTransactionHandler,
+		// broadcast transaction
+		"inject_transaction": injectTransactionHandler,
+		// get address affected uxouts
+		"get_address_uxouts": getAddrUxOutsHandler,
+		// get the pending spends and receipts of addresses
+		"get_address_pending": getAddressPendingHandler,
+	}
+
+	// register handlers
+	for path, handle := range handles {
+		if err := rpc.HandleFunc(path, handle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run starts the webrpc service.
+func (rpc *WebRPC) Run() error {
+	if rpc.WorkerNum < 1 {
+		return errors.New("rpc.WorkerNum must be > 0")
+	}
+
+	if rpc.ChanBuffSize < 1 {
+		return errors.New("rpc.ChanBuffSize must be > 0")
+	}
+
+	logger.Infof("start webrpc on http://%s", rpc.Addr)
+	defer logger.Info("webrpc service closed")
+
+	listener, err := net.Listen("tcp", rpc.Addr)
+	if err != nil {
+		return err
+	}
+
+	rpc.ops = make(chan operation, rpc.ChanBuffSize)
+
+	for i := uint(0); i < rpc.WorkerNum; i++ {
+		go rpc.workerThread(i)
+	}
+
+	errC := make(chan error, 1)
+	go func() {
+		if err := rpc.server.Serve(listener); err != http.ErrServerClosed {
+			// the webrpc service failed unexpectedly
+			logger.Info("webrpc.Run, http.Serve error: %v", err)
+			errC <- err
+			return
+		}
+		errC <- nil
+	}()
+
+	return <-errC
+}
+
+// Shutdown stops accepting requests and waits up to ShutdownTimeout for the
+// requests in progress to complete, then stops the workers
+func (rpc *WebRPC) Shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpc.ShutdownTimeout)
+	defer cancel()
+
+	// the workers must keep running until the requests in progress complete
+	err := rpc.server.Shutdown(ctx)
+	if err == context.DeadlineExceeded {
+		logger.Warning("webrpc shutdown timed out after %v, requests in progress were dropped", rpc.ShutdownTimeout)
+		err = rpc.server.Close()
+	}
+
+	close(rpc.quit)
+	return err
+}
+
+// HandleFunc registers handler function
+func (rpc *WebRPC) HandleFunc(method string, h HandlerFunc) error {
+	if _, ok := rpc.handlers[method]; ok {
+		return fmt.Errorf("%s method already exist", method)
+	}
+
+	rpc.handlers[method] = h
+	return nil
+}
+
+// ServHTTP implements the interface of http.Handler
+func (rpc *WebRPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rpc.mux.ServeHTTP(w, r)
+}
+
+// Handler processes the http request, a single request or a batch of requests.
+// Notifications are processed but not responded to.
+func (rpc *WebRPC) Handler(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	// only support post.
+	if r.Method != http.MethodPost {
+		rpc.sendError(w, start, errCodeInvalidRequest, errMsgNotPost)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, rpc.MaxBodySize))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		rpc.sendError(w, start, errCodeInvalidRequest, errMsgBodyTooLarge)
+		return
+	}
+	if err != nil || !json.Valid(body) {
+		rpc.sendError(w, start, errCodeParseError, errMsgParseError)
+		return
+	}
+
+	var key *auth.Key
+	if rpc.Keys != nil {
+		key, err = rpc.Keys.Authenticate(r)
+		if err != nil {
+			rpc.Keys.Reject(r, nil, "webrpc", "", err)
+			rpc.sendError(w, start, errCodeUnauthorized, errMsgUnauthorized)
+			return
+		}
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '[' {
+		if res, ok := rpc.call(r, body, key); ok {
+			wh.SendOr404(w, &res)
+		}
+		return
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		rpc.sendError(w, start, errCodeParseError, errMsgParseError)
+		return
+	}
+
+	switch {
+	case len(batch) == 0:
+		rpc.sendError(w, start, errCodeInvalidRequest, errMsgEmptyBatch)
+		return
+	case len(batch) > rpc.MaxBatchSize:
+		rpc.sendError(w, start, errCodeInvalidRequest, errMsgBatchTooLarge)
+		return
+	}
+
+	// the requests of the batch are processed concurrently by the workers
+	resCs := make([]<-chan callResult, len(batch))
+	for i, b := range batch {
+		resC := make(chan callResult, 1)
+		go func(b json.RawMessage) {
+			res, ok := rpc.call(r, b, key)
+			resC <- callResult{res, ok}
+		}(b)
+		resCs[i] = resC
+	}
+
+	ress := make([]Response, 0, len(batch))
+	for _, resC := range resCs {
+		if cr := <-resC; cr.ok {
+			ress = append(ress, cr.res)
+		}
+	}
+
+	// a batch of notifications gets no response
+	if len(ress) > 0 {
+		wh.SendOr404(w, ress)
+	}
+}
+
+type callResult struct {
+	res Response
+	ok  bool
+}
+
+// sendError sends an error response that can't be tied to a request
+func (rpc *WebRPC) sendError(w http.ResponseWriter, start time.Time, code int, msg string) {
+	res := makeErrorResponse(code, msg)
+	requestSeconds.With("invalid", responseStatus(res)).ObserveSince(start)
+	wh.SendOr404(w, &res)
+}
+
+// call processes the request encoded in b.  Returns false if the request is a
+// notification, which gets no response.  key is the API key of the request r.
+func (rpc *WebRPC) call(r *http.Request, b json.RawMessage, key *auth.Key) (res Response, ok bool) {
+	// the method label is the rpc method if it exists, to keep the number of
+	// label values bounded
+	start := time.Now()
+	method := "invalid"
+	defer func() {
+		requestSeconds.With(method, responseStatus(res)).ObserveSince(start)
+	}()
+
+	req := Request{}
+	if err := json.Unmarshal(b, &req); err != nil {
+		return makeErrorResponse(errCodeInvalidRequest, errMsgInvalidRequest), true
+	}
+
+	if req.Jsonrpc != jsonRPC {
+		return rpc.respond(req, makeErrorResponse(errCodeInvalidRequest, errMsgInvalidJsonrpc))
+	}
+
+	if req.Method == "" {
+		return rpc.respond(req, makeErrorResponse(errCodeInvalidRequest, errMsgMissingMethod))
+	}
+
+	method = "unknown"
+	if _, ok := rpc.handlers[req.Method]; ok {
+		method = req.Method
+	}
+
+	if res, ok := rpc.authorize(r, key, method); !ok {
+		return rpc.respond(req, res)
+	}
+
+	resC := make(chan Response, 1)
+	rpc.ops <- func(rpc *WebRPC) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Critical(fmt.Sprintf("%v", r))
+				resC <- makeErrorResponse(errCodeInternalError, errMsgInternalError)
+			}
+		}()
+
+		if handler, ok := rpc.handlers[req.Method]; ok {
+			logger.Info("webrpc handling method: %v", req.Method)
+			resC <- handler(req, rpc.Gateway)
+		} else {
+			resC <- makeErrorResponse(errCodeMethodNotFound, errMsgMethodNotFound)
+		}
+	}
+
+	return rpc.respond(req, <-resC)
+}
+
+// authorize checks that key is granted the scope of method.  Returns the
+// error response and false if it isn't.
+func (rpc *WebRPC) authorize(r *http.Request, key *auth.Key, method string) (Response, bool) {
+	if rpc.Keys == nil || method == "unknown" {
+		return Response{}, true
+	}
+
+	scope := methodScope(method)
+	switch {
+	case key == nil:
+		rpc.Keys.Reject(r, nil, method, scope, auth.ErrUnauthenticated)
+		return makeErrorResponse(errCodeUnauthorized, errMsgUnauthorized), false
+	case !key.Allows(scope):
+		rpc.Keys.Reject(r, key, method, scope, auth.ErrForbidden)
+		return makeErrorResponse(errCodeForbidden, errMsgForbidden), false
+	}
+
+	return Response{}, true
+}
+
+// respond sets the id of the request on its response
+func (rpc *WebRPC) respond(req Request, res Response) (Response, bool) {
+	if req.IsNotification() {
+		return res, false
+	}
+	res.ID = req.ID
+	return res, true
+}
+
+// responseStatus returns "ok" for successful responses and the error code otherwise
+func responseStatus(res Response) string {
+	if res.Error == nil {
+		return "ok"
+	}
+	return strconv.Itoa(res.Error.Code)
+}
+
+func (rpc *WebRPC) workerThread(seq uint) {
+	for {
+		select {
+		case <-rpc.quit:
+			return
+		case op := <-rpc.ops:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						logger.Error("recover: %v", r)
+					}
+				}()
+				op(rpc)
+			}()
+		}
+	}
+}
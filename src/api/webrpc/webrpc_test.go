@@ -104,6 +104,10 @@ func (fg fakeGateway) GetAddrUxOuts(addr cipher.Address) ([]*historydb.UxOutJSON
 	return nil, nil
 }
 
+func (fg fakeGateway) GetAddressesPending(addrs []cipher.Address) (*visor.PendingTxns, error) {
+	return nil, nil
+}
+
 func (fg fakeGateway) GetTimeNow() uint64 {
 	return 0
 }
@@ -145,6 +149,7 @@ func Test_rpcHandler_Handler(t *testing.T) {
 				req:        Request{},
 			},
 			Response{
+				ID:      nullID,
 				Jsonrpc: jsonRPC,
 				Error: &RPCError{
 					Code:    errCodeInvalidRequest,
@@ -162,7 +167,14 @@ func Test_rpcHandler_Handler(t *testing.T) {
 					Method:  "get_status",
 				},
 			},
-			makeErrorResponse(errCodeInvalidParams, errMsgInvalidJsonrpc),
+			Response{
+				ID:      "1",
+				Jsonrpc: jsonRPC,
+				Error: &RPCError{
+					Code:    errCodeInvalidRequest,
+					Message: errMsgInvalidJsonrpc,
+				},
+			},
 		},
 	}
 
@@ -184,3 +196,201 @@ func Test_rpcHandler_Handler(t *testing.T) {
 		})
 	}
 }
+
+func Test_rpcHandler_JSONRPC(t *testing.T) {
+	rpc := setupWebRPC(t)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- rpc.Run()
+	}()
+	defer func() {
+		rpc.Shutdown()
+		require.NoError(t, <-errC)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	// res is the id and the error code of a response, code 0 if it succeeded
+	type res struct {
+		id   string
+		code int
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want []res // nil for no response, one element for a non-batch request
+	}{
+		{
+			"number id",
+			`{"jsonrpc":"2.0","method":"get_lastblocks","params":[1],"id":7}`,
+			[]res{{"7", 0}},
+		},
+		{
+			"string id",
+			`{"jsonrpc":"2.0","method":"get_lastblocks","params":[1],"id":"abc"}`,
+			[]res{{`"abc"`, 0}},
+		},
+		{
+			"null id",
+			`{"jsonrpc":"2.0","method":"get_lastblocks","params":[1],"id":null}`,
+			[]res{{"null", 0}},
+		},
+		{
+			"notification",
+			`{"jsonrpc":"2.0","method":"get_lastblocks","params":[1]}`,
+			nil,
+		},
+		{
+			"error keeps the id",
+			`{"jsonrpc":"2.0","method":"no_such_method","id":3}`,
+			[]res{{"3", errCodeMethodNotFound}},
+		},
+		{
+			"notification of unknown method",
+			`{"jsonrpc":"2.0","method":"no_such_method"}`,
+			nil,
+		},
+		{
+			"invalid json",
+			`{"jsonrpc":"2.0","method"`,
+			[]res{{"null", errCodeParseError}},
+		},
+		{
+			"not an object",
+			`1`,
+			[]res{{"null", errCodeInvalidRequest}},
+		},
+		{
+			"invalid id",
+			`{"jsonrpc":"2.0","method":"get_lastblocks","id":{}}`,
+			[]res{{"null", errCodeInvalidRequest}},
+		},
+		{
+			"missing method",
+			`{"jsonrpc":"2.0","id":1}`,
+			[]res{{"1", errCodeInvalidRequest}},
+		},
+		{
+			"empty batch",
+			`[]`,
+			[]res{{"null", errCodeInvalidRequest}},
+		},
+		{
+			"batch",
+			`[
+				{"jsonrpc":"2.0","method":"get_lastblocks","params":[1],"id":1},
+				{"jsonrpc":"2.0","method":"get_lastblocks","params":[1]},
+				1,
+				{"jsonrpc":"2.0","method":"no_such_method","id":"x"},
+				{"jsonrpc":"2.0","method":"get_blocks","params":[1,1],"id":2}
+			]`,
+			[]res{{"1", 0}, {"null", errCodeInvalidRequest}, {`"x"`, errCodeMethodNotFound}, {"2", 0}},
+		},
+		{
+			"batch of notifications",
+			`[{"jsonrpc":"2.0","method":"get_lastblocks","params":[1]},{"jsonrpc":"2.0","method":"get_status"}]`,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/webrpc", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+			rpc.Handler(w, r)
+
+			if tt.want == nil {
+				require.Empty(t, w.Body.String())
+				return
+			}
+
+			type response struct {
+				ID     json.RawMessage `json:"id"`
+				Error  *RPCError       `json:"error"`
+				Result json.RawMessage `json:"result"`
+			}
+			var ress []response
+			body := w.Body.Bytes()
+			if body[0] == '[' {
+				require.NoError(t, json.Unmarshal(body, &ress))
+			} else {
+				ress = make([]response, 1)
+				require.NoError(t, json.Unmarshal(body, &ress[0]))
+			}
+
+			require.Len(t, ress, len(tt.want))
+			for i, want := range tt.want {
+				require.Equal(t, want.id, string(ress[i].ID))
+				if want.code == 0 {
+					require.Nil(t, ress[i].Error)
+					require.NotEmpty(t, ress[i].Result)
+				} else {
+					require.NotNil(t, ress[i].Error)
+					require.Equal(t, want.code, ress[i].Error.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchTooLarge(t *testing.T) {
+	rpc := setupWebRPC(t)
+	rpc.MaxBatchSize = 1
+
+	r := httptest.NewRequest("POST", "/webrpc", bytes.NewBufferString(`[{"jsonrpc":"2.0","method":"get_status","id":1},{"jsonrpc":"2.0","method":"get_status","id":2}]`))
+	w := httptest.NewRecorder()
+	rpc.Handler(w, r)
+
+	var res Response
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, nullID, res.ID)
+	require.Equal(t, errCodeInvalidRequest, res.Error.Code)
+	require.Equal(t, errMsgBatchTooLarge, res.Error.Message)
+}
+
+func TestBodyTooLarge(t *testing.T) {
+	rpc := setupWebRPC(t)
+	rpc.MaxBodySize = 16
+
+	r := httptest.NewRequest("POST", "/webrpc", bytes.NewBufferString(`{"jsonrpc":"2.0","method":"get_status","id":1}`))
+	w := httptest.NewRecorder()
+	rpc.Handler(w, r)
+
+	var res Response
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, nullID, res.ID)
+	require.Equal(t, errCodeInvalidRequest, res.Error.Code)
+	require.Equal(t, errMsgBodyTooLarge, res.Error.Message)
+}
+
+func TestShutdownWaitsForRequests(t *testing.T) {
+	rpc := setupWebRPC(t)
+	started := make(chan struct{})
+	require.NoError(t, rpc.HandleFunc("slow", func(req Request, gw Gatewayer) Response {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return makeSuccessResponse(req.ID, "done")
+	}))
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- rpc.Run()
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	resC := make(chan string, 1)
+	go func() {
+		var res string
+		c := &Client{Addr: rpc.Addr}
+		if err := c.Do(&res, "slow", nil); err != nil {
+			res = err.Error()
+		}
+		resC <- res
+	}()
+
+	<-started
+	require.NoError(t, rpc.Shutdown())
+	require.NoError(t, <-errC)
+	require.Equal(t, "done", <-resC)
+}
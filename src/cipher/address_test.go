@@ -82,6 +82,28 @@ func TestAddressVerify(t *testing.T) {
 	assert.NotNil(t, a.Verify(p))
 }
 
+func TestSetAddressVersion(t *testing.T) {
+	defer SetAddressVersion(0)
+
+	p, _ := GenerateKeyPair()
+	a := AddressFromPubKey(p)
+	s := a.String()
+
+	SetAddressVersion(0x02)
+	assert.Equal(t, byte(0x02), AddressVersion())
+	b := AddressFromPubKey(p)
+	assert.Equal(t, byte(0x02), b.Version)
+	assert.Nil(t, b.Verify(p))
+	assert.NotNil(t, a.Verify(p))
+
+	// addresses of another network are rejected
+	_, err := DecodeBase58Address(s)
+	assert.NotNil(t, err)
+	b2, err := DecodeBase58Address(b.String())
+	assert.Nil(t, err)
+	assert.Equal(t, b, b2)
+}
+
 func TestAddressString(t *testing.T) {
 	p, _ := GenerateKeyPair()
 	a := AddressFromPubKey(p)
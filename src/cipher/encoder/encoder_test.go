@@ -408,7 +408,7 @@ func TestDecodeNotEnoughLength(t *testing.T) {
 	err := DeserializeRaw(b, &d)
 	if err == nil {
 		t.Fatal("Expected error")
-	} else if err.Error() != "Deserialization failed" {
+	} else if err != ErrBufferUnderflow {
 		t.Fatalf("Expected different error, but got %s", err.Error())
 	}
 
@@ -417,7 +417,7 @@ func TestDecodeNotEnoughLength(t *testing.T) {
 	err = DeserializeRaw(b, thing)
 	if err == nil {
 		t.Fatal("Expected error")
-	} else if err.Error() != "Deserialization failed" {
+	} else if err != ErrBufferUnderflow {
 		t.Fatal("Expected different error")
 	}
 }
@@ -552,3 +552,99 @@ func TestByteArray(t *testing.T) {
 	}
 
 }
+
+type TestStructMaxLen struct {
+	Keys []cipher.PubKey `enc:",maxlen=2"`
+	Name string          `enc:",maxlen=4"`
+	Data []byte
+}
+
+func TestDecodeMaxLen(t *testing.T) {
+	x := TestStructMaxLen{
+		Keys: make([]cipher.PubKey, 2),
+		Name: "abcd",
+		Data: randBytes(10),
+	}
+	var y TestStructMaxLen
+	if err := DeserializeRaw(Serialize(x), &y); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(x, y) {
+		t.Fatal("roundtrip failed")
+	}
+
+	x.Keys = make([]cipher.PubKey, 3)
+	if err := DeserializeRaw(Serialize(x), &y); err != ErrMaxLenExceeded {
+		t.Fatalf("Expected ErrMaxLenExceeded, got %v", err)
+	}
+
+	x.Keys = nil
+	x.Name = "abcde"
+	if err := DeserializeRaw(Serialize(x), &y); err != ErrMaxLenExceeded {
+		t.Fatalf("Expected ErrMaxLenExceeded, got %v", err)
+	}
+}
+
+func TestDecodeLengthBoundedByBuffer(t *testing.T) {
+	// 100 bytes can't hold 10 keys of 33 bytes
+	b := make([]byte, 104)
+	lePutUint32(b, 10)
+	var keys []cipher.PubKey
+	if err := DeserializeRaw(b, &keys); err != ErrBufferUnderflow {
+		t.Fatalf("Expected ErrBufferUnderflow, got %v", err)
+	}
+
+	// a length larger than the buffer
+	lePutUint32(b, 0xFFFFFFFF)
+	var data []byte
+	if err := DeserializeRaw(b, &data); err == nil {
+		t.Fatal("Expected error")
+	}
+	var s string
+	if err := DeserializeRaw(b[:3], &s); err != ErrBufferUnderflow {
+		t.Fatalf("Expected ErrBufferUnderflow, got %v", err)
+	}
+}
+
+func TestDecodeNilSlice(t *testing.T) {
+	x := TestStructNested{X: 7}
+	var y TestStructNested
+	if err := DeserializeRaw(Serialize(x), &y); err != nil {
+		t.Fatal(err)
+	}
+	if y.Y != nil || y.Z != nil {
+		t.Fatal("nil slices decoded as empty slices")
+	}
+	if !reflect.DeepEqual(x, y) {
+		t.Fatal("roundtrip failed")
+	}
+}
+
+type TestStructNested struct {
+	X int32
+	Y []TestStruct2
+	Z []TestStruct
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	x := TestStructNested{
+		X: 7,
+		Y: []TestStruct2{{X: 1, K: [8]byte{1}}, {X: 2, W: true}},
+		Z: []TestStruct{{T: "abc", K: randBytes(5)}},
+	}
+	b := Serialize(x)
+
+	// no truncation or byte change may panic
+	for i := 0; i < len(b); i++ {
+		var y TestStructNested
+		if err := DeserializeRaw(b[:i], &y); err == nil {
+			t.Fatalf("Expected error decoding %d of %d bytes", i, len(b))
+		}
+
+		c := append([]byte{}, b...)
+		for _, v := range []byte{0x00, 0x7F, 0xFF} {
+			c[i] = v
+			DeserializeRaw(c, &y)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+//go:build gofuzz
+// +build gofuzz
+
+package encoder
+
+import "bytes"
+
+type fuzzStruct struct {
+	A uint8
+	B int16
+	C uint32
+	D int64
+	E [4]byte
+	F []byte `enc:",maxlen=64"`
+	G string
+	H []fuzzNested
+}
+
+type fuzzNested struct {
+	X []uint64
+	Y [2]int32
+}
+
+// FuzzDeserializeRaw is the go-fuzz entry point for the decoder.  Malformed
+// input must be rejected with an error, and a successful decode must encode
+// back to the bytes it was decoded from.
+func FuzzDeserializeRaw(data []byte) int {
+	var s fuzzStruct
+	if err := DeserializeRaw(data, &s); err != nil {
+		return 0
+	}
+
+	b := Serialize(s)
+	if !bytes.HasPrefix(data, b) {
+		panic("encoder: decoded value does not encode to the input")
+	}
+	return 1
+}
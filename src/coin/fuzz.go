@@ -0,0 +1,29 @@
+//go:build gofuzz
+// +build gofuzz
+
+package coin
+
+import "bytes"
+
+// FuzzTransactionDeserialize is the go-fuzz entry point for transactions
+// received from the network or the API.  Malformed input must be rejected
+// with an error, and a decoded transaction must serialize to the start of its
+// input.
+func FuzzTransactionDeserialize(data []byte) int {
+	txn, err := TransactionDeserialize(data)
+	if err != nil {
+		return 0
+	}
+
+	if !bytes.HasPrefix(data, txn.Serialize()) {
+		panic("coin: decoded transaction does not serialize to the input")
+	}
+
+	// exercise the code paths that run on unverified transactions
+	txn.Hash()
+	txn.Size()
+	if err := txn.Verify(); err != nil {
+		return 0
+	}
+	return 1
+}
@@ -274,10 +274,13 @@ func TestTransactionHashInner(t *testing.T) {
 func TestTransactionSerialization(t *testing.T) {
 	tx := makeTransaction(t)
 	b := tx.Serialize()
-	tx2 := TransactionDeserialize(b)
+	tx2, err := TransactionDeserialize(b)
+	require.NoError(t, err)
 	assert.Equal(t, tx, tx2)
 	// Invalid deserialization
-	assert.Panics(t, func() { TransactionDeserialize([]byte{0x04}) })
+	_, err = TransactionDeserialize([]byte{0x04})
+	assert.Error(t, err)
+	assert.Panics(t, func() { MustTransactionDeserialize([]byte{0x04}) })
 }
 
 func TestTransactionOutputHours(t *testing.T) {
@@ -0,0 +1,1009 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/daemon/pex"
+
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/utc"
+)
+
+/*
+Todo
+- verify that minimum/maximum connections are working
+- keep max connections
+- maintain minimum number of outgoing connections per server?
+
+
+*/
+var (
+	// ErrDisconnectReasons invalid version
+	ErrDisconnectInvalidVersion gnet.DisconnectReason = errors.New("Invalid version")
+	// ErrDisconnectIntroductionTimeout timeout
+	ErrDisconnectIntroductionTimeout gnet.DisconnectReason = errors.New("Version timeout")
+	// ErrDisconnectVersionSendFailed version send failed
+	ErrDisconnectVersionSendFailed gnet.DisconnectReason = errors.New("Version send failed")
+	// ErrDisconnectIsBlacklisted is blacklisted
+	ErrDisconnectIsBlacklisted gnet.DisconnectReason = errors.New("Blacklisted")
+	// ErrDisconnectSelf self connnect
+	ErrDisconnectSelf gnet.DisconnectReason = errors.New("Self connect")
+	// ErrDisconnectConnectedTwice connect twice
+	ErrDisconnectConnectedTwice gnet.DisconnectReason = errors.New("Already connected")
+	// ErrDisconnectIdle idle
+	ErrDisconnectIdle gnet.DisconnectReason = errors.New("Idle")
+	// ErrDisconnectNoIntroduction no introduction
+	ErrDisconnectNoIntroduction gnet.DisconnectReason = errors.New("First message was not an Introduction")
+	// ErrDisconnectIPLimitReached ip limit reached
+	ErrDisconnectIPLimitReached gnet.DisconnectReason = errors.New("Maximum number of connections for this IP was reached")
+	// ErrDisconnectOtherError this is returned when a seemingly impossible error is encountered
+	// e.g. net.Conn.Addr() returns an invalid ip:port
+	ErrDisconnectOtherError gnet.DisconnectReason = errors.New("Incomprehensible error")
+
+	// ErrDaemonClosed is returned by the Gateway requests made once the daemon
+	// loop is stopped
+	ErrDaemonClosed = errors.New("Daemon closed")
+
+	logger = logging.MustGetLogger("daemon")
+)
+
+const (
+	// MaxDropletPrecision represents the precision of droplets
+	MaxDropletPrecision = 1
+	MaxDropletDivisor   = 1e6
+)
+
+// Config subsystem configurations
+type Config struct {
+	Daemon   DaemonConfig
+	Messages MessagesConfig
+	Pool     PoolConfig
+	Peers    PeersConfig
+	Gateway  GatewayConfig
+	Visor    VisorConfig
+}
+
+// NewConfig returns a Config with defaults set
+func NewConfig() Config {
+	return Config{
+		Daemon:   NewDaemonConfig(),
+		Pool:     NewPoolConfig(),
+		Peers:    NewPeersConfig(),
+		Gateway:  NewGatewayConfig(),
+		Messages: NewMessagesConfig(),
+		Visor:    NewVisorConfig(),
+	}
+}
+
+// preprocess preprocess for config
+func (cfg *Config) preprocess() Config {
+	config := *cfg
+	if config.Daemon.LocalhostOnly {
+		if config.Daemon.Address == "" {
+			local, err := LocalhostIP()
+			if err != nil {
+				logger.Panicf("Failed to obtain localhost IP: %v", err)
+			}
+			config.Daemon.Address = local
+		} else {
+			if !IsLocalhost(config.Daemon.Address) {
+				logger.Panicf("Invalid address for localhost-only: %s",
+					config.Daemon.Address)
+			}
+		}
+		config.Peers.AllowLocalhost = true
+	}
+	config.Pool.port = config.Daemon.Port
+	config.Pool.address = config.Daemon.Address
+
+	if config.Daemon.DisableNetworking {
+		config.Peers.Disabled = true
+		config.Visor.DisableNetworking = true
+		config.Daemon.DisableIncomingConnections = true
+		config.Daemon.DisableOutgoingConnections = true
+	} else {
+		if config.Daemon.DisableIncomingConnections {
+			logger.Info("Incoming connections are disabled.")
+		}
+		if config.Daemon.DisableOutgoingConnections {
+			logger.Info("Outgoing connections are disabled.")
+		}
+	}
+
+	return config
+}
+
+// DaemonConfig configuration for the Daemon
+type DaemonConfig struct {
+	// Application version. TODO -- manage version better
+	Version int32
+	// IP Address to serve on. Leave empty for automatic assignment
+	Address string
+	// TCP/UDP port for connections
+	Port int
+	// Directory where application data is stored
+	DataDirectory string
+	// How often to check and initiate an outgoing connection if needed
+	OutgoingRate time.Duration
+	// How often to re-attempt to fill any missing private (aka required)
+	// connections
+	PrivateRate time.Duration
+	// Number of outgoing connections to maintain
+	OutgoingMax int
+	// Maximum number of connections to try at once
+	PendingMax int
+	// How long to wait for a version packet
+	IntroductionWait time.Duration
+	// How often to check for peers that have decided to stop communicating
+	CullInvalidRate time.Duration
+	// How many connections are allowed from the same base IP
+	IPCountsMax int
+	// Disable all networking activity
+	DisableNetworking bool
+	// Don't make outgoing connections
+	DisableOutgoingConnections bool
+	// Don't allow incoming connections
+	DisableIncomingConnections bool
+	// Run on localhost and only connect to localhost peers
+	LocalhostOnly bool
+	// Log ping and pong messages
+	LogPings bool
+	// Don't send compressed messages and don't advertise support for them
+	DisableCompression bool
+	// How long Shutdown waits for each subsystem to stop
+	ShutdownTimeout time.Duration
+}
+
+// NewDaemonConfig creates daemon config
+func NewDaemonConfig() DaemonConfig {
+	return DaemonConfig{
+		Version:                    2,
+		Address:                    "",
+		Port:                       6677,
+		OutgoingRate:               time.Second * 5,
+		PrivateRate:                time.Second * 5,
+		OutgoingMax:                16,
+		PendingMax:                 16,
+		IntroductionWait:           time.Second * 30,
+		CullInvalidRate:            time.Second * 3,
+		IPCountsMax:                3,
+		DisableNetworking:          false,
+		DisableOutgoingConnections: false,
+		DisableIncomingConnections: false,
+		LocalhostOnly:              false,
+		LogPings:                   true,
+		DisableCompression:         false,
+		ShutdownTimeout:            time.Second * 10,
+	}
+}
+
+// Daemon stateful properties of the daemon
+type Daemon struct {
+	// Daemon configuration
+	Config DaemonConfig
+
+	// Components
+	Messages *Messages
+	Pool     *Pool
+	Peers    *Peers
+	Gateway  *Gateway
+	Visor    *Visor
+
+	DefaultConnections []string
+
+	// Separate index of outgoing connections. The pool aggregates all
+	// connections.
+	outgoingConnections *OutgoingConnections
+	// Number of connections waiting to be formed or timeout
+	pendingConnections *PendingConnections
+	// Keep track of unsolicited clients who should notify us of their version
+	expectingIntroductions *ExpectIntroductions
+	// Keep track of a connection's mirror value, to avoid double
+	// connections (one to their listener, and one to our listener)
+	// Maps from addr to mirror value
+	connectionMirrors *ConnectionMirrors
+	// Maps from mirror value to a map of ip (no port)
+	// We use a map of ip as value because multiple peers can have the same
+	// mirror (to avoid attacks enabled by our use of mirrors),
+	// but only one per base ip
+	mirrorConnections *MirrorConnections
+	// Features advertised by the connections in their FeaturesMessage
+	connectionFeatures *ConnectionFeatures
+	// Outgoing connections whose FeaturesMessage wasn't answered yet
+	featureRequests *AddrSet
+	// Client connection callbacks
+	onConnectEvent chan ConnectEvent
+	// Client disconnection callbacks
+	onDisconnectEvent chan DisconnectEvent
+	// Connection failure events
+	connectionErrors chan ConnectionError
+	// Tracking connections from the same base IP.  Multiple connections
+	// from the same base IP are allowed but limited.
+	ipCounts *IPCount
+	// Message handling queue
+	messageEvents chan MessageEvent
+	// quit channel
+	quitC chan struct{}
+	// Done once the Run loop returns
+	running sync.WaitGroup
+}
+
+// NewDaemon returns a Daemon with primitives allocated
+func NewDaemon(config Config) (*Daemon, error) {
+	config = config.preprocess()
+	vs, err := NewVisor(config.Visor)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, err := NewPeers(config.Peers)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Daemon{
+		Config:   config.Daemon,
+		Messages: NewMessages(config.Messages),
+		Peers:    peers,
+		Visor:    vs,
+
+		DefaultConnections: DefaultConnections, //passed in from top level
+
+		expectingIntroductions: NewExpectIntroductions(),
+		connectionMirrors:      NewConnectionMirrors(),
+		mirrorConnections:      NewMirrorConnections(),
+		connectionFeatures:     NewConnectionFeatures(),
+		featureRequests:        NewAddrSet(),
+		ipCounts:               NewIPCount(),
+		// TODO -- if there are performance problems from blocking chans,
+		// Its because we are connecting to more things than OutgoingMax
+		// if we have private peers
+		onConnectEvent:      make(chan ConnectEvent, config.Daemon.OutgoingMax),
+		onDisconnectEvent:   make(chan DisconnectEvent, config.Daemon.OutgoingMax),
+		connectionErrors:    make(chan ConnectionError, config.Daemon.OutgoingMax),
+		outgoingConnections: NewOutgoingConnections(config.Daemon.OutgoingMax),
+		pendingConnections:  NewPendingConnections(config.Daemon.PendingMax),
+		messageEvents:       make(chan MessageEvent, config.Pool.EventChannelSize),
+		quitC:               make(chan struct{}),
+	}
+
+	d.Gateway = NewGateway(config.Gateway, d)
+	d.Messages.Config.Register()
+	d.Pool = NewPool(config.Pool, d)
+
+	return d, nil
+}
+
+// ConnectEvent generated when a client connects
+type ConnectEvent struct {
+	Addr      string
+	Solicited bool
+}
+
+// DisconnectEvent generated when a connection terminated
+type DisconnectEvent struct {
+	Addr   string
+	Reason gnet.DisconnectReason
+}
+
+// ConnectionError represent a failure to connect/dial a connection, with context
+type ConnectionError struct {
+	Addr  string
+	Error error
+}
+
+// MessageEvent encapsulates a deserialized message from the network
+type MessageEvent struct {
+	Message AsyncMessage
+	Context *gnet.MessageContext
+}
+
+// Shutdown Terminates all subsystems safely, in order: the Run loop stops
+// processing peer messages, the connections are closed, the peers are saved
+// and the visor is closed.
+func (dm *Daemon) Shutdown() {
+	// close the daemon loop first
+	logger.Info("Stopping the daemon loop")
+	close(dm.quitC)
+	if !waitTimeout(&dm.running, dm.Config.ShutdownTimeout) {
+		logger.Warning("Daemon loop did not stop within %v", dm.Config.ShutdownTimeout)
+	}
+
+	if !dm.Config.DisableNetworking {
+		logger.Info("Closing connections")
+		dm.Pool.Shutdown()
+	}
+
+	logger.Info("Saving peers")
+	dm.Peers.Shutdown()
+
+	logger.Info("Closing the visor")
+	dm.Visor.Shutdown()
+}
+
+// waitTimeout waits for wg, returns false if it takes longer than timeout
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// strand runs f on the loop that reads reqC and returns its error.  Once quit
+// is closed it returns closedErr instead: f isn't run, or is waited for if
+// the loop already started it.
+func strand(reqC chan<- func(), quit <-chan struct{}, closedErr error, f func() error) error {
+	var err error
+	// set by whichever comes first of the loop starting f (1) and the
+	// caller giving up on it (2)
+	var state int32
+	done := make(chan struct{})
+	req := func() {
+		if !atomic.CompareAndSwapInt32(&state, 0, 1) {
+			return
+		}
+		defer close(done)
+		err = f()
+	}
+
+	select {
+	case reqC <- req:
+	case <-quit:
+		return closedErr
+	}
+
+	select {
+	case <-done:
+		return err
+	case <-quit:
+		if atomic.CompareAndSwapInt32(&state, 0, 2) {
+			return closedErr
+		}
+		<-done
+		return err
+	}
+}
+
+// Run main loop for peer/connection management. Send anything to quit to shut it
+// down
+func (dm *Daemon) Run() (err error) {
+	dm.running.Add(1)
+	defer dm.running.Done()
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("recover:%v\n stack:%v", r, string(debug.Stack()))
+		}
+
+		logger.Info("Daemon closed")
+	}()
+
+	// buffered, so that the goroutines don't leak once Run returned
+	errC := make(chan error, 1)
+
+	// start visor, it creates blocks and announces them to the pool's peers
+	visorErrC := dm.Visor.Start(dm.Pool.Pool)
+
+	if !dm.Config.DisableIncomingConnections {
+		go func() {
+			errC <- dm.Pool.Run()
+		}()
+	}
+
+	privateConnectionsTicker := time.Tick(dm.Config.PrivateRate)
+	cullInvalidTicker := time.Tick(dm.Config.CullInvalidRate)
+	outgoingConnectionsTicker := time.Tick(dm.Config.OutgoingRate)
+	clearOldPeersTicker := time.Tick(dm.Peers.Config.CullRate)
+	requestPeersTicker := time.Tick(dm.Peers.Config.RequestRate)
+	clearStaleConnectionsTicker := time.Tick(dm.Pool.Config.ClearStaleRate)
+	idleCheckTicker := time.Tick(dm.Pool.Config.IdleCheckRate)
+
+	// connecto to trusted peers
+	if !dm.Config.DisableOutgoingConnections {
+		go dm.connectToTrustPeer()
+	}
+
+	for {
+		select {
+		case err = <-errC:
+			return
+		case err = <-visorErrC:
+			return
+		case <-dm.quitC:
+			return
+		// Remove connections that failed to complete the handshake
+		case <-cullInvalidTicker:
+			if !dm.Config.DisableNetworking {
+				dm.cullInvalidConnections()
+			}
+		// Request peers via PEX
+		case <-requestPeersTicker:
+			dm.Peers.requestPeers(dm.Pool)
+		// Remove peers we haven't seen in a while
+		case <-clearOldPeersTicker:
+			if !dm.Peers.Config.Disabled {
+				dm.Peers.Peers.ClearOld(dm.Peers.Config.Expiration)
+			}
+		// Remove connections that haven't said anything in a while
+		case <-clearStaleConnectionsTicker:
+			if !dm.Config.DisableNetworking {
+				dm.Pool.clearStaleConnections()
+			}
+		// Sends pings as needed
+		case <-idleCheckTicker:
+			if !dm.Config.DisableNetworking {
+				dm.Pool.sendPings()
+			}
+		// Fill up our outgoing connections
+		case <-outgoingConnectionsTicker:
+			trustPeerNum := len(dm.Peers.Peers.GetAllTrustedPeers())
+			if !dm.Config.DisableOutgoingConnections &&
+				dm.outgoingConnections.Len() < (dm.Config.OutgoingMax+trustPeerNum) &&
+				dm.pendingConnections.Len() < dm.Config.PendingMax {
+				dm.connectToRandomPeer()
+			}
+		// Always try to stay connected to our private peers
+		// TODO (also, connect to all of them on start)
+		case <-privateConnectionsTicker:
+			if !dm.Config.DisableOutgoingConnections {
+				dm.makePrivateConnections()
+			}
+		// Process callbacks for when a client connects. No disconnect chan
+		// is needed because the callback is triggered by HandleDisconnectEvent
+		// which is already select{}ed here
+		case r := <-dm.onConnectEvent:
+			if dm.Config.DisableNetworking {
+				logger.Error("There should be no connect events")
+				return
+			}
+			dm.onConnect(r)
+		case de := <-dm.onDisconnectEvent:
+			if dm.Config.DisableNetworking {
+				logger.Error("There should be no disconnect events")
+				return
+			}
+			dm.onDisconnect(de)
+		// Handle connection errors
+		case r := <-dm.connectionErrors:
+			if dm.Config.DisableNetworking {
+				logger.Error("There should be no connection errors")
+				return
+			}
+			dm.handleConnectionError(r)
+		// Process message sending results
+		case r := <-dm.Pool.Pool.SendResults:
+			if dm.Config.DisableNetworking {
+				logger.Error("There should be nothing in SendResults")
+				return
+			}
+			dm.handleMessageSendResult(r)
+		// Message handlers
+		case m := <-dm.messageEvents:
+			if dm.Config.DisableNetworking {
+				logger.Error("There should be no message events")
+				return
+			}
+			dm.processMessageEvent(m)
+		// Process any pending RPC requests
+		case req := <-dm.Gateway.requests:
+			req()
+		}
+	}
+}
+
+// GetListenPort returns the ListenPort for a given address.  If no port is found, 0 is
+// returned
+func (dm *Daemon) GetListenPort(addr string) uint16 {
+	m, ok := dm.connectionMirrors.Get(addr)
+	if !ok {
+		return 0
+	}
+
+	ip, _, err := SplitAddr(addr)
+	if err != nil {
+		logger.Error("GetListenPort received invalid addr: %v", err)
+		return 0
+	}
+
+	p, ok := dm.mirrorConnections.Get(m, ip)
+	if !ok {
+		return 0
+	}
+	return p
+}
+
+// Connects to a given peer.  Returns an error if no connection attempt was
+// made.  If the connection attempt itself fails, the error is sent to
+// the connectionErrors channel.
+func (dm *Daemon) connectToPeer(p *pex.Peer) error {
+	if dm.Config.DisableOutgoingConnections {
+		return errors.New("Outgoing connections disabled")
+	}
+	a, _, err := SplitAddr(p.Addr)
+	if err != nil {
+		logger.Warning("PEX gave us an invalid peer: %v", err)
+		return errors.New("Invalid peer")
+	}
+	if dm.Config.LocalhostOnly && !IsLocalhost(a) {
+		return errors.New("Not localhost")
+	}
+
+	conned, err := dm.Pool.Pool.IsConnExist(p.Addr)
+	if err != nil {
+		return err
+	}
+
+	if conned {
+		return errors.New("Already connected")
+	}
+
+	if _, ok := dm.pendingConnections.Get(p.Addr); ok {
+		return errors.New("Connection is pending")
+	}
+	cnt, ok := dm.ipCounts.Get(a)
+	if !dm.Config.LocalhostOnly && ok && cnt != 0 {
+		return errors.New("Already connected to a peer with this base IP")
+	}
+	logger.Debug("Trying to connect to %s", p.Addr)
+	dm.pendingConnections.Add(p.Addr, p)
+	go func() {
+		start := utc.Now()
+		if err := dm.Pool.Pool.Connect(p.Addr); err != nil {
+			dm.connectionErrors <- ConnectionError{p.Addr, err}
+			return
+		}
+		dm.Peers.Peers.SetLatency(p.Addr, utc.Now().Sub(start))
+	}()
+	return nil
+}
+
+// Connects to all private peers
+func (dm *Daemon) makePrivateConnections() {
+	if dm.Config.DisableOutgoingConnections {
+		return
+	}
+	addrs := dm.Peers.Peers.GetPrivateAddresses()
+	for _, addr := range addrs {
+		p, exist := dm.Peers.Peers.GetPeerByAddr(addr)
+		if exist {
+			logger.Info("Private peer attempt: %s", p.Addr)
+			if err := dm.connectToPeer(&p); err != nil {
+				logger.Debug("Did not connect to private peer: %v", err)
+			}
+		}
+	}
+}
+
+func (dm *Daemon) connectToTrustPeer() {
+	if dm.Config.DisableIncomingConnections {
+		return
+	}
+
+	logger.Info("connect to trusted peers")
+	// make connections to all trusted peers
+	peers := dm.Peers.Peers.GetPublicTrustPeers()
+	for _, p := range peers {
+		dm.connectToPeer(p)
+	}
+}
+
+// Attempts to connect to random peers, favoring peers with a good connection
+// record and in subnets we are not connected to yet
+func (dm *Daemon) connectToRandomPeer() {
+	if dm.Config.DisableOutgoingConnections {
+		return
+	}
+
+	subnets := make(map[string]bool)
+	for _, a := range dm.outgoingConnections.Addrs() {
+		subnets[pex.Subnet(a)] = true
+	}
+
+	// Only try as many peers as there are free outgoing slots, the caller
+	// checked that there is room for at least one
+	n := dm.Config.OutgoingMax - dm.outgoingConnections.Len() - dm.pendingConnections.Len()
+	if n < 1 {
+		n = 1
+	}
+
+	// Make connections to random (public) peers
+	peers := dm.Peers.Peers.SelectPublic(n, subnets)
+	if len(peers) == 0 {
+		// all the known peers are in subnets we are connected to
+		peers = dm.Peers.Peers.SelectPublic(n, nil)
+	}
+	for _, p := range peers {
+		// check if the peer has public port
+		if p.HasIncomePort {
+			// try to connect the peer if it's ip:mirror does not exist
+			if _, exist := dm.getMirrorPort(p.Addr, dm.Messages.Mirror); !exist {
+				dm.connectToPeer(p)
+				continue
+			}
+		} else {
+			// try to connect to the peer if we don't know whether the peer have public port
+			dm.connectToPeer(p)
+		}
+	}
+
+	if len(peers) == 0 {
+		// reset the retry times of all peers
+		dm.Peers.Peers.ResetAllRetryTimes()
+	}
+}
+
+// We remove a peer from the Pex if we failed to connect
+// Failure to connect
+// Use exponential backoff, not peer list
+func (dm *Daemon) handleConnectionError(c ConnectionError) {
+	logger.Debug("Failed to connect to %s with error: %v", c.Addr, c.Error)
+
+	dm.pendingConnections.Remove(c.Addr)
+
+	dm.Peers.Peers.SetConnectFailed(c.Addr)
+}
+
+// Removes unsolicited connections who haven't sent a version
+func (dm *Daemon) cullInvalidConnections() {
+	// This method only handles the erroneous people from the DHT, but not
+	// malicious nodes
+	now := utc.Now()
+	addrs, err := dm.expectingIntroductions.CullInvalidConns(func(addr string, t time.Time) (bool, error) {
+		conned, err := dm.Pool.Pool.IsConnExist(addr)
+		if err != nil {
+			return false, err
+		}
+
+		if !conned {
+			return true, nil
+		}
+
+		if t.Add(dm.Config.IntroductionWait).Before(now) {
+			return true, nil
+		}
+		return false, nil
+	})
+
+	if err != nil {
+		logger.Error("expectingIntroduction cull invalid connections failed: %v", err)
+		return
+	}
+
+	for _, a := range addrs {
+		exist, err := dm.Pool.Pool.IsConnExist(a)
+		if err != nil {
+			logger.Error("%v", err)
+			return
+		}
+
+		if exist {
+			logger.Info("Removing %s for not sending a version", a)
+			if err := dm.Pool.Pool.Disconnect(a, ErrDisconnectIntroductionTimeout); err != nil {
+				logger.Error("%v", err)
+				return
+			}
+			dm.Peers.RemovePeer(a)
+		}
+	}
+}
+
+// Records an AsyncMessage to the messageEvent chan.  Do not access
+// messageEvent directly.
+func (dm *Daemon) recordMessageEvent(m AsyncMessage, c *gnet.MessageContext) error {
+	dm.messageEvents <- MessageEvent{m, c}
+	return nil
+}
+
+// check if the connection needs introduction message
+func (dm *Daemon) needsIntro(addr string) bool {
+	_, exist := dm.expectingIntroductions.Get(addr)
+	return exist
+}
+
+// Processes a queued AsyncMessage.
+func (dm *Daemon) processMessageEvent(e MessageEvent) {
+	// The first message received must be an Introduction
+	// We have to check at process time and not record time because
+	// Introduction message does not update ExpectingIntroductions until its
+	// Process() is called
+	// _, needsIntro := self.expectingIntroductions[e.Context.Addr]
+	// if needsIntro {
+	if dm.needsIntro(e.Context.Addr) {
+		_, isIntro := e.Message.(*IntroductionMessage)
+		if !isIntro {
+			dm.Pool.Pool.Disconnect(e.Context.Addr, ErrDisconnectNoIntroduction)
+		}
+	}
+	e.Message.Process(dm)
+}
+
+// Called when a ConnectEvent is processed off the onConnectEvent channel
+func (dm *Daemon) onConnect(e ConnectEvent) {
+	a := e.Addr
+
+	if e.Solicited {
+		logger.Info("Connected to peer: %s (outgoing)", a)
+	} else {
+		logger.Info("Connected to peer: %s (incoming)", a)
+	}
+
+	dm.pendingConnections.Remove(a)
+
+	exist, err := dm.Pool.Pool.IsConnExist(a)
+	if err != nil {
+		logger.Error("%v", err)
+		return
+	}
+
+	if !exist {
+		logger.Warning("While processing an onConnect event, no pool " +
+			"connection was found")
+		return
+	}
+
+	if dm.ipCountMaxed(a) {
+		logger.Info("Max connections for %s reached, disconnecting", a)
+		dm.Pool.Pool.Disconnect(a, ErrDisconnectIPLimitReached)
+		return
+	}
+
+	dm.recordIPCount(a)
+
+	if e.Solicited {
+		dm.outgoingConnections.Add(a)
+	}
+
+	dm.expectingIntroductions.Add(a, utc.Now())
+	logger.Debug("Sending introduction message to %s, mirror:%d", a, dm.Messages.Mirror)
+	m := NewIntroductionMessage(dm.Messages.Mirror, dm.Config.Version,
+		dm.Pool.Pool.Config.Port)
+	dm.Pool.Pool.SendMessage(a, m)
+}
+
+// Returns the feature flags this daemon advertises in its FeaturesMessage
+func (dm *Daemon) features() uint32 {
+	f := FeatureIPv6
+	if !dm.Config.DisableCompression {
+		f |= FeatureCompression
+	}
+	return f
+}
+
+func (dm *Daemon) onDisconnect(e DisconnectEvent) {
+	logger.Info("%s disconnected because: %v", e.Addr, e.Reason)
+
+	dm.outgoingConnections.Remove(e.Addr)
+	dm.expectingIntroductions.Remove(e.Addr)
+	dm.Visor.RemoveConnection(e.Addr)
+	dm.removeIPCount(e.Addr)
+	dm.removeConnectionMirror(e.Addr)
+	dm.connectionFeatures.Remove(e.Addr)
+	if dm.featureRequests.Has(e.Addr) {
+		dm.featureRequests.Remove(e.Addr)
+		// Older versions close the connection on messages they don't know.
+		// Timeouts and connections dropped by this node say nothing about
+		// the version of the peer.
+		if closedByPeer(e.Reason) {
+			logger.Info("%s closed the connection on FeaturesMessage, not sending it again", e.Addr)
+			dm.Peers.Peers.SetLegacy(e.Addr, true)
+		}
+	}
+}
+
+// closedByPeer returns whether reason is the peer closing the connection
+func closedByPeer(reason error) bool {
+	return errors.Is(reason, io.EOF) || errors.Is(reason, syscall.ECONNRESET)
+}
+
+// isLegacyPeer returns whether the peer closed the connection when it was
+// last sent a FeaturesMessage.  The flag is kept in the peer list, peers that
+// aren't in it are always asked for their features.
+func (dm *Daemon) isLegacyPeer(addr string) bool {
+	p, ok := dm.Peers.Peers.GetPeerByAddr(addr)
+	return ok && p.Legacy
+}
+
+// Triggered when an gnet.Connection terminates
+func (dm *Daemon) onGnetDisconnect(addr string, reason gnet.DisconnectReason) {
+	e := DisconnectEvent{
+		Addr:   addr,
+		Reason: reason,
+	}
+	select {
+	case dm.onDisconnectEvent <- e:
+	default:
+		logger.Info("onDisconnectEvent channel is full")
+	}
+}
+
+// Triggered when an gnet.Connection is connected
+func (dm *Daemon) onGnetConnect(addr string, solicited bool) {
+	dm.onConnectEvent <- ConnectEvent{Addr: addr, Solicited: solicited}
+}
+
+// Returns whether the ipCount maximum has been reached
+func (dm *Daemon) ipCountMaxed(addr string) bool {
+	ip, _, err := SplitAddr(addr)
+	if err != nil {
+		logger.Warning("ipCountMaxed called with invalid addr: %v", err)
+		return true
+	}
+
+	if cnt, ok := dm.ipCounts.Get(ip); ok {
+		return cnt >= dm.Config.IPCountsMax
+	}
+	return false
+}
+
+// Adds base IP to ipCount or returns error if max is reached
+func (dm *Daemon) recordIPCount(addr string) {
+	ip, _, err := SplitAddr(addr)
+	if err != nil {
+		logger.Warning("recordIPCount called with invalid addr: %v", err)
+		return
+	}
+	dm.ipCounts.Increase(ip)
+}
+
+// Removes base IP from ipCount
+func (dm *Daemon) removeIPCount(addr string) {
+	ip, _, err := SplitAddr(addr)
+	if err != nil {
+		logger.Warning("removeIPCount called with invalid addr: %v", err)
+		return
+	}
+	dm.ipCounts.Decrease(ip)
+}
+
+// Adds addr + mirror to the connectionMirror mappings
+func (dm *Daemon) recordConnectionMirror(addr string, mirror uint32) error {
+	ip, port, err := SplitAddr(addr)
+	if err != nil {
+		logger.Warning("recordConnectionMirror called with invalid addr: %v",
+			err)
+		return err
+	}
+	dm.connectionMirrors.Add(addr, mirror)
+	dm.mirrorConnections.Add(mirror, ip, port)
+	return nil
+}
+
+// Removes an addr from the connectionMirror mappings
+func (dm *Daemon) removeConnectionMirror(addr string) {
+	mirror, ok := dm.connectionMirrors.Get(addr)
+	if !ok {
+		return
+	}
+	ip, _, err := SplitAddr(addr)
+	if err != nil {
+		logger.Warning("removeConnectionMirror called with invalid addr: %v",
+			err)
+		return
+	}
+
+	// remove ip from specific mirror
+	dm.mirrorConnections.Remove(mirror, ip)
+
+	dm.connectionMirrors.Remove(addr)
+}
+
+// Returns whether an addr+mirror's port and whether the port exists
+func (dm *Daemon) getMirrorPort(addr string, mirror uint32) (uint16, bool) {
+	ip, _, err := SplitAddr(addr)
+	if err != nil {
+		logger.Warning("getMirrorPort called with invalid addr: %v", err)
+		return 0, false
+	}
+	return dm.mirrorConnections.Get(mirror, ip)
+}
+
+// When an async message send finishes, its result is handled by this
+func (dm *Daemon) handleMessageSendResult(r gnet.SendResult) {
+	if r.Error != nil {
+		logger.Warning("Failed to send %s to %s: %v",
+			reflect.TypeOf(r.Message), r.Addr, r.Error)
+		return
+	}
+	switch r.Message.(type) {
+	case SendingTxnsMessage:
+		txns := r.Message.(SendingTxnsMessage).GetTxns()
+		dm.Visor.async(func() {
+			dm.Visor.setTxnsAnnounced(txns)
+		})
+	default:
+	}
+}
+
+// LocalhostIP returns the address for localhost on the machine
+func LocalhostIP() (string, error) {
+	tt, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	for _, t := range tt {
+		aa, err := t.Addrs()
+		if err != nil {
+			return "", err
+		}
+		for _, a := range aa {
+			if ipnet, ok := a.(*net.IPNet); ok && ipnet.IP.IsLoopback() {
+				return ipnet.IP.String(), nil
+			}
+		}
+	}
+	return "", errors.New("No local IP found")
+}
+
+// IsLocalhost returns true if addr is a localhost address
+func IsLocalhost(addr string) bool {
+	return net.ParseIP(addr).IsLoopback()
+}
+
+// SplitAddr splits an ip:port or [ip]:port string to ip, port
+func SplitAddr(addr string) (string, uint16, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return host, 0, fmt.Errorf("Invalid addr %s", addr)
+	}
+	port64, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return host, 0, fmt.Errorf("Invalid port in %s", addr)
+	}
+	return host, uint16(port64), nil
+}
+
+// JoinAddr joins ip and port into an ip:port string, IPv6 addresses are
+// enclosed in brackets
+func JoinAddr(ip string, port uint16) string {
+	return net.JoinHostPort(ip, strconv.Itoa(int(port)))
+}
+
+// ResolveAddr resolves the host of a host:port string, returning an ip:port
+// or [ip]:port string. Addresses whose host is already an ip are returned
+// in canonical form.
+func ResolveAddr(addr string) (string, error) {
+	host, port, err := SplitAddr(addr)
+	if err != nil {
+		return "", err
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return JoinAddr(ip.String(), port), nil
+	}
+
+	ips, err := net.LookupHost(host)
+	if err != nil {
+		return "", err
+	}
+	if len(ips) == 0 {
+		return "", fmt.Errorf("No address found for %s", host)
+	}
+	return JoinAddr(ips[0], port), nil
+}
+
+// DropletPrecisionCheck checks if the amount is valid
+func DropletPrecisionCheck(amount uint64) error {
+	if amount%MaxDropletDivisor != 0 {
+		return fmt.Errorf("invalid amount, too many decimal place")
+	}
+
+	return nil
+}
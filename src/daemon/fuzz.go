@@ -0,0 +1,34 @@
+//go:build gofuzz
+// +build gofuzz
+
+package daemon
+
+import (
+	"reflect"
+
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+)
+
+var fuzzMessageTypes = func() []reflect.Type {
+	var ts []reflect.Type
+	for _, mc := range getMessageConfigs() {
+		ts = append(ts, reflect.TypeOf(mc.Message))
+	}
+	return ts
+}()
+
+// FuzzMessages is the go-fuzz entry point for the messages exchanged with
+// peers.  The first byte selects the registered message type, the rest is
+// decoded into it.  Malformed input must be rejected with an error.
+func FuzzMessages(data []byte) int {
+	if len(data) == 0 {
+		return -1
+	}
+
+	t := fuzzMessageTypes[int(data[0])%len(fuzzMessageTypes)]
+	v := reflect.New(t)
+	if _, err := encoder.DeserializeRawToValue(data[1:], v); err != nil {
+		return 0
+	}
+	return 1
+}
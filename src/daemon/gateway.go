@@ -0,0 +1,690 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+
+	"fmt"
+
+	"github.com/skycoin/skycoin/src/visor/blockdb"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+)
+
+// Exposes an api for use by the gui rpc interface.
+// Read-only queries run concurrently on the caller's goroutine, see view.
+// Queries that change the state are serialized on the daemon loop, see strand.
+
+// GatewayConfig configuration set of gateway.
+type GatewayConfig struct {
+	BufferSize int
+}
+
+// NewGatewayConfig create and init an GatewayConfig
+func NewGatewayConfig() GatewayConfig {
+	return GatewayConfig{
+		BufferSize: 32,
+	}
+}
+
+// Gateway RPC interface wrapper for daemon state
+type Gateway struct {
+	Config GatewayConfig
+	drpc   RPC
+	vrpc   visor.RPC
+
+	// Backref to Daemon
+	d *Daemon
+	// Backref to Visor
+	v *visor.Visor
+	// Requests are queued on this channel
+	requests chan func()
+}
+
+// NewGateway create and init an Gateway instance.
+func NewGateway(c GatewayConfig, D *Daemon) *Gateway {
+	return &Gateway{
+		Config:   c,
+		drpc:     RPC{},
+		vrpc:     visor.MakeRPC(D.Visor.v),
+		d:        D,
+		v:        D.Visor.v,
+		requests: make(chan func(), c.BufferSize),
+	}
+}
+
+// strand runs f on the daemon loop and returns its error, or ErrDaemonClosed
+// once the daemon is shut down.  It is used by the methods that change the
+// state of the daemon, the blockchain or the wallets.
+func (gw *Gateway) strand(f func() error) error {
+	return strand(gw.requests, gw.d.quitC, ErrDaemonClosed, f)
+}
+
+// view runs the read-only query f on the caller's goroutine, concurrently with
+// the daemon loop and with other queries.  The blockchain and the unconfirmed
+// pool don't change while f runs, so it must be short: blocks wait for it.
+//
+// The queries of blocks and of the history, which can scan a lot of data, don't
+// use view.  Each of their reads is a bolt read-only transaction, which sees a
+// snapshot of the db and doesn't hold back the blocks being executed; a block
+// executed meanwhile is only seen by the reads that follow it.
+func (gw *Gateway) view(f func()) {
+	gw.d.Visor.view(f)
+}
+
+// GetConnections returns a *Connections.  It runs on the daemon loop, which
+// adds and removes the connections, so that the pool and the state kept of
+// each connection agree.
+func (gw *Gateway) GetConnections() interface{} {
+	var conns *Connections
+	if err := gw.strand(func() error {
+		conns = gw.drpc.GetConnections(gw.d)
+		return nil
+	}); err != nil {
+		return nil
+	}
+	return conns
+}
+
+// GetDefaultConnections returns default connections
+func (gw *Gateway) GetDefaultConnections() interface{} {
+	return gw.drpc.GetDefaultConnections(gw.d)
+}
+
+// GetConnection returns a *Connection of specific address, see GetConnections
+func (gw *Gateway) GetConnection(addr string) interface{} {
+	var conn *Connection
+	if err := gw.strand(func() error {
+		conn = gw.drpc.GetConnection(gw.d, addr)
+		return nil
+	}); err != nil {
+		return nil
+	}
+	return conn
+}
+
+// GetTrustConnections returns all trusted connections,
+// including private and public
+func (gw *Gateway) GetTrustConnections() interface{} {
+	return gw.drpc.GetTrustConnections(gw.d)
+}
+
+// GetExchgConnection returns all exchangeable connections,
+// including private and public
+func (gw *Gateway) GetExchgConnection() interface{} {
+	return gw.drpc.GetAllExchgConnections(gw.d)
+}
+
+// GetCompressionStats returns the compression stats of sent messages, by message type
+func (gw *Gateway) GetCompressionStats() map[string]gnet.CompressionStat {
+	return gw.d.Pool.Pool.CompressionStats.Get()
+}
+
+/* Blockchain & Transaction status */
+//DEPRECATE
+
+// GetBlockchainProgress returns a *BlockchainProgress
+func (gw *Gateway) GetBlockchainProgress() interface{} {
+	return gw.drpc.GetBlockchainProgress(gw.d.Visor)
+}
+
+// ResendTransaction resent the transaction and return a *ResendResult
+func (gw *Gateway) ResendTransaction(txn cipher.SHA256) interface{} {
+	var result interface{}
+	if err := gw.strand(func() error {
+		result = gw.drpc.ResendTransaction(gw.d.Visor, gw.d.Pool, txn)
+		return nil
+	}); err != nil {
+		logger.Error("gateway.ResendTransaction failed: %v", err)
+	}
+	return result
+}
+
+// ResendUnconfirmedTxns resents all unconfirmed transactions
+func (gw *Gateway) ResendUnconfirmedTxns() (rlt *ResendResult, err error) {
+	err = gw.strand(func() error {
+		rlt = gw.drpc.ResendUnconfirmedTxns(gw.d.Visor, gw.d.Pool)
+		return nil
+	})
+	return
+}
+
+// GetBlockchainMetadata returns a *visor.BlockchainMetadata
+func (gw *Gateway) GetBlockchainMetadata() interface{} {
+	var bcm interface{}
+	gw.view(func() {
+		bcm = gw.vrpc.GetBlockchainMetadata(gw.v)
+	})
+	return bcm
+}
+
+// GetBlockByHash returns the block by hash
+func (gw *Gateway) GetBlockByHash(hash cipher.SHA256) (block coin.SignedBlock, ok bool) {
+	b, err := gw.v.GetBlockByHash(hash)
+	if err != nil {
+		logger.Error("gateway.GetBlockByHash failed: %v", err)
+		return
+	}
+	if b == nil {
+		return
+	}
+	return *b, true
+}
+
+// GetBlockBySeq returns blcok by seq
+func (gw *Gateway) GetBlockBySeq(seq uint64) (block coin.SignedBlock, ok bool) {
+	b, err := gw.v.GetBlockBySeq(seq)
+	if err != nil {
+		logger.Error("gateway.GetBlockBySeq failed: %v", err)
+		return
+	}
+	if b == nil {
+		return
+	}
+	return *b, true
+}
+
+// GetBlocks returns a *visor.ReadableBlocks
+func (gw *Gateway) GetBlocks(start, end uint64) (*visor.ReadableBlocks, error) {
+	blocks := gw.vrpc.GetBlocks(gw.v, start, end)
+	return visor.NewReadableBlocks(blocks)
+}
+
+// GetBlocksInDepth returns blocks in different depth
+func (gw *Gateway) GetBlocksInDepth(vs []uint64) (*visor.ReadableBlocks, error) {
+	blocks := []coin.SignedBlock{}
+	for _, n := range vs {
+		b, err := gw.vrpc.GetBlockBySeq(gw.v, n)
+		if err != nil {
+			return nil, fmt.Errorf("get block %v failed: %v", n, err)
+		}
+		blocks = append(blocks, *b)
+	}
+
+	return visor.NewReadableBlocks(blocks)
+}
+
+// GetLastBlocks get last N blocks
+func (gw *Gateway) GetLastBlocks(num uint64) (*visor.ReadableBlocks, error) {
+	blocks := gw.vrpc.GetLastBlocks(gw.v, num)
+	return visor.NewReadableBlocks(blocks)
+}
+
+// OutputsFilter used as optional arguments in GetUnspentOutputs method
+type OutputsFilter func(outputs coin.UxArray) coin.UxArray
+
+// GetUnspentOutputs gets unspent outputs and returns the filtered results,
+// Note: all filters will be executed as the pending sequence in 'AND' mode.
+func (gw *Gateway) GetUnspentOutputs(filters ...OutputsFilter) (visor.ReadableOutputSet, error) {
+	// unspent outputs
+	var unspentOutputs []coin.UxOut
+	// unconfirmed spending outputs
+	var uncfmSpendingOutputs coin.UxArray
+	// unconfirmed incoming outputs
+	var uncfmIncomingOutputs coin.UxArray
+	var err error
+	gw.view(func() {
+		unspentOutputs, err = gw.v.GetUnspentOutputs()
+		if err != nil {
+			err = fmt.Errorf("get unspent output readables failed: %v", err)
+			return
+		}
+
+		uncfmSpendingOutputs, err = gw.v.UnconfirmedSpendingOutputs()
+		if err != nil {
+			err = fmt.Errorf("get unconfirmed spending outputs failed: %v", err)
+			return
+		}
+
+		uncfmIncomingOutputs, err = gw.v.UnconfirmedIncomingOutputs()
+		if err != nil {
+			err = fmt.Errorf("get all incomming outputs failed: %v", err)
+			return
+		}
+	})
+
+	if err != nil {
+		return visor.ReadableOutputSet{}, err
+	}
+
+	for _, flt := range filters {
+		unspentOutputs = flt(unspentOutputs)
+		uncfmSpendingOutputs = flt(uncfmSpendingOutputs)
+		uncfmIncomingOutputs = flt(uncfmIncomingOutputs)
+	}
+
+	outputSet := visor.ReadableOutputSet{}
+	outputSet.HeadOutputs, err = visor.NewReadableOutputs(unspentOutputs)
+	if err != nil {
+		return visor.ReadableOutputSet{}, err
+	}
+
+	outputSet.OutgoingOutputs, err = visor.NewReadableOutputs(uncfmSpendingOutputs)
+	if err != nil {
+		return visor.ReadableOutputSet{}, err
+	}
+
+	outputSet.IncomingOutputs, err = visor.NewReadableOutputs(uncfmIncomingOutputs)
+	if err != nil {
+		return visor.ReadableOutputSet{}, err
+	}
+
+	return outputSet, nil
+}
+
+// FbyAddressesNotIncluded filters the unspent outputs that are not owned by the addresses
+func FbyAddressesNotIncluded(addrs []string) OutputsFilter {
+	return func(outputs coin.UxArray) coin.UxArray {
+		addrMatch := coin.UxArray{}
+		addrMap := make(map[string]bool)
+		for _, addr := range addrs {
+			addrMap[addr] = false
+		}
+
+		for _, u := range outputs {
+			_, ok := addrMap[u.Body.Address.String()]
+			if !ok {
+				addrMatch = append(addrMatch, u)
+			}
+		}
+		return addrMatch
+	}
+}
+
+// FbyAddresses filters the unspent outputs that owned by the addresses
+func FbyAddresses(addrs []string) OutputsFilter {
+	return func(outputs coin.UxArray) coin.UxArray {
+		addrMatch := coin.UxArray{}
+		addrMap := make(map[string]bool)
+		for _, addr := range addrs {
+			addrMap[addr] = true
+		}
+
+		for _, u := range outputs {
+			if _, ok := addrMap[u.Body.Address.String()]; ok {
+				addrMatch = append(addrMatch, u)
+			}
+		}
+		return addrMatch
+	}
+}
+
+// FbyHashes filters the unspent outputs that have hashes matched.
+func FbyHashes(hashes []string) OutputsFilter {
+	return func(outputs coin.UxArray) coin.UxArray {
+		hsMatch := coin.UxArray{}
+		hsMap := make(map[string]bool)
+		for _, h := range hashes {
+			hsMap[h] = true
+		}
+
+		for _, u := range outputs {
+			if _, ok := hsMap[u.Hash().Hex()]; ok {
+				hsMatch = append(hsMatch, u)
+			}
+		}
+		return hsMatch
+	}
+}
+
+// GetTransaction returns transaction by txid.  It looks in the unconfirmed
+// pool and then in the history, in view so that a block executed meanwhile
+// doesn't move the txn between them.  The history is updated by the
+// blockchain parser after the block is executed, so a txn just confirmed
+// may still be reported as evicted until the parser catches up.
+func (gw *Gateway) GetTransaction(txid cipher.SHA256) (tx *visor.Transaction, err error) {
+	gw.view(func() {
+		tx, err = gw.v.GetTransaction(txid)
+	})
+	return
+}
+
+// GetTransactionResult gets transaction result by txid, see GetTransaction
+func (gw *Gateway) GetTransactionResult(txid cipher.SHA256) (*visor.TransactionResult, error) {
+	var tx *visor.Transaction
+	var err error
+	gw.view(func() {
+		tx, err = gw.vrpc.GetTransaction(gw.v, txid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return visor.NewTransactionResult(tx)
+}
+
+// InjectTransaction injects transaction
+func (gw *Gateway) InjectTransaction(txn coin.Transaction) error {
+	return gw.strand(func() error {
+		return gw.d.Visor.InjectTransaction(txn, gw.d.Pool)
+	})
+}
+
+// GetAddressTxns returns a *visor.TransactionResults
+func (gw *Gateway) GetAddressTxns(a cipher.Address) (*visor.TransactionResults, error) {
+	txs, err := gw.vrpc.GetAddressTxns(gw.v, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return visor.NewTransactionResults(txs)
+}
+
+// GetUxOutByID gets UxOut by hash id.
+func (gw *Gateway) GetUxOutByID(id cipher.SHA256) (*historydb.UxOut, error) {
+	return gw.v.GetUxOutByID(id)
+}
+
+// GetAddrUxOuts gets all the address affected UxOuts.
+func (gw *Gateway) GetAddrUxOuts(addr cipher.Address) ([]*historydb.UxOutJSON, error) {
+	uxouts, err := gw.v.GetAddrUxOuts(addr)
+	uxs := make([]*historydb.UxOutJSON, len(uxouts))
+	for i, ux := range uxouts {
+		uxs[i] = historydb.NewUxOutJSON(ux)
+	}
+	return uxs, err
+}
+
+// GetAddressUxOuts gets all the address affected UxOuts.
+func (gw *Gateway) GetAddressUxOuts(addr cipher.Address) ([]*historydb.UxOut, error) {
+	return gw.v.GetAddrUxOuts(addr)
+}
+
+// GetTimeNow returns the current Unix time of the blockchain clock, which is
+// ahead of the system time on regtest networks once the clock is advanced
+func (gw *Gateway) GetTimeNow() uint64 {
+	return gw.v.Now()
+}
+
+// GenerateBlocks creates n blocks right away, on regtest networks
+func (gw *Gateway) GenerateBlocks(n int) ([]coin.SignedBlock, error) {
+	return gw.d.Visor.GenerateBlocks(gw.d.Pool.Pool, n)
+}
+
+// FundAddress sends coins from the genesis address to addr in a new block, on
+// regtest networks
+func (gw *Gateway) FundAddress(addr cipher.Address, coins uint64) (coin.Transaction, coin.SignedBlock, error) {
+	return gw.d.Visor.FundAddress(gw.d.Pool.Pool, addr, coins)
+}
+
+// AdvanceTime moves the blockchain clock forward by d and returns the new
+// time, on regtest networks
+func (gw *Gateway) AdvanceTime(d time.Duration) (uint64, error) {
+	return gw.d.Visor.AdvanceTime(d)
+}
+
+// BindBlockListener registers ls to be called with each new block once the
+// block is stored in the history db.  ls runs on the parser goroutine and must
+// not block.  The returned function unregisters ls.
+func (gw *Gateway) BindBlockListener(ls visor.BlockListener) func() {
+	return gw.v.BindParsedBlockListener(ls)
+}
+
+// BindTxnListener registers ls to be called with each transaction added to the
+// unconfirmed pool.  ls runs while the visor state is locked and must not block.
+// The returned function unregisters ls.
+func (gw *Gateway) BindTxnListener(ls visor.TxnListener) func() {
+	return gw.v.BindTxnListener(ls)
+}
+
+// GetParsedHeight returns the seq of the last block stored in the history db,
+// -1 if there is none.  Blocks up to this seq have been passed to the block
+// listeners.
+func (gw *Gateway) GetParsedHeight() int64 {
+	return gw.v.ParsedHeight()
+}
+
+// GetAllUnconfirmedTxns returns all unconfirmed transactions
+func (gw *Gateway) GetAllUnconfirmedTxns() (txns []visor.UnconfirmedTxn) {
+	gw.view(func() {
+		txns = gw.v.GetAllUnconfirmedTxns()
+	})
+	return
+}
+
+// GetUnconfirmedTxns returns addresses related unconfirmed transactions
+func (gw *Gateway) GetUnconfirmedTxns(addrs []cipher.Address) (txns []visor.UnconfirmedTxn) {
+	gw.view(func() {
+		txns = gw.v.GetUnconfirmedTxns(visor.ToAddresses(addrs))
+	})
+	return
+}
+
+// GetLastTxs returns last confirmed transactions, return nil if empty
+func (gw *Gateway) GetLastTxs() (txns []*visor.Transaction, err error) {
+	return gw.v.GetLastTxs()
+}
+
+// GetUnspent returns the unspent pool
+func (gw *Gateway) GetUnspent() (unspent blockdb.UnspentPool) {
+	gw.view(func() {
+		unspent = gw.v.Blockchain.Unspent()
+	})
+	return
+}
+
+// impelemts the wallet.Validator interface
+type spendValidator struct {
+	uncfm   *visor.UnconfirmedTxnPool
+	unspent blockdb.UnspentPool
+}
+
+func newSpendValidator(uncfm *visor.UnconfirmedTxnPool, unspent blockdb.UnspentPool) *spendValidator {
+	return &spendValidator{
+		uncfm:   uncfm,
+		unspent: unspent,
+	}
+}
+
+func (sv spendValidator) HasUnconfirmedSpendTx(addr []cipher.Address) (bool, error) {
+	aux, err := sv.uncfm.SpendsOfAddresses(addr, sv.unspent)
+	if err != nil {
+		return false, err
+	}
+
+	return len(aux) > 0, nil
+}
+
+// Spend spends coins from given wallet and broadcast it,
+// return transaction or error.
+func (gw *Gateway) Spend(wltID string, amt wallet.Balance, dest cipher.Address) (*coin.Transaction, error) {
+	var tx *coin.Transaction
+	err := gw.strand(func() error {
+		// create spend validator
+		unspent := gw.v.Blockchain.Unspent()
+		sv := newSpendValidator(gw.v.Unconfirmed, unspent)
+		// create and sign transaction
+		var err error
+		tx, err = gw.vrpc.CreateAndSignTransaction(wltID,
+			sv,
+			unspent,
+			gw.v.Blockchain.Time(),
+			amt,
+			dest)
+		if err != nil {
+			return fmt.Errorf("Create transaction failed: %v", err)
+		}
+
+		// inject transaction
+		if err := gw.d.Visor.InjectTransaction(*tx, gw.d.Pool); err != nil {
+			return fmt.Errorf("Inject transaction failed: %v", err)
+		}
+		return nil
+	})
+
+	return tx, err
+}
+
+// NewWallet creates wallet
+func (gw *Gateway) NewWallet(wltName string, options ...wallet.Option) (wlt wallet.Wallet, err error) {
+	err = gw.strand(func() error {
+		var err error
+		wlt, err = gw.vrpc.NewWallet(wltName, options...)
+		return err
+	})
+	return
+}
+
+// CreateSpendingTransaction creates spending transactions
+func (gw *Gateway) CreateSpendingTransaction(wlt wallet.Wallet,
+	amt wallet.Balance,
+	dest cipher.Address) (tx *coin.Transaction, err error) {
+	gw.view(func() {
+		// generate spend validator
+		unspent := gw.v.Blockchain.Unspent()
+		sv := newSpendValidator(gw.v.Unconfirmed, unspent)
+
+		// create and sign transaction
+		tx, err = wlt.CreateAndSignTransaction(sv,
+			unspent,
+			gw.v.Blockchain.Time(),
+			amt,
+			dest)
+	})
+	return
+}
+
+// GetWalletBalance returns balance pair of specific wallet
+func (gw *Gateway) GetWalletBalance(wltID string) (balance wallet.BalancePair, err error) {
+	gw.view(func() {
+		var addrs []cipher.Address
+		addrs, err = gw.vrpc.GetWalletAddresses(wltID)
+		if err != nil {
+			return
+		}
+		auxs := gw.vrpc.GetUnspent(gw.v).GetUnspentsOfAddrs(addrs)
+
+		var spendUxs coin.AddressUxOuts
+		spendUxs, err = gw.vrpc.GetUnconfirmedSpends(gw.v, addrs)
+		if err != nil {
+			err = fmt.Errorf("get unconfimed spending failed when checking wallet balance: %v", err)
+			return
+		}
+
+		var recvUxs coin.AddressUxOuts
+		recvUxs, err = gw.vrpc.GetUnconfirmedReceiving(gw.v, addrs)
+		if err != nil {
+			err = fmt.Errorf("get unconfirmed receiving failed when when checking wallet balance: %v", err)
+			return
+		}
+
+		coins1, hours1 := gw.v.AddressBalance(auxs)
+		coins2, hours2 := gw.v.AddressBalance(auxs.Sub(spendUxs).Add(recvUxs))
+		balance = wallet.BalancePair{
+			Confirmed: wallet.Balance{Coins: coins1, Hours: hours1},
+			Predicted: wallet.Balance{Coins: coins2, Hours: hours2},
+		}
+	})
+	return
+}
+
+// GetAddressesBalance gets balance of given addresses
+func (gw *Gateway) GetAddressesBalance(addrs []cipher.Address) (balance wallet.BalancePair, err error) {
+	gw.view(func() {
+		auxs := gw.vrpc.GetUnspent(gw.v).GetUnspentsOfAddrs(addrs)
+		var spendUxs coin.AddressUxOuts
+		spendUxs, err = gw.vrpc.GetUnconfirmedSpends(gw.v, addrs)
+		if err != nil {
+			err = fmt.Errorf("get unconfirmed spending failed when checking addresses balance: %v", err)
+			return
+		}
+
+		var recvUxs coin.AddressUxOuts
+		recvUxs, err = gw.vrpc.GetUnconfirmedReceiving(gw.v, addrs)
+		if err != nil {
+			err = fmt.Errorf("get unconfirmed receiving failed when checking addresses balance: %v", err)
+			return
+		}
+
+		uxs := auxs.Sub(spendUxs)
+		uxs = uxs.Add(recvUxs)
+		coins1, hours1 := gw.v.AddressBalance(auxs)
+		coins2, hours2 := gw.v.AddressBalance(auxs.Sub(spendUxs).Add(recvUxs))
+		balance = wallet.BalancePair{
+			Confirmed: wallet.Balance{Coins: coins1, Hours: hours1},
+			Predicted: wallet.Balance{Coins: coins2, Hours: hours2},
+		}
+	})
+	return
+}
+
+// GetAddressesPending returns the outputs of addresses spent and received by
+// the unconfirmed transactions, and the transactions
+func (gw *Gateway) GetAddressesPending(addrs []cipher.Address) (pending *visor.PendingTxns, err error) {
+	gw.view(func() {
+		pending, err = gw.v.GetPendingOfAddresses(addrs)
+	})
+	return
+}
+
+// GetWalletDir returns path for storing wallet files
+func (gw *Gateway) GetWalletDir() string {
+	return gw.v.Config.WalletDirectory
+}
+
+// NewAddresses generate addresses in given wallet
+func (gw *Gateway) NewAddresses(wltID string, n int) (addrs []cipher.Address, err error) {
+	err = gw.strand(func() error {
+		var err error
+		addrs, err = gw.vrpc.NewAddresses(wltID, n)
+		return err
+	})
+	return
+}
+
+// UpdateWalletLabel updates the label of wallet
+func (gw *Gateway) UpdateWalletLabel(wltID, label string) error {
+	return gw.strand(func() error {
+		return gw.vrpc.UpdateWalletLabel(wltID, label)
+	})
+}
+
+// GetWallet returns wallet by id
+func (gw *Gateway) GetWallet(wltID string) (w wallet.Wallet, ok bool) {
+	gw.view(func() {
+		w, ok = gw.vrpc.GetWallet(wltID)
+	})
+	return
+}
+
+// GetWallets returns wallets
+func (gw *Gateway) GetWallets() (w wallet.Wallets) {
+	gw.view(func() {
+		w = gw.vrpc.GetWallets()
+	})
+	return
+}
+
+// GetWalletUnconfirmedTxns returns all unconfirmed transactions in given wallet,
+// with their status
+func (gw *Gateway) GetWalletUnconfirmedTxns(wltID string) (txns []visor.UnconfirmedTxnResult, err error) {
+	gw.view(func() {
+		var addrs []cipher.Address
+		addrs, err = gw.vrpc.GetWalletAddresses(wltID)
+		if err != nil {
+			return
+		}
+
+		txns = gw.v.GetUnconfirmedTxnResults(visor.ToAddresses(addrs))
+	})
+	return
+}
+
+// ReloadWallets reloads all wallets
+func (gw *Gateway) ReloadWallets() error {
+	return gw.strand(func() error {
+		return gw.vrpc.ReloadWallets()
+	})
+}
+
+// GetBuildInfo returns node build info.
+func (gw *Gateway) GetBuildInfo() (bi visor.BuildInfo) {
+	gw.view(func() {
+		bi = gw.vrpc.GetBuildInfo()
+	})
+	return
+}
@@ -0,0 +1,352 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFbyAddresses(t *testing.T) {
+	uxs := make(coin.UxArray, 5)
+	addrs := make([]cipher.Address, 5)
+	for i := 0; i < 5; i++ {
+		addrs[i] = testutil.MakeAddress()
+		uxs[i] = coin.UxOut{
+			Body: coin.UxBody{
+				Address: addrs[i],
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		addrs   []string
+		outputs []coin.UxOut
+		want    []coin.UxOut
+	}{
+		// TODO: Add test cases.
+		{
+			"filter with one address",
+			[]string{addrs[0].String()},
+			uxs[:2],
+			uxs[:1],
+		},
+		{
+			"filter with multiple addresses",
+			[]string{addrs[0].String(), addrs[1].String()},
+			uxs[:3],
+			uxs[:2],
+		},
+	}
+	for _, tt := range tests {
+		// fmt.Printf("want:%+v\n", tt.want)
+		outs := FbyAddresses(tt.addrs)(tt.outputs)
+		require.Equal(t, outs, coin.UxArray(tt.want))
+	}
+}
+
+func TestFbyHashes(t *testing.T) {
+	uxs := make(coin.UxArray, 5)
+	addrs := make([]cipher.Address, 5)
+	for i := 0; i < 5; i++ {
+		addrs[i] = testutil.MakeAddress()
+		uxs[i] = coin.UxOut{
+			Body: coin.UxBody{
+				Address: addrs[i],
+			},
+		}
+	}
+
+	type args struct {
+		hashes []string
+	}
+	tests := []struct {
+		name    string
+		hashes  []string
+		outputs coin.UxArray
+		want    coin.UxArray
+	}{
+		// TODO: Add test cases.
+		{
+			"filter with one hash",
+			[]string{uxs[0].Hash().Hex()},
+			uxs[:2],
+			uxs[:1],
+		},
+		{
+			"filter with multiple hash",
+			[]string{uxs[0].Hash().Hex(), uxs[1].Hash().Hex()},
+			uxs[:3],
+			uxs[:2],
+		},
+	}
+	for _, tt := range tests {
+		outs := FbyHashes(tt.hashes)(tt.outputs)
+		require.Equal(t, outs, coin.UxArray(tt.want))
+	}
+}
+
+// setupGateway creates a Gateway on a daemon whose blockchain only has the
+// genesis block.  The daemon loop is not running.
+func setupGateway(tb testing.TB) (*Gateway, func()) {
+	f, err := ioutil.TempFile("", "testdb")
+	require.NoError(tb, err)
+	f.Close()
+
+	db, err := bolt.Open(f.Name(), 0600, nil)
+	require.NoError(tb, err)
+
+	bc, err := visor.NewBlockchain(db, GenesisPublic)
+	require.NoError(tb, err)
+
+	gb, err := coin.NewGenesisBlock(GenesisAddress, GenesisCoins, GenesisTime)
+	require.NoError(tb, err)
+	require.NoError(tb, db.Update(func(tx *bolt.Tx) error {
+		return bc.ExecuteBlockWithTx(tx, &coin.SignedBlock{
+			Block: *gb,
+			Sig:   cipher.SignHash(gb.HashHeader(), GenesisSecret),
+		})
+	}))
+
+	d := &Daemon{
+		Visor: setupSimpleVisor(db, bc),
+	}
+	d.Gateway = NewGateway(NewGatewayConfig(), d)
+
+	return d.Gateway, func() {
+		db.Close()
+		os.Remove(f.Name())
+	}
+}
+
+// runBusyLoop serves the gateway requests like Daemon.Run does, while
+// processing a steady stream of peer messages which take msgTime each
+func runBusyLoop(gw *Gateway, msgTime time.Duration) func() {
+	quit := make(chan struct{})
+	msgs := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case msgs <- struct{}{}:
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-quit:
+				return
+			case req := <-gw.requests:
+				req()
+			case <-msgs:
+				time.Sleep(msgTime)
+			}
+		}
+	}()
+
+	return func() {
+		close(quit)
+	}
+}
+
+func TestGatewayQueriesDontNeedDaemonLoop(t *testing.T) {
+	gw, shutdown := setupGateway(t)
+	defer shutdown()
+
+	// the results are checked on the test goroutine, require can't fail
+	// the test from another one
+	var (
+		b       coin.SignedBlock
+		ok      bool
+		outs    visor.ReadableOutputSet
+		outsErr error
+		bal     wallet.BalancePair
+		balErr  error
+	)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b, ok = gw.GetBlockBySeq(0)
+		outs, outsErr = gw.GetUnspentOutputs()
+		bal, balErr = gw.GetAddressesBalance([]cipher.Address{GenesisAddress})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("read-only queries are waiting for the daemon loop")
+	}
+
+	require.True(t, ok)
+	require.Equal(t, uint64(0), b.Head.BkSeq)
+	require.NoError(t, outsErr)
+	require.Len(t, outs.HeadOutputs, 1)
+	require.NoError(t, balErr)
+	require.Equal(t, GenesisCoins, bal.Confirmed.Coins)
+}
+
+func TestGatewayViewWaitsForUpdates(t *testing.T) {
+	gw, shutdown := setupGateway(t)
+	defer shutdown()
+
+	gw.d.Visor.stateLk.Lock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		gw.GetAddressesBalance([]cipher.Address{GenesisAddress}) // nolint: errcheck
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("query ran while the state was being updated")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	gw.d.Visor.stateLk.Unlock()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("query did not run after the update")
+	}
+}
+
+func TestGatewayBlockQueriesDontWaitForUpdates(t *testing.T) {
+	gw, shutdown := setupGateway(t)
+	defer shutdown()
+
+	// block and history queries read bolt snapshots, they don't hold back
+	// the blocks being executed nor wait for them
+	gw.d.Visor.stateLk.Lock()
+	defer gw.d.Visor.stateLk.Unlock()
+
+	var (
+		ok        bool
+		blocks    *visor.ReadableBlocks
+		blocksErr error
+		last      *visor.ReadableBlocks
+		lastErr   error
+	)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, ok = gw.GetBlockBySeq(0)
+		blocks, blocksErr = gw.GetBlocks(0, 10)
+		last, lastErr = gw.GetLastBlocks(1)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("block queries are waiting for the state to be updated")
+	}
+
+	require.True(t, ok)
+	require.NoError(t, blocksErr)
+	require.Len(t, blocks.Blocks, 1)
+	require.NoError(t, lastErr)
+	require.Len(t, last.Blocks, 1)
+}
+
+// BenchmarkGatewayQueries compares the throughput of read-only queries served
+// by the daemon loop (strand), as they used to be, with queries running
+// concurrently (view), while the daemon loop is busy processing peer messages.
+func BenchmarkGatewayQueries(b *testing.B) {
+	gw, shutdown := setupGateway(b)
+	defer shutdown()
+
+	stop := runBusyLoop(gw, 100*time.Microsecond)
+	defer stop()
+
+	queries := []struct {
+		name string
+		f    func()
+	}{
+		{"GetBlockBySeq", func() {
+			gw.GetBlockBySeq(0)
+		}},
+		{"GetUnspentOutputs", func() {
+			gw.GetUnspentOutputs()
+		}},
+		{"GetAddressesBalance", func() {
+			gw.GetAddressesBalance([]cipher.Address{GenesisAddress})
+		}},
+	}
+
+	for _, q := range queries {
+		b.Run(q.name+"/strand", func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					gw.strand(func() error { // nolint: errcheck
+						q.f()
+						return nil
+					})
+				}
+			})
+		})
+
+		b.Run(q.name+"/view", func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					q.f()
+				}
+			})
+		})
+	}
+}
+
+func TestGatewayGetAddressesPending(t *testing.T) {
+	gw, shutdown := setupGateway(t)
+	defer shutdown()
+
+	_, _, addr := MakeAddress()
+	_, _, other := MakeAddress()
+	// the change gets no coin hours, they are all burnt
+	bc := gw.d.Visor.v.Blockchain
+	uxs, err := bc.Unspent().GetAll()
+	require.NoError(t, err)
+	txn := createGenesisSpendTransaction(t, bc, addr, 10e6, 0, uxs[0].CoinHours(bc.Time()))
+	_, err = gw.d.Visor.v.InjectTxn(txn)
+	require.NoError(t, err)
+	txid := txn.Hash().Hex()
+
+	pending, err := gw.GetAddressesPending([]cipher.Address{GenesisAddress, addr, other, addr})
+	require.NoError(t, err)
+	require.Len(t, pending.Transactions, 1)
+	require.Equal(t, txid, pending.Transactions[0].Transaction.Hash)
+	require.True(t, pending.Transactions[0].Status.Unconfirmed)
+	require.Len(t, pending.Addresses, 3)
+
+	// the genesis address spends its output and receives the change
+	genesis := pending.Addresses[0]
+	require.Equal(t, GenesisAddress.String(), genesis.Address)
+	require.Len(t, genesis.Spends, 1)
+	require.Equal(t, txid, genesis.Spends[0].Txid)
+	require.Equal(t, txn.In[0].Hex(), genesis.Spends[0].Hash)
+	require.Len(t, genesis.Receipts, 1)
+	require.Equal(t, txid, genesis.Receipts[0].Txid)
+	require.Equal(t, GenesisCoins, genesis.Outgoing.Coins)
+	require.Equal(t, GenesisCoins-10e6, genesis.Incoming.Coins)
+
+	require.Equal(t, addr.String(), pending.Addresses[1].Address)
+	require.Empty(t, pending.Addresses[1].Spends)
+	require.Len(t, pending.Addresses[1].Receipts, 1)
+	require.Equal(t, txid, pending.Addresses[1].Receipts[0].Txid)
+	require.Equal(t, uint64(10e6), pending.Addresses[1].Incoming.Coins)
+
+	require.Equal(t, other.String(), pending.Addresses[2].Address)
+	require.Empty(t, pending.Addresses[2].Spends)
+	require.Empty(t, pending.Addresses[2].Receipts)
+}
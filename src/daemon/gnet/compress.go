@@ -0,0 +1,166 @@
+package gnet
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+)
+
+/*
+A compressed message has the layout
+
+	length | CompressedPrefix | original prefix | flate(original body)
+
+It is only sent to connections which advertised support for it, and it is
+inflated back to the original prefix and body in decodeData, before the
+message is handed to convertToMessage.
+*/
+
+// CompressedPrefix marks a message whose body is flate compressed.
+// It is not registered as a message type.
+var CompressedPrefix = MessagePrefix{'C', 'M', 'P', 'R'}
+
+var (
+	// ErrDisconnectMalformedCompressedMessage compressed message could not be inflated
+	ErrDisconnectMalformedCompressedMessage DisconnectReason = errors.New("Malformed compressed message")
+)
+
+// compressMessage compresses the body of an encoded message, as produced by
+// encodeMessage.  The original bytes are returned if the body is smaller than
+// minSize or compressing it doesn't save any space.
+func compressMessage(m []byte, minSize int) []byte {
+	headerLen := messageLengthSize + messagePrefixLength
+	if len(m)-headerLen < minSize {
+		return m
+	}
+
+	var buf bytes.Buffer
+	// reserve the space for length prefix
+	buf.Write(make([]byte, messageLengthSize))
+	buf.Write(CompressedPrefix[:])
+	buf.Write(m[messageLengthSize:headerLen])
+
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		logger.Error("Create flate writer failed: %v", err)
+		return m
+	}
+
+	if _, err := w.Write(m[headerLen:]); err != nil {
+		logger.Error("Compress message failed: %v", err)
+		return m
+	}
+
+	if err := w.Close(); err != nil {
+		logger.Error("Compress message failed: %v", err)
+		return m
+	}
+
+	if buf.Len() >= len(m) {
+		return m
+	}
+
+	c := buf.Bytes()
+	copy(c[:messageLengthSize], encoder.SerializeAtomic(uint32(len(c)-messageLengthSize)))
+	return c
+}
+
+// isCompressedMessage returns true if the message data, stripped of the length
+// prefix, starts with CompressedPrefix
+func isCompressedMessage(data []byte) bool {
+	return len(data) >= messagePrefixLength &&
+		bytes.Equal(data[:messagePrefixLength], CompressedPrefix[:])
+}
+
+// decompressMessage inflates message data that was stripped of the length prefix.
+// The result contains the original message prefix and body.  The inflated
+// message may not be longer than maxMsgLength.
+func decompressMessage(data []byte, maxMsgLength int) ([]byte, error) {
+	if len(data) < 2*messagePrefixLength {
+		return nil, ErrDisconnectMalformedCompressedMessage
+	}
+
+	r := flate.NewReader(bytes.NewReader(data[2*messagePrefixLength:]))
+	defer r.Close()
+
+	// read one extra byte to detect the messages that exceed the limit
+	body, err := ioutil.ReadAll(io.LimitReader(r, int64(maxMsgLength-messagePrefixLength+1)))
+	if err != nil {
+		return nil, ErrDisconnectMalformedCompressedMessage
+	}
+
+	if len(body)+messagePrefixLength > maxMsgLength {
+		return nil, ErrDisconnectInvalidMessageLength
+	}
+
+	m := make([]byte, 0, messagePrefixLength+len(body))
+	m = append(m, data[messagePrefixLength:2*messagePrefixLength]...)
+	m = append(m, body...)
+	return m, nil
+}
+
+// CompressionStat records the number of messages of one type sent, and
+// their sizes before and after compression
+type CompressionStat struct {
+	Messages         uint64 `json:"messages"`
+	Compressed       uint64 `json:"compressed"`
+	RawBytes         uint64 `json:"raw_bytes"`
+	TransmittedBytes uint64 `json:"transmitted_bytes"`
+	// Ratio of transmitted bytes to raw bytes, filled in by CompressionStats.Get
+	Ratio float64 `json:"ratio"`
+}
+
+// CompressionStats collects CompressionStat by message prefix
+type CompressionStats struct {
+	stats map[MessagePrefix]CompressionStat
+	lk    sync.Mutex
+}
+
+// NewCompressionStats creates CompressionStats
+func NewCompressionStats() *CompressionStats {
+	return &CompressionStats{
+		stats: make(map[MessagePrefix]CompressionStat),
+	}
+}
+
+// record adds a sent message to the stats, raw is the message encoded by encodeMessage,
+// sent is the bytes written to the connection
+func (cs *CompressionStats) record(raw, sent []byte) {
+	if len(raw) < messageLengthSize+messagePrefixLength {
+		return
+	}
+
+	var prefix MessagePrefix
+	copy(prefix[:], raw[messageLengthSize:])
+
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+	s := cs.stats[prefix]
+	s.Messages++
+	if len(sent) != len(raw) {
+		s.Compressed++
+	}
+	s.RawBytes += uint64(len(raw))
+	s.TransmittedBytes += uint64(len(sent))
+	cs.stats[prefix] = s
+}
+
+// Get returns a copy of the stats, indexed by message prefix
+func (cs *CompressionStats) Get() map[string]CompressionStat {
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+	stats := make(map[string]CompressionStat, len(cs.stats))
+	for k, v := range cs.stats {
+		v.Ratio = 1
+		if v.RawBytes != 0 {
+			v.Ratio = float64(v.TransmittedBytes) / float64(v.RawBytes)
+		}
+		stats[string(bytes.TrimRight(k[:], "\x00"))] = v
+	}
+	return stats
+}
@@ -0,0 +1,103 @@
+package gnet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressMessage(t *testing.T) {
+	EraseMessages()
+	resetHandler()
+	RegisterMessage(BytesPrefix, BytesMessage{})
+	VerifyMessages()
+
+	m := encodeMessage(&BytesMessage{Bytes: bytes.Repeat([]byte("skycoin"), 1000)})
+
+	// body smaller than minSize is left alone
+	assert.Equal(t, m, compressMessage(m, len(m)))
+
+	c := compressMessage(m, 0)
+	require.True(t, len(c) < len(m))
+
+	buf := bytes.NewBuffer(c)
+	datas, err := decodeData(buf, 256*1024)
+	require.NoError(t, err)
+	require.Len(t, datas, 1)
+	assert.Equal(t, m[messageLengthSize:], datas[0])
+	assert.Equal(t, 0, buf.Len())
+
+	msg, err := convertToMessage(0, datas[0], testing.Verbose())
+	require.NoError(t, err)
+	assert.Equal(t, bytes.Repeat([]byte("skycoin"), 1000), msg.(*BytesMessage).Bytes)
+}
+
+func TestCompressMessageIncompressible(t *testing.T) {
+	EraseMessages()
+	resetHandler()
+	RegisterMessage(BytePrefix, ByteMessage{})
+	VerifyMessages()
+
+	m := encodeMessage(&ByteMessage{X: 7})
+	assert.Equal(t, m, compressMessage(m, 0))
+}
+
+func TestDecompressMessageTooLong(t *testing.T) {
+	EraseMessages()
+	resetHandler()
+	RegisterMessage(BytesPrefix, BytesMessage{})
+	VerifyMessages()
+
+	m := encodeMessage(&BytesMessage{Bytes: make([]byte, 4096)})
+	c := compressMessage(m, 0)
+	require.True(t, len(c) < 1024)
+
+	// the compressed message fits in the limit, the inflated one does not
+	_, err := decodeData(bytes.NewBuffer(c), 1024)
+	assert.Equal(t, ErrDisconnectInvalidMessageLength, err)
+}
+
+func TestDecompressMessageMalformed(t *testing.T) {
+	data := append([]byte{}, CompressedPrefix[:]...)
+	_, err := decompressMessage(data, 1024)
+	assert.Equal(t, ErrDisconnectMalformedCompressedMessage, err)
+
+	data = append(data, BytePrefix[:]...)
+	data = append(data, []byte("not a flate stream")...)
+	_, err = decompressMessage(data, 1024)
+	assert.Equal(t, ErrDisconnectMalformedCompressedMessage, err)
+}
+
+func TestCompressionStats(t *testing.T) {
+	EraseMessages()
+	resetHandler()
+	RegisterMessage(BytesPrefix, BytesMessage{})
+	VerifyMessages()
+
+	cs := NewCompressionStats()
+	m := encodeMessage(&BytesMessage{Bytes: make([]byte, 4096)})
+	c := compressMessage(m, 0)
+	cs.record(m, c)
+	cs.record(m, m)
+
+	stats := cs.Get()
+	require.Len(t, stats, 1)
+	s := stats["BYTS"]
+	assert.Equal(t, uint64(2), s.Messages)
+	assert.Equal(t, uint64(1), s.Compressed)
+	assert.Equal(t, uint64(2*len(m)), s.RawBytes)
+	assert.Equal(t, uint64(len(m)+len(c)), s.TransmittedBytes)
+	assert.Equal(t, float64(len(m)+len(c))/float64(2*len(m)), s.Ratio)
+}
+
+var BytesPrefix = MessagePrefix{'B', 'Y', 'T', 'S'}
+
+type BytesMessage struct {
+	Bytes []byte
+}
+
+func (bm *BytesMessage) Handle(c *MessageContext, data interface{}) error {
+	return nil
+}
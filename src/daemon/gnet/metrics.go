@@ -0,0 +1,21 @@
+package gnet
+
+import (
+	"bytes"
+
+	"github.com/skycoin/skycoin/src/util/metrics"
+)
+
+var (
+	messagesTotal = metrics.NewCounterVec("shellcoin_gnet_messages_total",
+		"Number of messages by type and direction", "type", "direction")
+	messageBytesTotal = metrics.NewCounterVec("shellcoin_gnet_message_bytes_total",
+		"Size of the messages before compression, by type and direction", "type", "direction")
+)
+
+// recordMessage counts a message in the metrics, m starts with the message prefix
+func recordMessage(direction string, m []byte) {
+	t := string(bytes.TrimRight(m[:messagePrefixLength], "\x00"))
+	messagesTotal.With(t, direction).Inc()
+	messageBytesTotal.With(t, direction).Add(float64(len(m)))
+}
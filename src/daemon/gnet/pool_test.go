@@ -170,6 +170,35 @@ func TestStartListen(t *testing.T) {
 	<-q
 }
 
+func TestStartListenOnListener(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	cfg := newTestConfig()
+	cfg.Port = 0
+	cfg.Listener = ln
+	p := NewConnectionPool(cfg, nil)
+	q := make(chan struct{})
+	go func() {
+		defer close(q)
+		p.Run()
+	}()
+	wait()
+	c, err := net.Dial("tcp", ln.Addr().String())
+	assert.Nil(t, err)
+	defer c.Close()
+	wait()
+	assert.Equal(t, ln, p.listener)
+	assert.Equal(t, 1, len(p.pool))
+
+	p.Shutdown()
+	<-q
+
+	// the listener is closed on Shutdown
+	_, err = net.Dial("tcp", ln.Addr().String())
+	assert.NotNil(t, err)
+}
+
 func TestStartListenTwice(t *testing.T) {
 	cfg := newTestConfig()
 	p := NewConnectionPool(cfg, nil)
@@ -417,7 +446,7 @@ func TestConnectionReadLoop(t *testing.T) {
 
 	p.Config.DisconnectCallback = func(addr string, reason DisconnectReason) {
 		// assert.Equal(t, connID, 1)
-		assert.Equal(t, reason, errors.New("read data failed: failed"))
+		assert.EqualError(t, reason, "read data failed: failed")
 	}
 
 	// 1:
@@ -459,7 +488,7 @@ func TestConnectionReadLoop(t *testing.T) {
 	rnconn := &ReadNothingConn{}
 	p.Config.DisconnectCallback = func(addr string, reason DisconnectReason) {
 		// assert.Equal(t, connID, 4)
-		assert.Equal(t, reason, errors.New("read data failed: done"))
+		assert.EqualError(t, reason, "read data failed: done")
 	}
 	go p.handleConnection(rnconn, false)
 	wait()
@@ -0,0 +1,144 @@
+package daemon
+
+import (
+	"sync"
+
+	"github.com/skycoin/skycoin/src/cipher"
+)
+
+// KnownTxns records the transactions known by each peer, so that we don't
+// announce transactions to peers that already know them, and don't send
+// transactions to peers that already have them.
+// A peer knows a transaction if it announced or sent it to us, or if we
+// announced or sent it to the peer.  Only the latest maxPerPeer hashes are
+// remembered for each peer.
+type KnownTxns struct {
+	maxPerPeer int
+	peers      map[string]*peerInventory
+	lk         sync.Mutex
+}
+
+// peerInventory is the set of transactions known by one peer
+type peerInventory struct {
+	// hashes maps to true if the peer has the transaction, and to false if we
+	// only announced it to the peer
+	hashes map[cipher.SHA256]bool
+	// ring buffer of the hashes, in the order they were added
+	order []cipher.SHA256
+	next  int
+}
+
+// NewKnownTxns creates KnownTxns
+func NewKnownTxns(maxPerPeer int) *KnownTxns {
+	return &KnownTxns{
+		maxPerPeer: maxPerPeer,
+		peers:      make(map[string]*peerInventory),
+	}
+}
+
+func (kt *KnownTxns) peer(addr string) *peerInventory {
+	p, ok := kt.peers[addr]
+	if !ok {
+		p = &peerInventory{
+			hashes: make(map[cipher.SHA256]bool),
+		}
+		kt.peers[addr] = p
+	}
+	return p
+}
+
+// add records the hash, evicting the oldest hash if the inventory is full
+func (pi *peerInventory) add(h cipher.SHA256, has bool, max int) {
+	if known, ok := pi.hashes[h]; ok {
+		pi.hashes[h] = known || has
+		return
+	}
+
+	if max <= 0 {
+		return
+	}
+
+	if len(pi.order) < max {
+		pi.order = append(pi.order, h)
+	} else {
+		delete(pi.hashes, pi.order[pi.next])
+		pi.order[pi.next] = h
+		pi.next = (pi.next + 1) % max
+	}
+	pi.hashes[h] = has
+}
+
+// SetHas records that the peer has the transactions
+func (kt *KnownTxns) SetHas(addr string, hashes []cipher.SHA256) {
+	kt.lk.Lock()
+	defer kt.lk.Unlock()
+	p := kt.peer(addr)
+	for _, h := range hashes {
+		p.add(h, true, kt.maxPerPeer)
+	}
+}
+
+// SetAnnounced records that the transactions were announced to the peer
+func (kt *KnownTxns) SetAnnounced(addr string, hashes []cipher.SHA256) {
+	kt.lk.Lock()
+	defer kt.lk.Unlock()
+	p := kt.peer(addr)
+	for _, h := range hashes {
+		p.add(h, false, kt.maxPerPeer)
+	}
+}
+
+// FilterKnown returns the hashes that the peer doesn't know, these are
+// the ones that need to be announced to it
+func (kt *KnownTxns) FilterKnown(addr string, hashes []cipher.SHA256) []cipher.SHA256 {
+	kt.lk.Lock()
+	defer kt.lk.Unlock()
+	p, ok := kt.peers[addr]
+	if !ok {
+		return hashes
+	}
+
+	var unknown []cipher.SHA256
+	for _, h := range hashes {
+		if _, ok := p.hashes[h]; !ok {
+			unknown = append(unknown, h)
+		}
+	}
+	return unknown
+}
+
+// FilterHas returns the hashes of the transactions that the peer doesn't have,
+// these are the ones that can be sent to it
+func (kt *KnownTxns) FilterHas(addr string, hashes []cipher.SHA256) []cipher.SHA256 {
+	kt.lk.Lock()
+	defer kt.lk.Unlock()
+	p, ok := kt.peers[addr]
+	if !ok {
+		return hashes
+	}
+
+	var missing []cipher.SHA256
+	for _, h := range hashes {
+		if !p.hashes[h] {
+			missing = append(missing, h)
+		}
+	}
+	return missing
+}
+
+// Remove forgets the inventory of the peer
+func (kt *KnownTxns) Remove(addr string) {
+	kt.lk.Lock()
+	defer kt.lk.Unlock()
+	delete(kt.peers, addr)
+}
+
+// Len returns the number of hashes known by the peer
+func (kt *KnownTxns) Len(addr string) int {
+	kt.lk.Lock()
+	defer kt.lk.Unlock()
+	if p, ok := kt.peers[addr]; ok {
+		return len(p.hashes)
+	}
+	return 0
+}
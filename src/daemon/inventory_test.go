@@ -0,0 +1,84 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+)
+
+func makeHashes(n int) []cipher.SHA256 {
+	hashes := make([]cipher.SHA256, n)
+	for i := range hashes {
+		hashes[i] = cipher.SumSHA256(cipher.RandByte(32))
+	}
+	return hashes
+}
+
+func TestKnownTxnsFilterKnown(t *testing.T) {
+	hashes := makeHashes(4)
+	kt := NewKnownTxns(10)
+
+	// unknown peer knows nothing
+	require.Equal(t, hashes, kt.FilterKnown("a", hashes))
+
+	kt.SetAnnounced("a", hashes[:1])
+	kt.SetHas("a", hashes[1:2])
+	require.Equal(t, hashes[2:], kt.FilterKnown("a", hashes))
+
+	// other peers are not affected
+	require.Equal(t, hashes, kt.FilterKnown("b", hashes))
+}
+
+func TestKnownTxnsFilterHas(t *testing.T) {
+	hashes := makeHashes(3)
+	kt := NewKnownTxns(10)
+
+	require.Equal(t, hashes, kt.FilterHas("a", hashes))
+
+	// announced txns can still be requested by the peer
+	kt.SetAnnounced("a", hashes[:2])
+	require.Equal(t, hashes, kt.FilterHas("a", hashes))
+
+	kt.SetHas("a", hashes[1:2])
+	require.Equal(t, []cipher.SHA256{hashes[0], hashes[2]}, kt.FilterHas("a", hashes))
+
+	// announcing doesn't downgrade a txn the peer has
+	kt.SetAnnounced("a", hashes[1:2])
+	require.Equal(t, []cipher.SHA256{hashes[0], hashes[2]}, kt.FilterHas("a", hashes))
+}
+
+func TestKnownTxnsEviction(t *testing.T) {
+	hashes := makeHashes(5)
+	kt := NewKnownTxns(3)
+
+	kt.SetHas("a", hashes[:3])
+	require.Equal(t, 3, kt.Len("a"))
+
+	// re-adding known hashes doesn't evict anything
+	kt.SetHas("a", hashes[:3])
+	require.Equal(t, hashes[3:], kt.FilterKnown("a", hashes))
+
+	// the oldest hashes are evicted first
+	kt.SetAnnounced("a", hashes[3:])
+	require.Equal(t, 3, kt.Len("a"))
+	require.Equal(t, hashes[:2], kt.FilterKnown("a", hashes))
+
+	kt.SetHas("a", hashes[:1])
+	require.Equal(t, 3, kt.Len("a"))
+	require.Equal(t, hashes[1:3], kt.FilterKnown("a", hashes))
+}
+
+func TestKnownTxnsRemove(t *testing.T) {
+	hashes := makeHashes(2)
+	kt := NewKnownTxns(10)
+
+	kt.SetHas("a", hashes)
+	require.Equal(t, 2, kt.Len("a"))
+	require.Empty(t, kt.FilterKnown("a", hashes))
+
+	kt.Remove("a")
+	require.Equal(t, 0, kt.Len("a"))
+	require.Equal(t, hashes, kt.FilterKnown("a", hashes))
+}
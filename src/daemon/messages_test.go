@@ -1,5 +1,121 @@
 package daemon
 
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/daemon/pex"
+	"github.com/skycoin/skycoin/src/testutil"
+)
+
+func TestClosedByPeer(t *testing.T) {
+	// read errors of a connection closed by the peer, as wrapped by gnet
+	require.True(t, closedByPeer(fmt.Errorf("read data failed: %w", io.EOF)))
+	require.True(t, closedByPeer(fmt.Errorf("read data failed: %w",
+		&net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)})))
+
+	require.False(t, closedByPeer(fmt.Errorf("read data failed: %w",
+		&net.OpError{Op: "read", Err: os.ErrDeadlineExceeded})))
+	require.False(t, closedByPeer(ErrDisconnectIdle))
+	require.False(t, closedByPeer(gnet.ErrDisconnectWriteFailed))
+}
+
+func TestSplitAddr(t *testing.T) {
+	cases := []struct {
+		addr string
+		ip   string
+		port uint16
+		err  bool
+	}{
+		{"112.32.32.14:6000", "112.32.32.14", 6000, false},
+		{"[2001:db8::1]:6000", "2001:db8::1", 6000, false},
+		{"[::1]:6000", "::1", 6000, false},
+		{"example.com:6000", "example.com", 6000, false},
+		{"2001:db8::1:6000", "", 0, true},
+		{"112.32.32.14", "", 0, true},
+		{"112.32.32.14:x", "112.32.32.14", 0, true},
+		{"112.32.32.14:70000", "112.32.32.14", 0, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.addr, func(t *testing.T) {
+			ip, port, err := SplitAddr(tc.addr)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.ip, ip)
+			require.Equal(t, tc.port, port)
+			require.Equal(t, tc.addr, JoinAddr(ip, port))
+		})
+	}
+}
+
+func TestResolveAddr(t *testing.T) {
+	addr, err := ResolveAddr("[2001:DB8:0::1]:6000")
+	require.NoError(t, err)
+	require.Equal(t, "[2001:db8::1]:6000", addr)
+
+	addr, err = ResolveAddr("112.32.32.14:6000")
+	require.NoError(t, err)
+	require.Equal(t, "112.32.32.14:6000", addr)
+
+	_, err = ResolveAddr("2001:db8::1")
+	require.Error(t, err)
+}
+
+func TestIPAddrV2(t *testing.T) {
+	for _, addr := range []string{"112.32.32.14:6000", "[2001:db8::1]:6000"} {
+		ipa, err := NewIPAddrV2(addr)
+		require.NoError(t, err)
+		require.Equal(t, addr, ipa.String())
+	}
+
+	ipa, err := NewIPAddrV2("112.32.32.14:6000")
+	require.NoError(t, err)
+	require.Len(t, ipa.IP, 4)
+
+	_, err = NewIPAddrV2("example.com:6000")
+	require.Error(t, err)
+
+	// IPv6 peers are skipped by NewIPAddr
+	_, err = NewIPAddr("[2001:db8::1]:6000")
+	require.Error(t, err)
+}
+
+func TestGivePeersMessages(t *testing.T) {
+	peers := []*pex.Peer{
+		pex.NewPeer("112.32.32.14:6000"),
+		pex.NewPeer("[2001:db8::1]:6000"),
+	}
+
+	// the old message only carries IPv4 peers
+	m := NewGivePeersMessage(peers)
+	assert.Equal(t, []string{"112.32.32.14:6000"}, m.GetPeers())
+
+	m2 := NewGivePeersV2Message(peers)
+	var m2Decoded GivePeersV2Message
+	require.NoError(t, encoder.DeserializeRaw(encoder.Serialize(*m2), &m2Decoded))
+	assert.Equal(t, []string{"112.32.32.14:6000", "[2001:db8::1]:6000"}, m2Decoded.GetPeers())
+
+	// malformed ips are dropped
+	m2Decoded.Peers = append(m2Decoded.Peers, IPAddrV2{IP: []byte{1, 2, 3}, Port: 6000})
+	assert.Len(t, m2Decoded.GetPeers(), 2)
+}
+
 // import (
 // 	"errors"
 // 	"fmt"
@@ -38,6 +154,157 @@ package daemon
 // 	}
 // }
 
+func TestRegisterMessagesNetworkPrefix(t *testing.T) {
+	defer gnet.EraseMessages()
+
+	for _, prefix := range []string{"", "t", "r"} {
+		gnet.EraseMessages()
+		c := NewMessagesConfig()
+		c.NetworkPrefix = prefix
+		require.NotPanics(t, c.Register)
+
+		require.Len(t, gnet.MessageIDMap, len(c.Messages))
+		id := gnet.MessageIDMap[reflect.TypeOf(IntroductionMessage{})]
+		require.Equal(t, prefix+"INTR"[len(prefix):], string(id[:]))
+		id = gnet.MessageIDMap[reflect.TypeOf(GetBlocksMessage{})]
+		require.Equal(t, prefix+"GETB"[len(prefix):], string(id[:]))
+
+		// registering the same messages again is a no-op
+		require.NotPanics(t, c.Register)
+		require.Len(t, gnet.MessageIDMap, len(c.Messages))
+	}
+
+	// the messages can't be registered for another network
+	c := NewMessagesConfig()
+	c.NetworkPrefix = "x"
+	require.Panics(t, c.Register)
+}
+
+// sampleMessages returns a populated value of every registered message type
+func sampleMessages(t *testing.T) []interface{} {
+	txn := coin.Transaction{
+		In:   []cipher.SHA256{cipher.SumSHA256([]byte("in"))},
+		Out:  []coin.TransactionOutput{{Address: testutil.MakeAddress(), Coins: 1e6, Hours: 10}},
+		Sigs: []cipher.Sig{{1, 2, 3}},
+	}
+	txn.UpdateHeader()
+	hashes := []cipher.SHA256{txn.Hash(), cipher.SumSHA256([]byte("txn"))}
+
+	v2, err := NewIPAddrV2("[2001:db8::1]:6000")
+	require.NoError(t, err)
+
+	msgs := []interface{}{
+		NewIntroductionMessage(1234, 2, 6000),
+		NewFeaturesMessage(FeatureCompression | FeatureIPv6),
+		&GetPeersMessage{},
+		&GivePeersMessage{Peers: []IPAddr{{IP: 0x7f000001, Port: 6000}}},
+		&GivePeersV2Message{Peers: []IPAddrV2{v2}},
+		&PingMessage{},
+		&PongMessage{},
+		NewGetBlocksMessage(3, 20),
+		NewGiveBlocksMessage([]coin.SignedBlock{{
+			Block: coin.Block{Body: coin.BlockBody{Transactions: coin.Transactions{txn}}},
+		}}),
+		NewAnnounceBlocksMessage(3),
+		NewGetTxnsMessage(hashes),
+		NewGiveTxnsMessage(coin.Transactions{txn}),
+		NewAnnounceTxnsMessage(hashes),
+	}
+
+	// keep the samples in sync with the registered messages
+	types := make(map[reflect.Type]bool)
+	for _, m := range msgs {
+		types[reflect.TypeOf(m).Elem()] = true
+	}
+	for _, mc := range getMessageConfigs() {
+		require.True(t, types[reflect.TypeOf(mc.Message)], "no sample of %T", mc.Message)
+	}
+
+	return msgs
+}
+
+func decodeMessage(b []byte, m interface{}) error {
+	v := reflect.New(reflect.TypeOf(m).Elem())
+	_, err := encoder.DeserializeRawToValue(b, v)
+	return err
+}
+
+func TestMessagesDecodeMalformed(t *testing.T) {
+	for _, m := range sampleMessages(t) {
+		t.Run(reflect.TypeOf(m).Elem().Name(), func(t *testing.T) {
+			b := encoder.Serialize(m)
+			require.NoError(t, decodeMessage(b, m))
+
+			// every byte of a message is needed to decode it
+			for i := range b {
+				var err error
+				require.NotPanics(t, func() {
+					err = decodeMessage(b[:i], m)
+				})
+				require.Error(t, err, "truncated to %d bytes", i)
+			}
+
+			// corrupted bytes are either decoded or rejected
+			for i := range b {
+				for _, x := range []byte{0x00, 0x7f, 0xff} {
+					c := append([]byte{}, b...)
+					c[i] = x
+					require.NotPanics(t, func() {
+						decodeMessage(c, m) // nolint: errcheck
+					})
+				}
+			}
+		})
+	}
+}
+
+func TestIntroductionMessageLayout(t *testing.T) {
+	// older nodes decode the introduction into this layout and reject any
+	// other length, so it must not change
+	v2 := struct {
+		Mirror  uint32
+		Port    uint16
+		Version int32
+	}{1234, 6000, 2}
+
+	b := encoder.Serialize(NewIntroductionMessage(1234, 2, 6000))
+	require.Equal(t, encoder.Serialize(v2), b)
+
+	var intro IntroductionMessage
+	n, err := encoder.DeserializeRawToValue(b, reflect.ValueOf(&intro))
+	require.NoError(t, err)
+	require.Equal(t, len(b), n)
+}
+
+func TestMessagesMaxLen(t *testing.T) {
+	cases := []struct {
+		max int
+		msg func(n int) interface{}
+	}{
+		{maxGivePeers, func(n int) interface{} { return &GivePeersMessage{Peers: make([]IPAddr, n)} }},
+		{maxGivePeers, func(n int) interface{} {
+			peers := make([]IPAddrV2, n)
+			for i := range peers {
+				peers[i].IP = make([]byte, 4)
+			}
+			return &GivePeersV2Message{Peers: peers}
+		}},
+		{maxGiveBlocks, func(n int) interface{} { return NewGiveBlocksMessage(make([]coin.SignedBlock, n)) }},
+		{maxTxnHashes, func(n int) interface{} { return NewAnnounceTxnsMessage(make([]cipher.SHA256, n)) }},
+		{maxTxnHashes, func(n int) interface{} { return NewGetTxnsMessage(make([]cipher.SHA256, n)) }},
+		{maxTxnHashes, func(n int) interface{} { return NewGiveTxnsMessage(make(coin.Transactions, n)) }},
+	}
+
+	for _, tc := range cases {
+		m := tc.msg(tc.max)
+		t.Run(reflect.TypeOf(m).Elem().Name(), func(t *testing.T) {
+			require.NoError(t, decodeMessage(encoder.Serialize(m), m))
+			err := decodeMessage(encoder.Serialize(tc.msg(tc.max+1)), m)
+			require.Equal(t, encoder.ErrMaxLenExceeded, err)
+		})
+	}
+}
+
 // func TestRegisterMessages(t *testing.T) {
 // 	gnet.EraseMessages()
 // 	c := NewMessagesConfig()
@@ -0,0 +1,53 @@
+package daemon
+
+import (
+	"os"
+
+	"github.com/skycoin/skycoin/src/util/metrics"
+)
+
+var (
+	blockchainHeight = metrics.NewGauge("shellcoin_blockchain_height",
+		"Sequence number of the head block")
+	unconfirmedTxns = metrics.NewGauge("shellcoin_unconfirmed_txns",
+		"Number of transactions in the unconfirmed pool")
+	unconfirmedTxnsBytes = metrics.NewGauge("shellcoin_unconfirmed_txns_bytes",
+		"Size of the transactions in the unconfirmed pool")
+	peerConnections = metrics.NewGaugeVec("shellcoin_peer_connections",
+		"Number of peer connections by direction", "direction")
+	dbSizeBytes = metrics.NewGauge("shellcoin_db_size_bytes",
+		"Size of the blockchain database file")
+)
+
+// CollectMetrics updates the gauges that are computed when the metrics are
+// read, register it with metrics.OnCollect
+func (dm *Daemon) CollectMetrics() {
+	if !dm.Config.DisableNetworking {
+		outgoing := dm.outgoingConnections.Len()
+		peerConnections.With("outgoing").Set(float64(outgoing))
+		peerConnections.With("pending").Set(float64(dm.pendingConnections.Len()))
+		if n, err := dm.Pool.Pool.Size(); err == nil {
+			peerConnections.With("incoming").Set(float64(n - outgoing))
+		}
+	}
+
+	if dm.Visor.Config.Disabled {
+		return
+	}
+
+	dm.Visor.view(func() {
+		blockchainHeight.Set(float64(dm.Visor.v.HeadBkSeq()))
+
+		txns := dm.Visor.v.Unconfirmed.RawTxns()
+		size := 0
+		for i := range txns {
+			size += txns[i].Size()
+		}
+		unconfirmedTxns.Set(float64(len(txns)))
+		unconfirmedTxnsBytes.Set(float64(size))
+	})
+
+	if fi, err := os.Stat(dm.Visor.Config.Config.DBPath); err == nil {
+		dbSizeBytes.Set(float64(fi.Size()))
+	}
+}
@@ -0,0 +1,34 @@
+package daemon
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/util/metrics"
+)
+
+func TestCollectMetrics(t *testing.T) {
+	gw, cleanup := setupGateway(t)
+	defer cleanup()
+
+	d := gw.d
+	d.Config.DisableNetworking = true
+	d.Visor.Config.Disabled = false
+
+	_, _, addr := MakeAddress()
+	txn := createGenesisSpendTransaction(t, d.Visor.v.Blockchain, addr, GenesisCoins, 0, 0)
+	_, err := d.Visor.v.InjectTxn(txn)
+	require.NoError(t, err)
+
+	d.CollectMetrics()
+
+	var buf bytes.Buffer
+	require.NoError(t, metrics.DefaultRegistry.WriteText(&buf))
+	out := buf.String()
+	require.Contains(t, out, "\nshellcoin_blockchain_height 0\n")
+	require.Contains(t, out, "\nshellcoin_unconfirmed_txns 1\n")
+	require.Contains(t, out, "\nshellcoin_unconfirmed_txns_bytes "+strconv.Itoa(txn.Size())+"\n")
+}
@@ -0,0 +1,193 @@
+package daemon
+
+import (
+	"time"
+
+	"os"
+	"path/filepath"
+
+	"github.com/skycoin/skycoin/src/daemon/pex"
+)
+
+// PeersConfig config for peers
+type PeersConfig struct {
+	// Folder where peers database should be saved
+	DataDirectory string
+	// Maximum number of peers to keep account of in the PeerList
+	Max int
+	// Cull peers after they havent been seen in this much time
+	Expiration time.Duration
+	// Cull expired peers on this interval
+	CullRate time.Duration
+	// How often to clear expired blacklist entries
+	UpdateBlacklistRate time.Duration
+	// How often to request peers via PEX
+	RequestRate time.Duration
+	// How many peers to send back in response to a peers request
+	ReplyCount int
+	// Localhost peers are allowed in the peerlist
+	AllowLocalhost bool
+	// Disable exchanging of peers.  Peers are still loaded from disk
+	Disabled bool
+	// Names of the enabled discovery sources, see pex.AllSources
+	Sources []string
+	// Seed-list file of newline delimited addresses. Relative to DataDirectory
+	// if not absolute
+	SeedFile string
+	// DNS seeds, either host names or host:port pairs
+	DNSSeeds []string
+	// Port of the peers resolved from DNS seeds which don't specify one
+	DNSSeedPort int
+	// Resolver for DNS seeds. The system resolver is used if nil
+	Resolver pex.Resolver
+}
+
+// NewPeersConfig creates peers config
+func NewPeersConfig() PeersConfig {
+	return PeersConfig{
+		DataDirectory:       "./",
+		Max:                 1000,
+		Expiration:          time.Hour * 24 * 7,
+		CullRate:            time.Minute * 10,
+		UpdateBlacklistRate: time.Minute,
+		RequestRate:         time.Minute,
+		ReplyCount:          30,
+		AllowLocalhost:      false,
+		Disabled:            false,
+		Sources:             pex.AllSources,
+		SeedFile:            "seeds.txt",
+		DNSSeeds:            []string{},
+		DNSSeedPort:         6677,
+	}
+}
+
+// Peers maintains the config and peers instance
+type Peers struct {
+	Config PeersConfig
+	// Peer list
+	Peers *pex.Pex
+	// Peers received via GivePeersMessage, nil if the exchange source is disabled
+	exchange *pex.ExchangeSource
+}
+
+// NewPeers creates peers
+func NewPeers(c PeersConfig) (*Peers, error) {
+	if c.Disabled {
+		logger.Info("PEX is disabled")
+	}
+
+	ps := &Peers{
+		Config: c,
+	}
+
+	// the peer database stays open until Shutdown
+	peers := pex.NewPex(ps.Config.Max)
+	if err := peers.Open(ps.Config.DataDirectory); err != nil {
+		logger.Notice("Failed to load peer database")
+		logger.Notice("Reason: %v", err)
+		return nil, err
+	}
+	logger.Debug("Init peers")
+	peers.AllowLocalhost = ps.Config.AllowLocalhost
+
+	ps.Peers = peers
+	ps.bootstrap()
+
+	if err := ps.Peers.Save(); err != nil {
+		ps.Peers.Close()
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+// bootstrap adds the peers of the enabled discovery sources
+func (ps *Peers) bootstrap() {
+	for _, name := range ps.Config.Sources {
+		switch name {
+		case pex.SourceStatic:
+			ps.Peers.Discover(pex.NewStaticSource(DefaultConnections))
+			// default peers will mark as trusted peers.
+			for _, addr := range DefaultConnections {
+				if err := ps.Peers.SetTrustState(addr, true); err != nil {
+					logger.Warning("Set default peer %s trusted failed: %v", addr, err)
+				}
+			}
+		case pex.SourceFile:
+			fn := ps.Config.SeedFile
+			if fn == "" {
+				continue
+			}
+			if !filepath.IsAbs(fn) {
+				fn = filepath.Join(ps.Config.DataDirectory, fn)
+			}
+			if _, err := os.Stat(fn); os.IsNotExist(err) {
+				logger.Debug("Seed file %s does not exist", fn)
+				continue
+			}
+			ps.Peers.Discover(pex.NewFileSource(fn))
+		case pex.SourceDNS:
+			if len(ps.Config.DNSSeeds) == 0 {
+				continue
+			}
+			ps.Peers.Discover(pex.NewDNSSource(ps.Config.DNSSeeds, ps.Config.DNSSeedPort, ps.Config.Resolver))
+		case pex.SourceExchange:
+			ps.exchange = pex.NewExchangeSource()
+		default:
+			logger.Error("Unknown peer discovery source %q", name)
+		}
+	}
+}
+
+// addExchangedPeers adds the peers received via GivePeersMessage
+func (ps *Peers) addExchangedPeers(addrs []string) {
+	if ps.exchange == nil {
+		return
+	}
+	ps.exchange.Add(addrs)
+	ps.Peers.Discover(ps.exchange)
+}
+
+// DefaultConnections do "default_peers file"
+// read file, write, if does not exist
+var DefaultConnections = []string{}
+
+// Shutdown the PeerList
+func (ps *Peers) Shutdown() error {
+	if ps.Peers == nil {
+		return nil
+	}
+
+	err := ps.Peers.Save()
+	if err != nil {
+		logger.Warning("Failed to save peer database")
+		logger.Warning("Reason: %v", err)
+	} else {
+		logger.Info("Peers saved")
+	}
+
+	if cerr := ps.Peers.Close(); cerr != nil {
+		logger.Warning("Failed to close peer database: %v", cerr)
+		if err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// RemovePeer removes a peer, if not private
+func (ps *Peers) RemovePeer(a string) {
+	ps.Peers.RemovePeer(a)
+}
+
+// Requests peers from our connections
+func (ps *Peers) requestPeers(pool *Pool) {
+	if ps.Config.Disabled {
+		return
+	}
+	if ps.Peers.Full() {
+		return
+	}
+	m := NewGetPeersMessage()
+	pool.Pool.BroadcastMessage(m)
+}
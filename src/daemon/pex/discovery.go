@@ -0,0 +1,216 @@
+package pex
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Names of the discovery sources, recorded in Peer.Source
+const (
+	// SourceStatic peers from a list compiled into the client or given on the command line
+	SourceStatic = "static"
+	// SourceFile peers read from a seed-list file
+	SourceFile = "file"
+	// SourceDNS peers resolved from DNS seeds
+	SourceDNS = "dns"
+	// SourceExchange peers learned from other peers via GivePeersMessage
+	SourceExchange = "exchange"
+)
+
+// AllSources lists the names of all discovery sources
+var AllSources = []string{SourceStatic, SourceFile, SourceDNS, SourceExchange}
+
+// Source is a source of peer addresses used to discover peers
+type Source interface {
+	// Name returns the name of the source, which is recorded in Peer.Source
+	Name() string
+	// Peers returns the addresses of the form ip:port known by the source.
+	// The addresses are not validated, Pex.Discover does that.
+	Peers() ([]string, error)
+}
+
+// Discover adds the peers of the sources to the peer list.  Each address is
+// checked with ValidateAddress, invalid addresses and failing sources are
+// logged and skipped.  Returns the number of peers that were added.
+func (px *Pex) Discover(sources ...Source) int {
+	n := 0
+	for _, s := range sources {
+		addrs, err := s.Peers()
+		if err != nil {
+			logger.Warning("Discover peers from %s failed: %v", s.Name(), err)
+			continue
+		}
+
+		valid := make([]string, 0, len(addrs))
+		for _, a := range addrs {
+			a = strings.TrimSpace(a)
+			if !ValidateAddress(a, px.AllowLocalhost) {
+				logger.Warning("Discarding invalid address %q from %s", a, s.Name())
+				continue
+			}
+			valid = append(valid, a)
+		}
+
+		if len(valid) != 0 {
+			logger.Debug("Discovered %d peers from %s", len(valid), s.Name())
+		}
+		n += px.AddPeersFromSource(valid, s.Name())
+	}
+	return n
+}
+
+// StaticSource is a fixed list of peers
+type StaticSource struct {
+	addrs []string
+}
+
+// NewStaticSource creates StaticSource
+func NewStaticSource(addrs []string) *StaticSource {
+	return &StaticSource{addrs: addrs}
+}
+
+// Name implements Source
+func (ss *StaticSource) Name() string {
+	return SourceStatic
+}
+
+// Peers implements Source
+func (ss *StaticSource) Peers() ([]string, error) {
+	return ss.addrs, nil
+}
+
+// FileSource reads peers from a newline delimited file of addresses.
+// Empty lines and lines starting with # are ignored.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource creates FileSource
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// Name implements Source
+func (fs *FileSource) Name() string {
+	return SourceFile
+}
+
+// Peers implements Source
+func (fs *FileSource) Peers() ([]string, error) {
+	lines, err := readLines(fs.path)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(lines))
+	for _, l := range lines {
+		l = strings.TrimSpace(l)
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		addrs = append(addrs, l)
+	}
+	return addrs, nil
+}
+
+// Resolver looks up the ip addresses of a host
+type Resolver interface {
+	LookupHost(host string) ([]string, error)
+}
+
+type netResolver struct{}
+
+func (netResolver) LookupHost(host string) ([]string, error) {
+	return net.LookupHost(host)
+}
+
+// DNSSource resolves DNS seeds to peers.  A seed is either a host name, whose
+// addresses are combined with the default port, or a host:port pair.
+type DNSSource struct {
+	seeds    []string
+	port     int
+	resolver Resolver
+}
+
+// NewDNSSource creates DNSSource, the system resolver is used if resolver is nil
+func NewDNSSource(seeds []string, port int, resolver Resolver) *DNSSource {
+	if resolver == nil {
+		resolver = netResolver{}
+	}
+	return &DNSSource{
+		seeds:    seeds,
+		port:     port,
+		resolver: resolver,
+	}
+}
+
+// Name implements Source
+func (ds *DNSSource) Name() string {
+	return SourceDNS
+}
+
+// Peers implements Source. Seeds that fail to resolve are logged and skipped,
+// an error is returned only if none of them resolve.
+func (ds *DNSSource) Peers() ([]string, error) {
+	var addrs []string
+	var lastErr error
+	resolved := 0
+	for _, seed := range ds.seeds {
+		host, port := seed, strconv.Itoa(ds.port)
+		if h, p, err := net.SplitHostPort(seed); err == nil {
+			host, port = h, p
+		}
+
+		ips, err := ds.resolver.LookupHost(host)
+		if err != nil {
+			logger.Warning("Resolve DNS seed %s failed: %v", seed, err)
+			lastErr = err
+			continue
+		}
+		resolved++
+
+		for _, ip := range ips {
+			addrs = append(addrs, net.JoinHostPort(ip, port))
+		}
+	}
+
+	if resolved == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	return addrs, nil
+}
+
+// ExchangeSource collects the peers received in GivePeersMessages until
+// they are consumed by Pex.Discover
+type ExchangeSource struct {
+	addrs []string
+	lk    sync.Mutex
+}
+
+// NewExchangeSource creates ExchangeSource
+func NewExchangeSource() *ExchangeSource {
+	return &ExchangeSource{}
+}
+
+// Name implements Source
+func (es *ExchangeSource) Name() string {
+	return SourceExchange
+}
+
+// Add queues peers received from another peer
+func (es *ExchangeSource) Add(addrs []string) {
+	es.lk.Lock()
+	defer es.lk.Unlock()
+	es.addrs = append(es.addrs, addrs...)
+}
+
+// Peers implements Source, the queued peers are returned only once
+func (es *ExchangeSource) Peers() ([]string, error) {
+	es.lk.Lock()
+	defer es.lk.Unlock()
+	addrs := es.addrs
+	es.addrs = nil
+	return addrs, nil
+}
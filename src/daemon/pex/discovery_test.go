@@ -0,0 +1,129 @@
+package pex
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubResolver map[string][]string
+
+func (sr stubResolver) LookupHost(host string) ([]string, error) {
+	ips, ok := sr[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return ips, nil
+}
+
+func sortedAddrs(px *Pex) []string {
+	addrs := px.GetAllAddresses()
+	sort.Strings(addrs)
+	return addrs
+}
+
+func TestDiscoverStatic(t *testing.T) {
+	px := NewPex(10)
+	n := px.Discover(NewStaticSource([]string{address, " 111.32.32.13:2020 ", "127.0.0.1:6000", "bad"}))
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"111.32.32.13:2020", address}, sortedAddrs(px))
+
+	p, ok := px.GetPeerByAddr(address)
+	require.True(t, ok)
+	assert.Equal(t, SourceStatic, p.Source)
+
+	// localhost is accepted if allowed
+	px.AllowLocalhost = true
+	require.Equal(t, 1, px.Discover(NewStaticSource([]string{"127.0.0.1:6000"})))
+}
+
+func TestDiscoverKeepsFirstSource(t *testing.T) {
+	px := NewPex(10)
+	px.Discover(NewStaticSource([]string{address}))
+	es := NewExchangeSource()
+	es.Add([]string{address})
+	px.Discover(es)
+
+	p, ok := px.GetPeerByAddr(address)
+	require.True(t, ok)
+	assert.Equal(t, SourceStatic, p.Source)
+}
+
+func TestDiscoverFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pex")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fn := filepath.Join(dir, "seeds.txt")
+	data := "# seed peers\n" + address + "\n\n  69.32.54.111:2222\n0.0.0.0:6000\n"
+	require.NoError(t, ioutil.WriteFile(fn, []byte(data), 0600))
+
+	px := NewPex(10)
+	require.Equal(t, 2, px.Discover(NewFileSource(fn)))
+	require.Equal(t, []string{address, "69.32.54.111:2222"}, sortedAddrs(px))
+
+	p, ok := px.GetPeerByAddr("69.32.54.111:2222")
+	require.True(t, ok)
+	assert.Equal(t, SourceFile, p.Source)
+
+	// missing file adds nothing
+	px = NewPex(10)
+	require.Equal(t, 0, px.Discover(NewFileSource(filepath.Join(dir, "missing.txt"))))
+	require.Empty(t, px.GetAllAddresses())
+}
+
+func TestDNSSource(t *testing.T) {
+	r := stubResolver{
+		"seed1.example.com": {"112.32.32.14", "2001:4860:4860::8888"},
+		"seed2.example.com": {"69.32.54.111", "224.1.1.1"},
+	}
+
+	ds := NewDNSSource([]string{"seed1.example.com", "seed2.example.com:2222", "missing.example.com"}, 3030, r)
+	addrs, err := ds.Peers()
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"112.32.32.14:3030",
+		"[2001:4860:4860::8888]:3030",
+		"69.32.54.111:2222",
+		"224.1.1.1:2222",
+	}, addrs)
+
+	// multicast addresses are rejected by ValidateAddress
+	px := NewPex(10)
+	require.Equal(t, 3, px.Discover(ds))
+	p, ok := px.GetPeerByAddr("69.32.54.111:2222")
+	require.True(t, ok)
+	assert.Equal(t, SourceDNS, p.Source)
+	p, ok = px.GetPeerByAddr("[2001:4860:4860::8888]:3030")
+	require.True(t, ok)
+	assert.Equal(t, SourceDNS, p.Source)
+
+	// all seeds failing is an error
+	ds = NewDNSSource([]string{"missing.example.com"}, 3030, r)
+	_, err = ds.Peers()
+	require.Error(t, err)
+	require.Equal(t, 0, px.Discover(ds))
+}
+
+func TestExchangeSource(t *testing.T) {
+	es := NewExchangeSource()
+	es.Add([]string{address})
+	es.Add([]string{address2})
+
+	px := NewPex(10)
+	require.Equal(t, 2, px.Discover(es))
+	p, ok := px.GetPeerByAddr(address2)
+	require.True(t, ok)
+	assert.Equal(t, SourceExchange, p.Source)
+
+	// the queued peers are consumed
+	addrs, err := es.Peers()
+	require.NoError(t, err)
+	require.Empty(t, addrs)
+}
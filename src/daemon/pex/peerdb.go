@@ -0,0 +1,188 @@
+package pex
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/skycoin/skycoin/src/util/file"
+)
+
+// peersBucket is the bolt bucket holding the peers, keyed by address, with
+// JSON encoded Peer values
+var peersBucket = []byte("peers")
+
+// ErrPeerDBNotOpen is returned by Save before Open or after Close
+var ErrPeerDBNotOpen = errors.New("Peer database is not open")
+
+// openPeerDB opens the peer database in dir, creating it if it doesn't exist
+func openPeerDB(dir string) (*bolt.DB, error) {
+	db, err := bolt.Open(filepath.Join(dir, PeerDatabaseFilename), 0600, &bolt.Options{
+		Timeout: 500 * time.Millisecond,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Open peer database failed: %v", err)
+	}
+	return db, nil
+}
+
+// Open opens the peer database "<dir>/<PeerDatabaseFilename>", creating it if
+// it doesn't exist, and loads its peers.  The database stays open for Save
+// until Close.  If the database doesn't exist, the peers are imported from
+// the JSON file "<dir>/<LegacyPeerDatabaseFilename>" written by older
+// versions, if there is one.
+func (px *Pex) Open(dir string) error {
+	if px.db != nil {
+		return errors.New("Peer database is already open")
+	}
+
+	_, err := os.Stat(filepath.Join(dir, PeerDatabaseFilename))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	exists := err == nil
+
+	db, err := openPeerDB(dir)
+	if err != nil {
+		return err
+	}
+
+	var pl *Peerlist
+	if exists {
+		pl, err = loadPeerlist(db)
+	} else {
+		pl, err = loadLegacyPeerlist(dir)
+	}
+	if err != nil {
+		db.Close()
+		return err
+	}
+
+	px.Peerlist = pl
+	px.db = db
+	return nil
+}
+
+// Close closes the peer database opened by Open
+func (px *Pex) Close() error {
+	if px.db == nil {
+		return nil
+	}
+	err := px.db.Close()
+	px.db = nil
+	return err
+}
+
+// Save writes the known peers to the peer database in one transaction.  The
+// peers that changed are updated, and the peers that were removed or failed
+// more than 10 consecutive connection attempts are deleted.
+func (px *Pex) Save() error {
+	if px.db == nil {
+		return ErrPeerDBNotOpen
+	}
+
+	var err error
+	px.strand(func() {
+		err = px.db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists(peersBucket)
+			if err != nil {
+				return err
+			}
+
+			// the keys can't be deleted while the cursor iterates
+			var stale [][]byte
+			if err := b.ForEach(func(k, v []byte) error {
+				if p, ok := px.peers[string(k)]; !ok || p.RetryTimes > 10 {
+					stale = append(stale, k)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			for _, k := range stale {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+			}
+
+			for addr, p := range px.peers {
+				if p.RetryTimes > 10 {
+					continue
+				}
+
+				v, err := json.Marshal(p)
+				if err != nil {
+					return err
+				}
+				if bytes.Equal(b.Get([]byte(addr)), v) {
+					continue
+				}
+
+				if err := b.Put([]byte(addr), v); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}, "Save")
+
+	if err != nil {
+		logger.Notice("SavePeerList Failed: %v", err)
+	}
+	return err
+}
+
+// loadPeerlist loads the peers from the peer database
+func loadPeerlist(db *bolt.DB) (*Peerlist, error) {
+	peerlist := Peerlist{peers: make(map[string]*Peer)}
+	if err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(peersBucket)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			var p Peer
+			if err := json.Unmarshal(v, &p); err != nil {
+				logger.Warning("Skipping malformed peer %s: %v", k, err)
+				return nil
+			}
+			peerlist.peers[string(k)] = &p
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return &peerlist, nil
+}
+
+// loadLegacyPeerlist imports the peers from the JSON file written by older
+// versions.  The peerlist is empty if that file doesn't exist.
+func loadLegacyPeerlist(dir string) (*Peerlist, error) {
+	peerlist := Peerlist{peers: make(map[string]*Peer)}
+
+	fn := filepath.Join(dir, LegacyPeerDatabaseFilename)
+	if _, err := os.Stat(fn); os.IsNotExist(err) {
+		return &peerlist, nil
+	}
+
+	if err := file.LoadJSON(fn, &peerlist.peers); err != nil {
+		return nil, err
+	}
+
+	for _, p := range peerlist.peers {
+		if p.FirstSeen.IsZero() {
+			p.FirstSeen = p.LastSeen
+		}
+	}
+
+	logger.Info("Imported %d peers from %s", len(peerlist.peers), fn)
+	return &peerlist, nil
+}
@@ -0,0 +1,187 @@
+package pex
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/util/file"
+)
+
+// openPex opens the peer database in dir with a new Pex
+func openPex(t *testing.T, dir string) *Pex {
+	px := NewPex(10)
+	require.NoError(t, px.Open(dir))
+	return px
+}
+
+func TestPeerlistSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	px := NewPex(10)
+	require.Equal(t, ErrPeerDBNotOpen, px.Save())
+	require.NoError(t, px.Open(dir))
+	require.Error(t, px.Open(dir))
+	require.Empty(t, px.peers)
+
+	_, err = px.AddPeerFromSource(address, SourceStatic)
+	require.NoError(t, err)
+	_, err = px.AddPeer(address2)
+	require.NoError(t, err)
+	_, err = px.AddPeer("111.32.32.13:2020")
+	require.NoError(t, err)
+	_, err = px.AddPeer("111.32.32.14:2020")
+	require.NoError(t, err)
+	require.NoError(t, px.Save())
+
+	px.SetConnected(address, 3)
+	px.SetLatency(address, 150*time.Millisecond)
+	px.SetConnectFailed(address2)
+	px.SetLegacy(address2, true)
+	// peers failing too often are not saved
+	for i := 0; i < 11; i++ {
+		px.IncreaseRetryTimes("111.32.32.13:2020")
+	}
+	// removed peers are deleted
+	px.RemovePeer("111.32.32.14:2020")
+
+	// saving again updates the peers in the open database
+	require.NoError(t, px.Save())
+	require.NoError(t, px.Close())
+	require.Equal(t, ErrPeerDBNotOpen, px.Save())
+
+	px = openPex(t, dir)
+	defer px.Close()
+	require.Len(t, px.peers, 2)
+
+	p := px.peers[address]
+	require.NotNil(t, p)
+	assert.Equal(t, SourceStatic, p.Source)
+	assert.Equal(t, 1, p.ConnectSuccesses)
+	assert.Equal(t, 0, p.ConnectFailures)
+	assert.Equal(t, int32(3), p.Version)
+	assert.Equal(t, 150*time.Millisecond, p.Latency)
+	assert.False(t, p.LastConnected.IsZero())
+	assert.False(t, p.FirstSeen.IsZero())
+
+	p = px.peers[address2]
+	require.NotNil(t, p)
+	assert.Equal(t, 0, p.ConnectSuccesses)
+	assert.Equal(t, 1, p.ConnectFailures)
+	assert.True(t, p.LastConnected.IsZero())
+	assert.True(t, p.Legacy)
+	// retry times are not persisted
+	assert.Equal(t, 0, p.RetryTimes)
+}
+
+func TestLoadLegacyPeerlist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	seen := Now().Add(-time.Hour)
+	legacy := map[string]*Peer{
+		address: {Addr: address, LastSeen: seen, Trusted: true},
+	}
+	require.NoError(t, file.SaveJSON(filepath.Join(dir, LegacyPeerDatabaseFilename), legacy, 0600))
+
+	px := openPex(t, dir)
+	require.Len(t, px.peers, 1)
+	p := px.peers[address]
+	assert.True(t, p.Trusted)
+	assert.True(t, p.FirstSeen.Equal(seen))
+
+	// once saved, the database takes precedence over the legacy file
+	_, err = px.AddPeer(address2)
+	require.NoError(t, err)
+	require.NoError(t, px.Save())
+	require.NoError(t, px.Close())
+
+	px = openPex(t, dir)
+	defer px.Close()
+	require.Len(t, px.peers, 2)
+}
+
+func TestPeerScore(t *testing.T) {
+	untried := Peer{}
+	good := Peer{ConnectSuccesses: 10, Latency: 50 * time.Millisecond}
+	slow := Peer{ConnectSuccesses: 10, Latency: 3 * time.Second}
+	bad := Peer{ConnectFailures: 5}
+
+	assert.Equal(t, 0.5, untried.Score())
+	assert.True(t, good.Score() > untried.Score())
+	assert.True(t, good.Score() > slow.Score())
+	assert.True(t, untried.Score() > bad.Score())
+	assert.True(t, bad.Score() > 0)
+}
+
+func TestSubnet(t *testing.T) {
+	assert.Equal(t, "112.32.0.0/16", Subnet("112.32.32.14:6000"))
+	assert.Equal(t, Subnet("112.32.1.1:6000"), Subnet("112.32.32.14:7000"))
+	assert.NotEqual(t, Subnet("112.33.1.1:6000"), Subnet("112.32.32.14:6000"))
+	assert.Equal(t, "2001:db8::/32", Subnet("[2001:db8:1::1]:6000"))
+	assert.Equal(t, "bad", Subnet("bad"))
+}
+
+func TestSelectPublic(t *testing.T) {
+	px := NewPex(0)
+	addrs := []string{
+		"112.32.32.14:6000",
+		"112.32.32.15:6000",
+		"112.32.99.1:6000",
+		"69.32.54.111:6000",
+		"111.32.32.13:6000",
+	}
+	require.Equal(t, len(addrs), px.AddPeers(addrs))
+	_, err := px.AddPeer("120.1.1.1:6000")
+	require.NoError(t, err)
+	require.NoError(t, px.SetPrivate("120.1.1.1:6000", true))
+
+	// one peer per subnet, private peers excluded
+	peers := px.SelectPublic(0, nil)
+	require.Len(t, peers, 3)
+	subnets := make(map[string]bool)
+	for _, p := range peers {
+		assert.False(t, p.Private)
+		sn := Subnet(p.Addr)
+		assert.False(t, subnets[sn])
+		subnets[sn] = true
+	}
+
+	require.Len(t, px.SelectPublic(2, nil), 2)
+
+	peers = px.SelectPublic(0, map[string]bool{Subnet(addrs[0]): true})
+	require.Len(t, peers, 2)
+	for _, p := range peers {
+		assert.NotEqual(t, Subnet(addrs[0]), Subnet(p.Addr))
+	}
+}
+
+func TestSelectPublicFavorsGoodPeers(t *testing.T) {
+	px := NewPex(0)
+	good := "112.32.32.14:6000"
+	bad := "69.32.54.111:6000"
+	require.Equal(t, 2, px.AddPeers([]string{good, bad}))
+
+	for i := 0; i < 20; i++ {
+		px.SetConnected(good, 3)
+		px.peers[bad].ConnectFailures++
+	}
+
+	first := 0
+	for i := 0; i < 200; i++ {
+		peers := px.SelectPublic(1, nil)
+		require.Len(t, peers, 1)
+		if peers[0].Addr == good {
+			first++
+		}
+	}
+	assert.True(t, first > 150, "good peer selected first %d times", first)
+}
@@ -0,0 +1,722 @@
+// Package pex is a toolkit for implementing a peer exchange system
+package pex
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"math"
+
+	"sync"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/utc"
+)
+
+//TODO:
+// - peer "seen" means something else than use here
+// - only transmit peers that have active or recent connections
+
+var (
+	// PeerDatabaseFilename filename for disk-cached peers
+	PeerDatabaseFilename = "peers.db"
+	// LegacyPeerDatabaseFilename filename of the JSON peer list written by
+	// older versions, it is imported if PeerDatabaseFilename does not exist
+	LegacyPeerDatabaseFilename = "peers.txt"
+	// BlacklistedDatabaseFilename  filename for disk-cached blacklisted peers
+	BlacklistedDatabaseFilename = "blacklisted_peers.txt"
+	// ErrPeerlistFull returned when the Pex is at a maximum
+	ErrPeerlistFull = errors.New("Peer list full")
+	// ErrInvalidAddress Returned when an address appears malformed
+	ErrInvalidAddress = errors.New("Invalid address")
+	// ErrBlacklistedAddress returned when attempting to add a blacklisted peer
+	ErrBlacklistedAddress = errors.New("Blacklisted address")
+	// RefreshBlacklistRate How often to updated expired entries in the blacklist
+	RefreshBlacklistRate = time.Second * 30
+	// Logging. See http://godoc.org/github.com/op/go-logging for
+	// instructions on how to include this log's output
+	logger = logging.MustGetLogger("pex")
+	// Default rng
+	rnum = rand.New(rand.NewSource(time.Now().Unix()))
+	// For removing inadvertent whitespace from addresses
+	whitespaceFilter = regexp.MustCompile("\\s")
+)
+
+// ValidateAddress returns true if ipPort is a valid ip:port string.
+// IPv6 addresses must be enclosed in brackets, e.g. [2001:db8::1]:6000
+func ValidateAddress(ipPort string, allowLocalhost bool) bool {
+	ipPort = whitespaceFilter.ReplaceAllString(ipPort, "")
+	host, portStr, err := net.SplitHostPort(ipPort)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	} else if ip.IsLoopback() {
+		if !allowLocalhost {
+			return false
+		}
+	} else if !ip.IsGlobalUnicast() {
+		return false
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port < 1024 {
+		return false
+	}
+	return true
+}
+
+// Peer represents a known peer
+type Peer struct {
+	Addr             string        // An address of the form ip:port
+	FirstSeen        time.Time     // Unix timestamp when this peer was added
+	LastSeen         time.Time     // Unix timestamp when this peer was last seen
+	LastConnected    time.Time     // Unix timestamp of the last successful connection, zero if never
+	ConnectSuccesses int           // Number of successful connections
+	ConnectFailures  int           // Number of failed connection attempts
+	Latency          time.Duration // TCP connect time of the last successful connection
+	Version          int32         // Protocol version advertised by this peer
+	Legacy           bool          // Whether this peer closed the connection when sent a FeaturesMessage
+	Private          bool          // Whether it should omitted from public requests
+	Trusted          bool          // Whether this peer is trusted
+	HasIncomePort    bool          // Whether this peer has incomming port
+	Source           string        // Name of the discovery source the peer was learned from
+	RetryTimes       int           `json:"-"` // records the retry times
+}
+
+// NewPeer returns a *Peer initialised by an address string of the form ip:port
+func NewPeer(address string) *Peer {
+	p := &Peer{Addr: address, Private: false, Trusted: false}
+	p.Seen()
+	p.FirstSeen = p.LastSeen
+	return p
+}
+
+// Seen marks the peer as seen
+func (peer *Peer) Seen() {
+	peer.LastSeen = Now()
+}
+
+// IncreaseRetryTimes adds the retry times
+func (peer *Peer) IncreaseRetryTimes() {
+	peer.RetryTimes++
+	logger.Debug("Increase retry times of %v to %v", peer.Addr, peer.RetryTimes)
+}
+
+// ResetRetryTimes resets the retry time
+func (peer *Peer) ResetRetryTimes() {
+	peer.RetryTimes = 0
+	logger.Debug("Reset retry times of %v", peer.Addr)
+}
+
+// CanTry returns whether this peer is tryable base on the exponential backoff algorithm
+func (peer *Peer) CanTry() (rlt bool) {
+	now := Now()
+	mod := (math.Exp2(float64(peer.RetryTimes)) - 1) * 5
+	if mod == 0 {
+		rlt = true
+		return
+	}
+
+	t := rnum.Int63n(int64(mod))
+	timePass := now.Sub(peer.LastSeen).Seconds()
+	rlt = int64(timePass) > t
+	return
+}
+
+// String returns the peer address
+func (peer *Peer) String() string {
+	return peer.Addr
+}
+
+// Score rates the peer for outgoing connections, in (0, 1].  Peers with
+// a good connection record and a low latency score higher.  Peers that were
+// never tried score 0.5.
+func (peer *Peer) Score() float64 {
+	// success ratio, starting from one success and one failure
+	score := float64(peer.ConnectSuccesses+1) / float64(peer.ConnectSuccesses+peer.ConnectFailures+2)
+	if peer.Latency > 0 {
+		score /= 1 + peer.Latency.Seconds()
+	}
+	return score
+}
+
+// Subnet returns the network of an ip:port address, used to spread the
+// outgoing connections over different networks: the /16 for IPv4 and the /32
+// for IPv6 addresses.  The address is returned if it has no valid ip.
+func Subnet(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return addr
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(16, 32)).String() + "/16"
+	}
+	return ip.Mask(net.CIDRMask(32, 128)).String() + "/32"
+}
+
+// Peerlist is a map of addresses to *PeerStates
+type Peerlist struct {
+	lock  sync.Mutex
+	peers map[string]*Peer
+}
+
+func (pl *Peerlist) strand(f func(), arg ...interface{}) {
+	pl.lock.Lock()
+	defer pl.lock.Unlock()
+	// logger.Critical("%v", arg)
+	f()
+}
+
+// GetPublicTrustPeers returns all trusted public peers
+func (pl *Peerlist) GetPublicTrustPeers() []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		keys := pl.getTrustAddresses(false)
+		peers = make([]*Peer, len(keys))
+		for i, key := range keys {
+			peers[i] = pl.peers[key]
+		}
+	}, "GetPublickTrustPeers")
+	return peers
+}
+
+// GetPrivateTrustPeers returns all trusted private peers
+func (pl *Peerlist) GetPrivateTrustPeers() []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		keys := pl.getTrustAddresses(true)
+		peers = make([]*Peer, len(keys))
+		for i, key := range keys {
+			peers[i] = pl.peers[key]
+		}
+	}, "GetPrivateTrustPeers")
+	return peers
+}
+
+// GetAllTrustedPeers returns all trusted peers, including private and public peers.
+func (pl *Peerlist) GetAllTrustedPeers() []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		keys := pl.getAllTrustPeers()
+		peers = make([]*Peer, len(keys))
+		for i, key := range keys {
+			peers[i] = pl.peers[key]
+		}
+	}, "GetAllTrustedPeers")
+	return peers
+}
+
+func (pl *Peerlist) getTrustAddresses(private bool) []string {
+	keys := []string{}
+	for key, p := range pl.peers {
+		if p.Trusted {
+			if p.CanTry() {
+				if private && p.Private {
+					keys = append(keys, key)
+				} else if !private && !p.Private {
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	return keys
+}
+
+func (pl *Peerlist) getAllTrustPeers() []string {
+	return append(pl.getTrustAddresses(false), pl.getTrustAddresses(true)...)
+}
+
+// GetPublicAddresses returns the string addresses of all public peers
+func (pl *Peerlist) GetPublicAddresses() []string {
+	var addrs []string
+	pl.strand(func() {
+		addrs = pl.getAddresses(false)
+	}, "GetPublicAddresses")
+	return addrs
+}
+
+// GetPrivateAddresses returns the string addresses of all private peers
+func (pl *Peerlist) GetPrivateAddresses() []string {
+	var addrs []string
+	pl.strand(func() {
+		addrs = pl.getAddresses(true)
+	}, "GetPrivateAddresses")
+	return addrs
+}
+
+// RemovePeer removes peer
+func (pl *Peerlist) RemovePeer(a string) {
+	pl.strand(func() {
+		delete(pl.peers, a)
+	}, "RemovePeer")
+}
+
+// GetAllAddresses returns the string addresses of all peers, public or private
+func (pl *Peerlist) GetAllAddresses() []string {
+	var addrs []string
+	pl.strand(func() {
+		addrs = append(pl.getAddresses(false), pl.getAddresses(true)...)
+	}, "GetAllAddreses")
+	return addrs
+}
+
+// GetPeerByAddr returns peer of given address
+func (pl *Peerlist) GetPeerByAddr(a string) (Peer, bool) {
+	var peer Peer
+	var exist bool
+	pl.strand(func() {
+		if p, ok := pl.peers[a]; ok {
+			peer = *p
+			exist = true
+			return
+		}
+	}, "GetPeerByAddr")
+	return peer, exist
+}
+
+// ClearOld removes public peers that haven't been seen in timeAgo seconds
+func (pl *Peerlist) ClearOld(timeAgo time.Duration) {
+	t := Now()
+	pl.strand(func() {
+		for addr, peer := range pl.peers {
+			if !peer.Private && t.Sub(peer.LastSeen) > timeAgo {
+				delete(pl.peers, addr)
+			}
+		}
+	}, "ClearOld")
+}
+
+// Returns the string addresses of all public peers
+func (pl *Peerlist) getAddresses(private bool) []string {
+	keys := make([]string, 0, len(pl.peers))
+	for key, p := range pl.peers {
+		if p.CanTry() {
+			if private && p.Private {
+				keys = append(keys, key)
+			} else if !private && !p.Private {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
+// Returns n random peers, or all of the peers, whichever is lower.
+// If count is 0, all of the peers are returned, shuffled.
+func (pl *Peerlist) random(count int, includePrivate bool) []*Peer {
+	keys := []string{}
+	if includePrivate {
+		keys = append(pl.getAddresses(true), pl.getAddresses(false)...)
+	} else {
+		keys = pl.getAddresses(false)
+	}
+	if len(keys) == 0 {
+		return make([]*Peer, 0)
+	}
+	max := count
+	if count == 0 || count > len(keys) {
+		max = len(keys)
+	}
+	peers := make([]*Peer, 0, max)
+	perm := rand.Perm(len(keys))
+	for _, i := range perm[:max] {
+		peers = append(peers, pl.peers[keys[i]])
+	}
+	return peers
+}
+
+func (pl *Peerlist) getExchgAddr(private bool) []string {
+	keys := []string{}
+	for a, p := range pl.peers {
+		if p.HasIncomePort && p.Private == private {
+			keys = append(keys, a)
+		}
+	}
+	return keys
+}
+
+// returns all exchangeable addresses
+func (pl *Peerlist) getAllExchgAddr() []string {
+	return append(pl.getExchgAddr(true), pl.getExchgAddr(false)...)
+}
+
+// returns n random exchangeable peers, return all if count is 0.
+func (pl *Peerlist) randomExchg(count int, includePrivate bool) []*Peer {
+	keys := []string{}
+	if includePrivate {
+		keys = pl.getAllExchgAddr()
+	} else {
+		keys = pl.getExchgAddr(false)
+	}
+
+	if len(keys) == 0 {
+		return make([]*Peer, 0)
+	}
+
+	max := count
+	if count == 0 || count > len(keys) {
+		max = len(keys)
+	}
+	peers := make([]*Peer, 0, max)
+	perm := rand.Perm(len(keys))
+	for _, i := range perm[:max] {
+		peers = append(peers, pl.peers[keys[i]])
+	}
+	return peers
+}
+
+// RandomExchgPublic returns n random exchangeable public peers
+// return all exchangeable public peers if count is 0.
+func (pl *Peerlist) RandomExchgPublic(count int) []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		peers = pl.randomExchg(count, false)
+	}, "RandomExchgPublic")
+	return peers
+}
+
+// RandomExchgAll returns n random exchangeable peers, including private peers.
+// return all exchangeable peers if count is 0.
+func (pl *Peerlist) RandomExchgAll(count int) []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		peers = pl.randomExchg(count, true)
+	}, "RandomExchgAll")
+	return peers
+}
+
+// SelectPublic returns up to count public peers to connect to, at most one per
+// subnet and none in the excluded subnets.  Peers are drawn at random, weighted
+// by their Score, so good peers are favored while the others still get tried.
+// If count is 0, one peer per subnet is returned.
+func (pl *Peerlist) SelectPublic(count int, excludeSubnets map[string]bool) []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		peers = pl.selectPublic(count, excludeSubnets)
+	}, "SelectPublic")
+	return peers
+}
+
+func (pl *Peerlist) selectPublic(count int, excludeSubnets map[string]bool) []*Peer {
+	type candidate struct {
+		peer *Peer
+		key  float64
+	}
+
+	var cands []candidate
+	for _, addr := range pl.getAddresses(false) {
+		p := pl.peers[addr]
+		if excludeSubnets[Subnet(addr)] {
+			continue
+		}
+		// weighted random order: the keys are exponentially distributed with
+		// a rate of the score, lower keys come first
+		cands = append(cands, candidate{
+			peer: p,
+			key:  rnum.ExpFloat64() / p.Score(),
+		})
+	}
+
+	sort.Slice(cands, func(i, j int) bool {
+		return cands[i].key < cands[j].key
+	})
+
+	var peers []*Peer
+	subnets := make(map[string]bool)
+	for _, c := range cands {
+		if count > 0 && len(peers) >= count {
+			break
+		}
+		sn := Subnet(c.peer.Addr)
+		if subnets[sn] {
+			continue
+		}
+		subnets[sn] = true
+		peers = append(peers, c.peer)
+	}
+	return peers
+}
+
+// RandomPublic returns n random peers, or all of the peers, whichever is lower.
+// If count is 0, all of the peers are returned, shuffled.  Will not include
+// private peers.
+func (pl *Peerlist) RandomPublic(count int) []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		peers = pl.random(count, false)
+	}, "RandomPublic")
+	return peers
+}
+
+// RandomAll returns n random peers, or all of the peers, whichever is lower.
+// If count is 0, all of the peers are returned, shuffled.  Includes private
+// peers.
+func (pl *Peerlist) RandomAll(count int) []*Peer {
+	var peers []*Peer
+	pl.strand(func() {
+		peers = pl.random(count, true)
+	}, "RandomAll")
+	return peers
+}
+
+// IncreaseRetryTimes increases retry times
+func (pl *Peerlist) IncreaseRetryTimes(addr string) {
+	pl.strand(func() {
+		if _, ok := pl.peers[addr]; ok {
+			pl.peers[addr].IncreaseRetryTimes()
+			pl.peers[addr].Seen()
+		}
+	}, "IncreaseRetryTimes")
+}
+
+// ResetRetryTimes reset retry times
+func (pl *Peerlist) ResetRetryTimes(addr string) {
+	pl.strand(func() {
+		if _, ok := pl.peers[addr]; ok {
+			pl.peers[addr].ResetRetryTimes()
+			pl.peers[addr].Seen()
+		}
+	}, "ResetRetryTimes")
+}
+
+// SetConnected records a successful connection to the peer, which advertised
+// the given protocol version.  A peer advertising another version than before
+// was updated, it's no longer considered legacy.
+func (pl *Peerlist) SetConnected(addr string, version int32) {
+	pl.strand(func() {
+		if p, ok := pl.peers[addr]; ok {
+			p.ResetRetryTimes()
+			p.Seen()
+			p.LastConnected = p.LastSeen
+			p.ConnectSuccesses++
+			if p.Version != version {
+				p.Legacy = false
+			}
+			p.Version = version
+		}
+	}, "SetConnected")
+}
+
+// SetLegacy records whether the peer runs a version that doesn't know the
+// FeaturesMessage
+func (pl *Peerlist) SetLegacy(addr string, legacy bool) {
+	pl.strand(func() {
+		if p, ok := pl.peers[addr]; ok {
+			p.Legacy = legacy
+		}
+	}, "SetLegacy")
+}
+
+// SetConnectFailed records a failed connection attempt to the peer
+func (pl *Peerlist) SetConnectFailed(addr string) {
+	pl.strand(func() {
+		if p, ok := pl.peers[addr]; ok {
+			p.IncreaseRetryTimes()
+			p.Seen()
+			p.ConnectFailures++
+		}
+	}, "SetConnectFailed")
+}
+
+// SetLatency records the time it took to connect to the peer
+func (pl *Peerlist) SetLatency(addr string, latency time.Duration) {
+	pl.strand(func() {
+		if p, ok := pl.peers[addr]; ok {
+			p.Latency = latency
+		}
+	}, "SetLatency")
+}
+
+// ResetAllRetryTimes reset all peers' retry times
+func (pl *Peerlist) ResetAllRetryTimes() {
+	logger.Info("Reset all peer's retry times")
+	pl.strand(func() {
+		for _, p := range pl.peers {
+			p.ResetRetryTimes()
+		}
+	})
+}
+
+// Pex manages a set of known peers and controls peer acquisition
+type Pex struct {
+	// All known peers
+	*Peerlist
+	// If false, localhost peers will be rejected from the peerlist
+	AllowLocalhost bool
+	maxPeers       int
+	// peer database, open between Open and Close
+	db *bolt.DB
+}
+
+// NewPex creates pex
+func NewPex(maxPeers int) *Pex {
+	return &Pex{
+		Peerlist:       &Peerlist{peers: make(map[string]*Peer, maxPeers)},
+		maxPeers:       maxPeers,
+		AllowLocalhost: false,
+	}
+}
+
+// AddPeer adds a peer to the peer list, given an address. If the peer list is
+// full, PeerlistFullError is returned */
+func (px *Pex) AddPeer(ip string) (*Peer, error) {
+	return px.AddPeerFromSource(ip, "")
+}
+
+// AddPeerFromSource adds a peer to the peer list and records the name of the
+// discovery source it came from. The source of a known peer is not changed.
+func (px *Pex) AddPeerFromSource(ip string, source string) (*Peer, error) {
+	if !ValidateAddress(ip, px.AllowLocalhost) {
+		return nil, ErrInvalidAddress
+	}
+	var p Peer
+	var err error
+	px.Peerlist.strand(func() {
+		peer := px.peers[ip]
+		if peer != nil {
+			peer.Seen()
+			p = *peer
+			return
+		} else if px.full() {
+			err = ErrPeerlistFull
+		} else {
+			peer := NewPeer(ip)
+			peer.Source = source
+			px.peers[ip] = peer
+			p = *peer
+		}
+	}, "AddPeer")
+	return &p, err
+}
+
+// SetPrivate updates the private value of given ip in peerlist
+func (px *Pex) SetPrivate(ip string, private bool) error {
+	var err error
+	px.Peerlist.strand(func() {
+		if p, ok := px.peers[ip]; ok {
+			p.Private = private
+			return
+		}
+
+		err = fmt.Errorf("Set peer.Private failed: %v does not exist in peerlist", ip)
+	})
+	return err
+}
+
+// SetTrustState updates the peer's Trusted statue
+func (px *Pex) SetTrustState(addr string, trusted bool) error {
+	if !ValidateAddress(addr, px.AllowLocalhost) {
+		return ErrInvalidAddress
+	}
+
+	var err error
+	px.strand(func() {
+		if p, ok := px.peers[addr]; ok {
+			p.Trusted = trusted
+		} else {
+			err = fmt.Errorf("%s does not exist in peel list", addr)
+		}
+
+	}, "SetTrustState")
+
+	return err
+}
+
+// SetPeerHasInPort update whether the peer has incomming port.
+func (px *Pex) SetPeerHasInPort(addr string, v bool) error {
+	if !ValidateAddress(addr, px.AllowLocalhost) {
+		return ErrInvalidAddress
+	}
+
+	var err error
+	px.strand(func() {
+		if p, ok := px.peers[addr]; ok {
+			p.HasIncomePort = v
+			p.Seen()
+		} else {
+			err = fmt.Errorf("peer %s is not in exchange peer list", addr)
+		}
+
+	}, "SetPeerHasInPort")
+
+	return err
+}
+
+// Full returns true if no more peers can be added
+func (px *Pex) Full() bool {
+	var full bool
+	px.strand(func() {
+		full = px.full()
+	}, "Full")
+	return full
+}
+
+func (px *Pex) full() bool {
+	return px.maxPeers > 0 && len(px.peers) >= px.maxPeers
+}
+
+// AddPeers add multiple peers at once. Any errors will be logged, but not returned
+// Returns the number of peers that were added without error.  Note that
+// adding a duplicate peer will not cause an error.
+func (px *Pex) AddPeers(peers []string) int {
+	return px.AddPeersFromSource(peers, "")
+}
+
+// AddPeersFromSource adds multiple peers learned from the given source.
+// Returns the number of peers that were added without error.
+func (px *Pex) AddPeersFromSource(peers []string, source string) int {
+	n := len(peers)
+	for _, p := range peers {
+		_, err := px.AddPeerFromSource(p, source)
+		if err != nil {
+			logger.Warning("Failed to add peer %s, Reason: %v", p, err)
+			n--
+		}
+	}
+	return n
+}
+
+/* Common utilities */
+
+// Reads a file located at dir/filename and splits it on newlines
+func readLines(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, info.Size())
+	_, err = f.Read(data)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return strings.Split(string(data), "\n"), nil
+}
+
+// Now returns UTC time
+func Now() time.Time {
+	return utc.Now()
+}
@@ -64,6 +64,17 @@ func TestValidateAddress(t *testing.T) {
 	assert.True(t, ValidateAddress("127.0.0.1:8888", true))
 	// localhost is not allowed
 	assert.False(t, ValidateAddress("127.0.0.1:8888", false))
+	// ipv6
+	assert.True(t, ValidateAddress("[2001:db8::1]:8888", false))
+	assert.True(t, ValidateAddress("[::1]:8888", true))
+	assert.False(t, ValidateAddress("[::1]:8888", false))
+	assert.False(t, ValidateAddress("[::]:8888", false))
+	assert.False(t, ValidateAddress("[ff02::1]:8888", false))
+	assert.False(t, ValidateAddress("[2001:db8::1]:1000", false))
+	// ipv6 requires brackets
+	assert.False(t, ValidateAddress("2001:db8::1:8888", false))
+	// host names are not ips
+	assert.False(t, ValidateAddress("example.com:8888", false))
 }
 
 /* Peer tests */
@@ -86,6 +97,29 @@ func TestPeerSeen(t *testing.T) {
 	}
 }
 
+func TestSetLegacy(t *testing.T) {
+	px := NewPex(10)
+	_, err := px.AddPeer(address)
+	assert.NoError(t, err)
+
+	legacy := func() bool {
+		p, ok := px.GetPeerByAddr(address)
+		assert.True(t, ok)
+		return p.Legacy
+	}
+
+	px.SetConnected(address, 2)
+	px.SetLegacy(address, true)
+	assert.True(t, legacy())
+
+	// reconnecting with the same version doesn't clear the flag, a new
+	// version does
+	px.SetConnected(address, 2)
+	assert.True(t, legacy())
+	px.SetConnected(address, 3)
+	assert.False(t, legacy())
+}
+
 func TestPeerString(t *testing.T) {
 	p := NewPeer(address)
 	assert.Equal(t, address, p.String())
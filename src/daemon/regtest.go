@@ -0,0 +1,186 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+)
+
+// On a regtest network the node is the master, its key is known and it
+// doesn't connect to peers.  Blocks are created on request instead of on a
+// timer, coins are sent from the genesis address to the addresses under
+// test, and the clock of new blocks is moved forward to accumulate coin hours.
+
+// MaxGenerateBlocks is the most blocks GenerateBlocks creates in one call
+const MaxGenerateBlocks = 1000
+
+var errNotRegtest = errors.New("Only available in regtest mode")
+
+func (vs *Visor) checkRegtest() error {
+	if vs.Config.Disabled {
+		return errors.New("Visor disabled")
+	}
+	if !vs.Config.Regtest {
+		return errNotRegtest
+	}
+	if !vs.Config.Config.IsMaster {
+		return errors.New("Only master chain can create blocks")
+	}
+	return nil
+}
+
+// GenerateBlocks creates and publishes n blocks right away, at most
+// MaxGenerateBlocks.  Each block holds the unconfirmed txns; blocks can't be
+// empty, so when there are none the block holds a txn of the genesis address
+// sending its coins and coin hours to itself, which burns a single hour.
+// Each block is at least a second after the previous one, the clock is moved
+// forward if needed.
+func (vs *Visor) GenerateBlocks(pool Broadcaster, n int) ([]coin.SignedBlock, error) {
+	if err := vs.checkRegtest(); err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		return nil, errors.New("Number of blocks must be positive")
+	}
+	if n > MaxGenerateBlocks {
+		return nil, fmt.Errorf("Number of blocks must not exceed %d", MaxGenerateBlocks)
+	}
+
+	var sbs []coin.SignedBlock
+	err := vs.strand(func() error {
+		for i := 0; i < n; i++ {
+			sb, err := vs.generateBlock(pool)
+			if err != nil {
+				return err
+			}
+			sbs = append(sbs, sb)
+		}
+		return nil
+	})
+	return sbs, err
+}
+
+// FundAddress sends coins from the genesis address to addr and creates a
+// block with the txn right away.  Returns the txn and the block.
+func (vs *Visor) FundAddress(pool Broadcaster, addr cipher.Address, coins uint64) (coin.Transaction, coin.SignedBlock, error) {
+	if err := vs.checkRegtest(); err != nil {
+		return coin.Transaction{}, coin.SignedBlock{}, err
+	}
+	if coins == 0 {
+		return coin.Transaction{}, coin.SignedBlock{}, errors.New("Number of coins must be positive")
+	}
+	if err := DropletPrecisionCheck(coins); err != nil {
+		return coin.Transaction{}, coin.SignedBlock{}, err
+	}
+
+	var txn coin.Transaction
+	var sb coin.SignedBlock
+	err := vs.strand(func() error {
+		var err error
+		if txn, err = vs.genesisSpend(addr, coins, false); err != nil {
+			return err
+		}
+		if err = vs.injectTransaction(txn, nil); err != nil {
+			return err
+		}
+		sb, err = vs.generateBlock(pool)
+		return err
+	})
+	return txn, sb, err
+}
+
+// AdvanceTime moves the clock of new blocks forward by d and returns the new
+// time.  The coin hours of the outputs grow once a block is created.
+func (vs *Visor) AdvanceTime(d time.Duration) (uint64, error) {
+	if err := vs.checkRegtest(); err != nil {
+		return 0, err
+	}
+
+	var now uint64
+	err := vs.strand(func() error {
+		if err := vs.v.AdvanceTime(d); err != nil {
+			return err
+		}
+		now = vs.v.Now()
+		return nil
+	})
+	return now, err
+}
+
+func (vs *Visor) generateBlock(pool Broadcaster) (coin.SignedBlock, error) {
+	if now, head := vs.v.Now(), vs.v.Blockchain.Time(); now <= head {
+		if err := vs.v.AdvanceTime(time.Duration(head-now+1) * time.Second); err != nil {
+			return coin.SignedBlock{}, err
+		}
+	}
+
+	if vs.v.Unconfirmed.Len() != 0 {
+		return vs.createAndPublishBlock(pool)
+	}
+
+	// the self-spend keeps the coin hours of the genesis address, so it
+	// can't go through the unconfirmed pool, which burns half of them
+	txn, err := vs.genesisSpend(vs.Config.Config.GenesisAddress, 0, true)
+	if err != nil {
+		return coin.SignedBlock{}, err
+	}
+
+	vs.stateLk.Lock()
+	sb, err := vs.v.CreateAndExecuteBlockOf(coin.Transactions{txn})
+	vs.stateLk.Unlock()
+	if err != nil {
+		return coin.SignedBlock{}, err
+	}
+	vs.broadcastBlock(sb, pool)
+	return sb, nil
+}
+
+// genesisSpend creates a txn spending the outputs of the genesis address,
+// which sends coins to addr and the rest back to the genesis address.  The
+// coin hours of the inputs are burned, unless keepHours is set, then the
+// rest gets all of them but one.
+func (vs *Visor) genesisSpend(addr cipher.Address, coins uint64, keepHours bool) (coin.Transaction, error) {
+	c := vs.Config.Config
+	if cipher.AddressFromSecKey(c.BlockchainSeckey) != c.GenesisAddress {
+		return coin.Transaction{}, errors.New("Genesis address is not owned by the master key")
+	}
+
+	uxs := vs.v.Blockchain.Unspent().GetUnspentsOfAddrs([]cipher.Address{c.GenesisAddress})[c.GenesisAddress]
+	headTime := vs.v.Blockchain.Time()
+
+	var txn coin.Transaction
+	var total, hours uint64
+	keys := make([]cipher.SecKey, 0, len(uxs))
+	for _, ux := range uxs {
+		txn.PushInput(ux.Hash())
+		keys = append(keys, c.BlockchainSeckey)
+		total += ux.Body.Coins
+		hours += ux.CoinHours(headTime)
+	}
+
+	if total == 0 || total < coins {
+		return coin.Transaction{}, errors.New("Genesis address has insufficient coins")
+	}
+	if !keepHours {
+		hours = 0
+	} else if hours > 0 {
+		// while the head is the genesis block, new outputs are verified with
+		// an empty source txn, and an output with all the hours would match
+		// the genesis output
+		hours--
+	}
+
+	if coins > 0 {
+		txn.PushOutput(addr, coins, 0)
+	}
+	if total > coins {
+		txn.PushOutput(c.GenesisAddress, total-coins, hours)
+	}
+
+	txn.SignInputs(keys)
+	txn.UpdateHeader()
+	return txn, nil
+}
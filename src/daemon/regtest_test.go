@@ -0,0 +1,215 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+// setupRegtestVisor runs a regtest Visor and waits for its genesis block
+func setupRegtestVisor(t *testing.T) (*Visor, *recordingBroadcaster, func()) {
+	vs, cleanup := setupMasterVisor(t)
+	vs.Config.Regtest = true
+
+	pool := &recordingBroadcaster{}
+	vs.Start(pool)
+
+	for i := 0; i < 100; i++ {
+		var uxs coin.UxArray
+		vs.view(func() {
+			uxs, _ = vs.v.Blockchain.Unspent().GetAll()
+		})
+		if len(uxs) != 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return vs, pool, func() {
+		vs.Shutdown()
+		cleanup()
+	}
+}
+
+func addressCoins(vs *Visor, addr cipher.Address) uint64 {
+	var coins uint64
+	vs.view(func() {
+		uxs := vs.v.Blockchain.Unspent().GetUnspentsOfAddrs([]cipher.Address{addr})
+		for _, ux := range uxs[addr] {
+			coins += ux.Body.Coins
+		}
+	})
+	return coins
+}
+
+func TestVisorGenerateBlocks(t *testing.T) {
+	vs, pool, cleanup := setupRegtestVisor(t)
+	defer cleanup()
+
+	sbs, err := vs.GenerateBlocks(pool, 3)
+	require.NoError(t, err)
+	require.Len(t, sbs, 3)
+	for i, sb := range sbs {
+		require.Equal(t, uint64(i+1), sb.Seq())
+		require.Len(t, sb.Block.Body.Transactions, 1)
+		// the genesis address keeps its coin hours
+		require.Equal(t, uint64(1), sb.Block.Head.Fee)
+		if i > 0 {
+			require.True(t, sb.Time() > sbs[i-1].Time())
+		}
+	}
+	require.Equal(t, uint64(3), vs.HeadBkSeq())
+	require.Len(t, pool.messages(), 3)
+
+	// the blocks send the genesis coins to the genesis address
+	require.Equal(t, GenesisCoins, addressCoins(vs, GenesisAddress))
+
+	_, err = vs.GenerateBlocks(pool, 0)
+	testutil.RequireError(t, err, "Number of blocks must be positive")
+	_, err = vs.GenerateBlocks(pool, MaxGenerateBlocks+1)
+	testutil.RequireError(t, err, "Number of blocks must not exceed 1000")
+}
+
+func TestVisorFundAddress(t *testing.T) {
+	vs, pool, cleanup := setupRegtestVisor(t)
+	defer cleanup()
+
+	_, _, addr := MakeAddress()
+	txn, sb, err := vs.FundAddress(pool, addr, 10e6)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sb.Seq())
+	require.Equal(t, coin.Transactions{txn}, sb.Block.Body.Transactions)
+	require.Equal(t, uint64(10e6), addressCoins(vs, addr))
+	require.Equal(t, GenesisCoins-10e6, addressCoins(vs, GenesisAddress))
+
+	_, _, err = vs.FundAddress(pool, addr, 0)
+	testutil.RequireError(t, err, "Number of coins must be positive")
+	_, _, err = vs.FundAddress(pool, addr, 1)
+	testutil.RequireError(t, err, "invalid amount, too many decimal place")
+	_, _, err = vs.FundAddress(pool, addr, GenesisCoins)
+	testutil.RequireError(t, err, "Genesis address has insufficient coins")
+}
+
+func TestVisorAdvanceTime(t *testing.T) {
+	vs, pool, cleanup := setupRegtestVisor(t)
+	defer cleanup()
+
+	_, _, addr := MakeAddress()
+	_, _, err := vs.FundAddress(pool, addr, 10e6)
+	require.NoError(t, err)
+
+	before := vs.v.Now()
+	now, err := vs.AdvanceTime(24 * time.Hour)
+	require.NoError(t, err)
+	require.True(t, now >= before+24*3600)
+
+	sbs, err := vs.GenerateBlocks(pool, 1)
+	require.NoError(t, err)
+	require.True(t, sbs[0].Time() >= now)
+
+	// 10 coins held for 24 hours
+	var hours uint64
+	vs.view(func() {
+		uxs := vs.v.Blockchain.Unspent().GetUnspentsOfAddrs([]cipher.Address{addr})
+		hours = uxs[addr][0].CoinHours(vs.v.Blockchain.Time())
+	})
+	require.True(t, hours >= 240)
+
+	_, err = vs.AdvanceTime(-time.Hour)
+	testutil.RequireError(t, err, "Time can only move forward")
+}
+
+func TestVisorNotRegtest(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+
+	pool := &recordingBroadcaster{}
+	_, err := vs.GenerateBlocks(pool, 1)
+	require.Equal(t, errNotRegtest, err)
+	_, _, err = vs.FundAddress(pool, GenesisAddress, 1e6)
+	require.Equal(t, errNotRegtest, err)
+	_, err = vs.AdvanceTime(time.Hour)
+	require.Equal(t, errNotRegtest, err)
+}
+
+func TestVisorTransactionStatus(t *testing.T) {
+	vs, pool, cleanup := setupRegtestVisor(t)
+	defer cleanup()
+
+	getTxn := func(h cipher.SHA256) *visor.Transaction {
+		var tx *visor.Transaction
+		vs.view(func() {
+			var err error
+			tx, err = vs.v.GetTransaction(h)
+			require.NoError(t, err)
+		})
+		return tx
+	}
+
+	_, _, addr := MakeAddress()
+	var txn coin.Transaction
+	err := vs.strand(func() error {
+		var err error
+		txn, err = vs.genesisSpend(addr, 10e6, false)
+		return err
+	})
+	require.NoError(t, err)
+	h := txn.Hash()
+
+	require.Nil(t, getTxn(h))
+
+	// an injected txn was verified, it is pending before the next refresh
+	_, err = vs.InjectTxn(txn)
+	require.NoError(t, err)
+	tx := getTxn(h)
+	require.Equal(t, visor.TxnPending, tx.Status.State)
+	vs.RefreshUnconfirmed()
+	tx = getTxn(h)
+	require.Equal(t, visor.TxnPending, tx.Status.State)
+	require.True(t, tx.Status.Unconfirmed)
+	firstSeen := tx.Status.FirstSeen
+	require.NotZero(t, firstSeen)
+	require.Zero(t, tx.Status.LastAnnounced)
+
+	vs.SetTxnsAnnounced([]cipher.SHA256{h})
+	require.NotZero(t, getTxn(h).Status.LastAnnounced)
+
+	// the txn is evicted once it was not received for UnconfirmedMaxAge
+	_, err = vs.AdvanceTime(vs.Config.Config.UnconfirmedMaxAge + time.Hour)
+	require.NoError(t, err)
+	vs.RefreshUnconfirmed()
+	tx = getTxn(h)
+	require.NotNil(t, tx)
+	require.Equal(t, visor.TxnEvicted, tx.Status.State)
+	require.False(t, tx.Status.Unconfirmed)
+	require.Equal(t, h, tx.Txn.Hash())
+	require.Equal(t, firstSeen, tx.Status.FirstSeen)
+	require.True(t, tx.Status.Evicted >= vs.v.Now()-1)
+
+	// it is pending again when received again, and keeps its first seen time
+	_, err = vs.InjectTxn(txn)
+	require.NoError(t, err)
+	vs.RefreshUnconfirmed()
+	tx = getTxn(h)
+	require.Equal(t, visor.TxnPending, tx.Status.State)
+	require.Equal(t, firstSeen, tx.Status.FirstSeen)
+	require.Zero(t, tx.Status.Evicted)
+
+	_, err = vs.GenerateBlocks(pool, 1)
+	require.NoError(t, err)
+	// the txn is found once the block is parsed
+	for tx = getTxn(h); tx == nil && vs.v.ParsedHeight() < 1; tx = getTxn(h) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NotNil(t, tx)
+	require.Equal(t, visor.TxnConfirmed, tx.Status.State)
+	require.Equal(t, uint64(1), tx.Status.Height)
+	require.Equal(t, firstSeen, tx.Status.FirstSeen)
+	require.NotZero(t, tx.Status.LastAnnounced)
+}
@@ -381,3 +381,58 @@ func TestIPCountDecrease(t *testing.T) {
 	assert.Equal(t, 2, len(ic.value))
 	assert.Equal(t, 1, ic.value["b"].(int))
 }
+
+func TestIPCountIPv6(t *testing.T) {
+	ic := NewIPCount()
+	ic.Increase("2001:db8:1:2::1")
+	ic.Increase("2001:db8:1:2:aaaa::5")
+	ic.Increase("2001:db8:1:3::1")
+	ic.Increase("112.32.32.14")
+
+	// addresses in the same /64 are counted together
+	n, ok := ic.Get("2001:db8:1:2:ffff::1")
+	assert.True(t, ok)
+	assert.Equal(t, 2, n)
+
+	n, ok = ic.Get("2001:db8:1:3::2")
+	assert.True(t, ok)
+	assert.Equal(t, 1, n)
+
+	n, ok = ic.Get("112.32.32.14")
+	assert.True(t, ok)
+	assert.Equal(t, 1, n)
+
+	_, ok = ic.Get("112.32.32.15")
+	assert.False(t, ok)
+
+	ic.Decrease("2001:db8:1:2::1")
+	n, _ = ic.Get("2001:db8:1:2::1")
+	assert.Equal(t, 1, n)
+}
+
+func TestConnectionFeatures(t *testing.T) {
+	cf := NewConnectionFeatures()
+	_, ok := cf.Get("a")
+	assert.False(t, ok)
+
+	cf.Add("a", FeatureIPv6)
+	f, ok := cf.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, FeatureIPv6, f)
+
+	cf.Remove("a")
+	_, ok = cf.Get("a")
+	assert.False(t, ok)
+}
+
+func TestAddrSet(t *testing.T) {
+	as := NewAddrSet()
+	assert.False(t, as.Has("a"))
+
+	as.Add("a")
+	assert.True(t, as.Has("a"))
+	assert.False(t, as.Has("b"))
+
+	as.Remove("a")
+	assert.False(t, as.Has("a"))
+}
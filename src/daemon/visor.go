@@ -0,0 +1,1180 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/utc"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+//TODO
+//- download block headers
+//- request blocks individually across multiple peers
+
+//TODO
+//- use CXO for blocksync
+
+/*
+Visor should not be duplicated
+- this should be pushed into /src/visor
+*/
+
+// ErrVisorClosed is returned by the Visor requests made once Shutdown is called
+var ErrVisorClosed = errors.New("Visor closed")
+
+// VisorConfig represents the configuration of visor
+type VisorConfig struct {
+	Config visor.Config
+	// Disabled the visor completely
+	Disabled bool
+	// Don't send messages to peers, set when the daemon's networking is
+	// disabled
+	DisableNetworking bool
+	// How often to request blocks from peers
+	BlocksRequestRate time.Duration
+	// How often to announce our blocks to peers
+	BlocksAnnounceRate time.Duration
+	// How many blocks to respond with to a GetBlocksMessage
+	BlocksResponseCount uint64
+	//how long between saving copies of the blockchain
+	BlockchainBackupRate time.Duration
+	// Max announce txns hash number
+	MaxTxnAnnounceNum int
+	// How often to announce our unconfirmed txns to peers
+	TxnsAnnounceRate time.Duration
+	// New txns are announced to each peer after a random delay up to this value
+	TxnsAnnounceMaxDelay time.Duration
+	// Max number of txn hashes remembered as known by each peer
+	MaxKnownTxnsPerPeer int
+	// How long Shutdown waits for the Run loop to stop
+	ShutdownTimeout time.Duration
+	// Create blocks only on request and allow GenerateBlocks, FundAddress
+	// and AdvanceTime, for regtest networks
+	Regtest bool
+}
+
+// nopBroadcaster drops the messages, it replaces the pool when networking is
+// disabled since the pool doesn't run
+type nopBroadcaster struct{}
+
+func (nopBroadcaster) BroadcastMessage(msg gnet.Message) error         { return nil }
+func (nopBroadcaster) SendMessage(addr string, msg gnet.Message) error { return nil }
+func (nopBroadcaster) GetConnections() ([]gnet.Connection, error)      { return nil, nil }
+
+// NewVisorConfig creates default visor config
+func NewVisorConfig() VisorConfig {
+	return VisorConfig{
+		Config:               visor.NewVisorConfig(),
+		Disabled:             false,
+		BlocksRequestRate:    time.Second * 60, //backup, could be disabled
+		BlocksAnnounceRate:   time.Second * 60, //backup, could be disabled
+		BlocksResponseCount:  20,
+		BlockchainBackupRate: time.Second * 30,
+		MaxTxnAnnounceNum:    16,
+		TxnsAnnounceRate:     time.Minute,
+		TxnsAnnounceMaxDelay: time.Second * 2,
+		MaxKnownTxnsPerPeer:  5000,
+		ShutdownTimeout:      time.Second * 10,
+	}
+}
+
+// Visor struct
+type Visor struct {
+	Config VisorConfig
+	v      *visor.Visor
+	// Peer-reported blockchain length.  Use to estimate download progress
+	blockchainLengths map[string]uint64
+	reqC              chan func() // all request will go through this channel, to keep writing and reading member variable thread safe.
+	// Shutdown stops the Run loop and closes the visor
+	Shutdown context.CancelFunc
+	// ctx is done once Shutdown is called
+	ctx context.Context
+	// Done once the Run loop returns
+	running sync.WaitGroup
+	// Block creation requests from CreateBlockNow
+	createBlockC chan chan error
+	// Txns known by each peer
+	knownTxns *KnownTxns
+	// Timers of the delayed txn announcements by connection, only used by
+	// the Run loop
+	announceTimers map[string]map[*time.Timer]struct{}
+	// stateLk is held for writing while the blockchain or the unconfirmed
+	// pool changes, and for reading by Gateway queries, so that they see a
+	// consistent state without going through the daemon loop
+	stateLk sync.RWMutex
+}
+
+// Broadcaster sends messages to the connected peers, it is implemented by
+// gnet.ConnectionPool
+type Broadcaster interface {
+	BroadcastMessage(msg gnet.Message) error
+	SendMessage(addr string, msg gnet.Message) error
+	GetConnections() ([]gnet.Connection, error)
+}
+
+// NewVisor creates visor instance
+func NewVisor(c VisorConfig) (*Visor, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	vs := &Visor{
+		Config:            c,
+		blockchainLengths: make(map[string]uint64),
+		knownTxns:         NewKnownTxns(c.MaxKnownTxnsPerPeer),
+		announceTimers:    make(map[string]map[*time.Timer]struct{}),
+		reqC:              make(chan func(), 100),
+		ctx:               ctx,
+		createBlockC:      make(chan chan error),
+		Shutdown:          cancel,
+	}
+
+	if c.Disabled {
+		return vs, nil
+	}
+
+	v, closeVs, err := visor.NewVisor(c.Config)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	vs.v = v
+
+	vs.Shutdown = func() {
+		// stop the Run loop, then close the visor
+		cancel()
+		if !waitTimeout(&vs.running, c.ShutdownTimeout) {
+			logger.Warning("Visor loop did not stop within %v", c.ShutdownTimeout)
+		}
+		closeVs()
+	}
+
+	return vs, nil
+}
+
+// Start runs the visor and its maintenance loop in a goroutine.  The loop
+// creates blocks if running as master, refreshes the unconfirmed pool, and
+// requests and announces blocks and txns to the peers of pool.  It returns
+// once Shutdown is called, its error is sent on the returned channel.
+func (vs *Visor) Start(pool Broadcaster) <-chan error {
+	errC := make(chan error, 1)
+	// added before the goroutine starts, so that Shutdown can't miss it
+	vs.running.Add(1)
+	go func() {
+		defer vs.running.Done()
+		errC <- vs.run(pool)
+	}()
+	return errC
+}
+
+// newTicker returns the channel of a ticker of interval d and the function
+// that stops it.  Like time.Tick, the channel is nil, and never receives, if
+// d isn't positive.
+func newTicker(d time.Duration) (<-chan time.Time, func()) {
+	if d <= 0 {
+		return nil, func() {}
+	}
+	t := time.NewTicker(d)
+	return t.C, t.Stop
+}
+
+func (vs *Visor) run(pool Broadcaster) error {
+	defer logger.Info("Visor closed")
+	if vs.Config.Disabled {
+		<-vs.ctx.Done()
+		return nil
+	}
+	if vs.Config.DisableNetworking {
+		pool = nopBroadcaster{}
+	}
+
+	errC := make(chan error, 1)
+	go func() {
+		// vs.Shutdown will notify the vs.v.Run to return.
+		errC <- vs.v.Run()
+	}()
+
+	// regtest nodes create blocks on request only
+	var blockCreationTicker <-chan time.Time
+	if vs.Config.Config.IsMaster && !vs.Config.Regtest {
+		blockInterval := time.Duration(vs.Config.Config.BlockCreationInterval) * time.Second
+		c, stop := newTicker(blockInterval)
+		defer stop()
+		blockCreationTicker = c
+	}
+
+	unconfirmedRefreshTicker, stop := newTicker(vs.Config.Config.UnconfirmedRefreshRate)
+	defer stop()
+	blocksRequestTicker, stop := newTicker(vs.Config.BlocksRequestRate)
+	defer stop()
+	blocksAnnounceTicker, stop := newTicker(vs.Config.BlocksAnnounceRate)
+	defer stop()
+	txnsAnnounceTicker, stop := newTicker(vs.Config.TxnsAnnounceRate)
+	defer stop()
+	defer vs.stopAnnounceTimers()
+
+	// The maintenance tasks run in this goroutine, which also serves the
+	// strand requests, so they call the unexported methods directly
+	for {
+		select {
+		case <-vs.ctx.Done():
+			return nil
+		case err := <-errC:
+			return err
+		case req := <-vs.reqC:
+			req()
+		// Create blocks, if master chain
+		case <-blockCreationTicker:
+			vs.createBlock(pool)
+		case errC := <-vs.createBlockC:
+			errC <- vs.createBlock(pool)
+		case <-unconfirmedRefreshTicker:
+			// get the transactions that turn to valid and announce them
+			vs.announceTxns(pool, vs.refreshUnconfirmed())
+		case <-blocksRequestTicker:
+			vs.requestBlocks(pool)
+		case <-blocksAnnounceTicker:
+			vs.announceBlocks(pool)
+		case <-txnsAnnounceTicker:
+			vs.announceAllTxns(pool)
+		}
+	}
+}
+
+// CreateBlockNow makes the Run loop create and publish a block right away,
+// instead of waiting for the block creation interval.  Returns the block
+// creation error.
+func (vs *Visor) CreateBlockNow() error {
+	if vs.Config.Disabled {
+		return errors.New("Visor disabled")
+	}
+
+	errC := make(chan error, 1)
+	select {
+	case vs.createBlockC <- errC:
+		return <-errC
+	case <-vs.ctx.Done():
+		return ErrVisorClosed
+	}
+}
+
+// createBlock creates and publishes a block, if master chain
+func (vs *Visor) createBlock(pool Broadcaster) error {
+	if !vs.Config.Config.IsMaster {
+		return errors.New("Only master chain can create blocks")
+	}
+
+	sb, err := vs.createAndPublishBlock(pool)
+	if err != nil {
+		logger.Error("Failed to create block: %v", err)
+		return err
+	}
+
+	// Not a critical error, but we want it visible in logs
+	logger.Critical("Created and published a new block %d", sb.Seq(), logging.F("seq", sb.Seq()))
+	return nil
+}
+
+// strand runs f on the Run loop and returns its error, or ErrVisorClosed once
+// Shutdown is called.  f must not block.
+func (vs *Visor) strand(f func() error) error {
+	return strand(vs.reqC, vs.ctx.Done(), ErrVisorClosed, f)
+}
+
+// async queues f on the Run loop without waiting for it, so that the daemon
+// loop isn't held up by block creation or other slow visor work.  f is
+// dropped once Shutdown is called, and by a disabled visor, which has no Run
+// loop.
+func (vs *Visor) async(f func()) {
+	if vs.Config.Disabled {
+		return
+	}
+
+	select {
+	case vs.reqC <- f:
+	case <-vs.ctx.Done():
+	}
+}
+
+// view runs the read-only query f concurrently with other queries.  Changes to
+// the blockchain and the unconfirmed pool wait until f returns.
+// f must not call methods that go through strand.
+func (vs *Visor) view(f func()) {
+	vs.stateLk.RLock()
+	defer vs.stateLk.RUnlock()
+	f()
+}
+
+// RefreshUnconfirmed checks unconfirmed txns against the blockchain and purges ones too old
+func (vs *Visor) RefreshUnconfirmed() (hashes []cipher.SHA256) {
+	if vs.Config.Disabled {
+		return
+	}
+	vs.strand(func() error {
+		hashes = vs.refreshUnconfirmed()
+		return nil
+	})
+	return
+}
+
+func (vs *Visor) refreshUnconfirmed() []cipher.SHA256 {
+	vs.stateLk.Lock()
+	defer vs.stateLk.Unlock()
+	return vs.v.RefreshUnconfirmed()
+}
+
+// RequestBlocks Sends a GetBlocksMessage to all connections
+func (vs *Visor) RequestBlocks(pool Broadcaster) {
+	if vs.Config.Disabled {
+		return
+	}
+	vs.strand(func() error {
+		vs.requestBlocks(pool)
+		return nil
+	})
+}
+
+func (vs *Visor) requestBlocks(pool Broadcaster) {
+	m := NewGetBlocksMessage(vs.v.HeadBkSeq(), vs.Config.BlocksResponseCount)
+	pool.BroadcastMessage(m)
+}
+
+// AnnounceBlocks sends an AnnounceBlocksMessage to all connections
+func (vs *Visor) AnnounceBlocks(pool Broadcaster) {
+	if vs.Config.Disabled {
+		return
+	}
+	vs.strand(func() error {
+		vs.announceBlocks(pool)
+		return nil
+	})
+}
+
+func (vs *Visor) announceBlocks(pool Broadcaster) {
+	m := NewAnnounceBlocksMessage(vs.v.HeadBkSeq())
+	pool.BroadcastMessage(m)
+}
+
+// AnnounceAllTxns announces local unconfirmed transactions to all connections,
+// each connection is only sent the hashes it doesn't know
+func (vs *Visor) AnnounceAllTxns(pool Broadcaster) {
+	if vs.Config.Disabled {
+		return
+	}
+	vs.strand(func() error {
+		vs.announceAllTxns(pool)
+		return nil
+	})
+}
+
+func (vs *Visor) announceAllTxns(pool Broadcaster) {
+	addrs, err := connectionAddrs(pool)
+	if err != nil {
+		logger.Debug("Announce all txns failed: %v", err)
+		return
+	}
+
+	hashes := vs.v.GetAllValidUnconfirmedTxHashes()
+	for _, addr := range addrs {
+		vs.announceTxnsToAddr(pool, addr, hashes)
+	}
+}
+
+// AnnounceAllTxnsToAddr announces local unconfirmed transactions to one
+// connection, from the Run loop
+func (vs *Visor) AnnounceAllTxnsToAddr(pool Broadcaster, addr string) {
+	if vs.Config.Disabled {
+		return
+	}
+
+	vs.async(func() {
+		vs.announceTxnsToAddr(pool, addr, vs.v.GetAllValidUnconfirmedTxHashes())
+	})
+}
+
+// announceTxns announces new transaction hashes to all connections, from the
+// Run loop.  The announcement to each connection is delayed by a random
+// duration up to TxnsAnnounceMaxDelay, so that the origin of a transaction is
+// harder to trace.  The delayed announcements are dropped when the connection
+// closes or the visor stops.
+func (vs *Visor) announceTxns(pool Broadcaster, txns []cipher.SHA256) {
+	if vs.Config.Disabled {
+		return
+	}
+	if len(txns) <= 0 {
+		return
+	}
+
+	addrs, err := connectionAddrs(pool)
+	if err != nil {
+		logger.Debug("Announce txns failed: %v", err)
+		return
+	}
+
+	for _, addr := range addrs {
+		delay := vs.announceDelay()
+		if delay == 0 {
+			vs.announceTxnsToAddr(pool, addr, txns)
+			continue
+		}
+
+		addr := addr
+		var t *time.Timer
+		t = time.AfterFunc(delay, func() {
+			vs.async(func() {
+				// the connection closed after the timer fired
+				if _, ok := vs.announceTimers[addr][t]; !ok {
+					return
+				}
+				delete(vs.announceTimers[addr], t)
+				if len(vs.announceTimers[addr]) == 0 {
+					delete(vs.announceTimers, addr)
+				}
+				vs.announceTxnsToAddr(pool, addr, txns)
+			})
+		})
+
+		if vs.announceTimers[addr] == nil {
+			vs.announceTimers[addr] = make(map[*time.Timer]struct{})
+		}
+		vs.announceTimers[addr][t] = struct{}{}
+	}
+}
+
+// stopAnnounceTimers drops the delayed txn announcements to addrs, or to all
+// connections if none is given
+func (vs *Visor) stopAnnounceTimers(addrs ...string) {
+	if len(addrs) == 0 {
+		for addr := range vs.announceTimers {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	for _, addr := range addrs {
+		for t := range vs.announceTimers[addr] {
+			t.Stop()
+		}
+		delete(vs.announceTimers, addr)
+	}
+}
+
+// announceTxnsToAddr sends the hashes that the connection doesn't know in AnnounceTxnsMessages
+func (vs *Visor) announceTxnsToAddr(pool Broadcaster, addr string, hashes []cipher.SHA256) {
+	unknown := vs.knownTxns.FilterKnown(addr, hashes)
+	n := vs.Config.MaxTxnAnnounceNum
+	if n > maxTxnHashes {
+		n = maxTxnHashes
+	}
+	for _, hs := range divideHashes(unknown, n) {
+		m := NewAnnounceTxnsMessage(hs)
+		if err := pool.SendMessage(addr, m); err != nil {
+			logger.Debug("Send AnnounceTxnsMessage to %s failed: %v", addr, err)
+			return
+		}
+		vs.knownTxns.SetAnnounced(addr, hs)
+	}
+}
+
+// announceDelay returns a random delay in [0, TxnsAnnounceMaxDelay)
+func (vs *Visor) announceDelay() time.Duration {
+	if vs.Config.TxnsAnnounceMaxDelay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(vs.Config.TxnsAnnounceMaxDelay)))
+}
+
+// SetTxnsKnown records that the connection has the transactions
+func (vs *Visor) SetTxnsKnown(addr string, hashes []cipher.SHA256) {
+	vs.knownTxns.SetHas(addr, hashes)
+}
+
+// returns the addresses of all connections in the pool
+func connectionAddrs(pool Broadcaster) ([]string, error) {
+	conns, err := pool.GetConnections()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(conns))
+	for _, c := range conns {
+		addrs = append(addrs, c.Addr())
+	}
+	return addrs, nil
+}
+
+func divideHashes(hashes []cipher.SHA256, n int) [][]cipher.SHA256 {
+	if len(hashes) == 0 {
+		return [][]cipher.SHA256{}
+	}
+	var j int
+	var hashesArray [][]cipher.SHA256
+	if len(hashes) > n {
+		for i := range hashes {
+			if len(hashes[j:i]) == n {
+				hs := make([]cipher.SHA256, n)
+				copy(hs, hashes[j:i])
+				hashesArray = append(hashesArray, hs)
+				j = i
+			}
+		}
+	}
+	hs := make([]cipher.SHA256, len(hashes)-j)
+	copy(hs, hashes[j:])
+	hashesArray = append(hashesArray, hs)
+	return hashesArray
+}
+
+// RequestBlocksFromAddr sends a GetBlocksMessage to one connected address
+func (vs *Visor) RequestBlocksFromAddr(pool *Pool, addr string) error {
+	if vs.Config.Disabled {
+		return errors.New("Visor disabled")
+	}
+	return vs.strand(func() error {
+		return vs.requestBlocksFromAddr(pool, addr)
+	})
+}
+
+func (vs *Visor) requestBlocksFromAddr(pool *Pool, addr string) error {
+	m := NewGetBlocksMessage(vs.v.HeadBkSeq(), vs.Config.BlocksResponseCount)
+	exist, err := pool.Pool.IsConnExist(addr)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return fmt.Errorf("Tried to send GetBlocksMessage to %s, but we're "+
+			"not connected", addr)
+	}
+	return pool.Pool.SendMessage(addr, m)
+}
+
+// SetTxnsAnnounced sets all txns as announced
+func (vs *Visor) SetTxnsAnnounced(txns []cipher.SHA256) {
+	vs.strand(func() error {
+		vs.setTxnsAnnounced(txns)
+		return nil
+	})
+}
+
+func (vs *Visor) setTxnsAnnounced(txns []cipher.SHA256) {
+	vs.stateLk.Lock()
+	defer vs.stateLk.Unlock()
+	vs.v.SetTxnsAnnounced(txns, utc.Now())
+}
+
+// Sends a signed block to all connections.
+// TODO: deprecate, should only send to clients that request by hash
+func (vs *Visor) broadcastBlock(sb coin.SignedBlock, pool Broadcaster) {
+	if vs.Config.Disabled || vs.Config.DisableNetworking {
+		return
+	}
+	m := NewGiveBlocksMessage([]coin.SignedBlock{sb})
+	pool.BroadcastMessage(m)
+}
+
+// broadcastTransaction broadcasts a single transaction to all peers.
+func (vs *Visor) broadcastTransaction(t coin.Transaction, pool *Pool) {
+	if vs.Config.Disabled || vs.Config.DisableNetworking {
+		logger.Debug("broadcast tx disabled")
+		return
+	}
+	m := NewGiveTxnsMessage(coin.Transactions{t})
+	l, err := pool.Pool.Size()
+	if err != nil {
+		logger.Error("Broadcast GivenTxnsMessage failed: %v", err)
+		return
+	}
+
+	logger.Debug("Broadcasting GiveTxnsMessage to %d conns", l)
+	pool.Pool.BroadcastMessage(m)
+}
+
+// InjectTransaction injects transaction to the unconfirmed pool and broadcasts it
+// The transaction must have a valid fee, be well-formed and not spend timelocked outputs.
+func (vs *Visor) InjectTransaction(txn coin.Transaction, pool *Pool) error {
+	return vs.strand(func() error {
+		if err := vs.injectTransaction(txn, pool); err != nil {
+			return err
+		}
+
+		vs.broadcastTransaction(txn, pool)
+		return nil
+	})
+}
+
+func (vs *Visor) injectTransaction(txn coin.Transaction, pool *Pool) error {
+	if err := vs.verifyTransaction(txn); err != nil {
+		return err
+	}
+
+	vs.stateLk.Lock()
+	defer vs.stateLk.Unlock()
+	_, err := vs.v.InjectTxn(txn)
+	return err
+}
+
+func (vs *Visor) verifyTransaction(txn coin.Transaction) error {
+	inUxs, err := vs.v.Blockchain.Unspent().GetArray(txn.In)
+	if err != nil {
+		return err
+	}
+
+	fee, err := visor.TransactionFee(&txn, vs.v.Blockchain.Time(), inUxs)
+	if err != nil {
+		return err
+	}
+
+	if err := visor.VerifyTransactionFee(&txn, fee); err != nil {
+		return err
+	}
+
+	if visor.TransactionIsLocked(inUxs) {
+		return errors.New("Transaction has locked address inputs")
+	}
+
+	if err := txn.Verify(); err != nil {
+		return fmt.Errorf("Transaction Verification Failed, %v", err)
+	}
+
+	// valid the spending coins
+	for _, out := range txn.Out {
+		if err := DropletPrecisionCheck(out.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResendTransaction resends a known UnconfirmedTxn.
+func (vs *Visor) ResendTransaction(h cipher.SHA256, pool *Pool) {
+	if vs.Config.Disabled {
+		return
+	}
+	vs.strand(func() error {
+		if ut, ok := vs.v.Unconfirmed.Get(h); ok {
+			vs.broadcastTransaction(ut.Txn, pool)
+		}
+		return nil
+	})
+}
+
+// ResendUnconfirmedTxns resents all unconfirmed transactions
+func (vs *Visor) ResendUnconfirmedTxns(pool *Pool) []cipher.SHA256 {
+	var txids []cipher.SHA256
+	if vs.Config.Disabled {
+		return txids
+	}
+	vs.strand(func() error {
+		txns := vs.v.GetAllUnconfirmedTxns()
+
+		for i := range txns {
+			logger.Debugf("Rebroadcast tx %s", txns[i].Hash().Hex())
+			vs.broadcastTransaction(txns[i].Txn, pool)
+			txids = append(txids, txns[i].Txn.Hash())
+		}
+		return nil
+	})
+	return txids
+}
+
+// CreateAndPublishBlock creates a block from unconfirmed transactions and sends it to the network.
+// Will panic if not running as a master chain.  Returns creation error and
+// whether it was published or not
+func (vs *Visor) CreateAndPublishBlock(pool Broadcaster) error {
+	if vs.Config.Disabled {
+		return errors.New("Visor disabled")
+	}
+	return vs.strand(func() error {
+		_, err := vs.createAndPublishBlock(pool)
+		return err
+	})
+}
+
+func (vs *Visor) createAndPublishBlock(pool Broadcaster) (coin.SignedBlock, error) {
+	vs.stateLk.Lock()
+	sb, err := vs.v.CreateAndExecuteBlock()
+	vs.stateLk.Unlock()
+	if err != nil {
+		return coin.SignedBlock{}, err
+	}
+	vs.broadcastBlock(sb, pool)
+	return sb, nil
+}
+
+// RemoveConnection updates internal state when a connection disconnects
+func (vs *Visor) RemoveConnection(addr string) {
+	vs.async(func() {
+		delete(vs.blockchainLengths, addr)
+		vs.stopAnnounceTimers(addr)
+		// after the announcements queued before, which record the txns
+		// they sent
+		vs.knownTxns.Remove(addr)
+	})
+}
+
+// RecordBlockchainLength saves a peer-reported blockchain length
+func (vs *Visor) RecordBlockchainLength(addr string, bkLen uint64) {
+	vs.strand(func() error {
+		vs.blockchainLengths[addr] = bkLen
+		return nil
+	})
+}
+
+// EstimateBlockchainLength returns the blockchain length estimated from peer reports
+// Deprecate. Should not need. Just report time of last block
+func (vs *Visor) EstimateBlockchainLength() uint64 {
+	var maxLen uint64
+	vs.strand(func() error {
+		ourLen := vs.v.HeadBkSeq()
+		if len(vs.blockchainLengths) < 2 {
+			maxLen = ourLen
+			return nil
+		}
+		for _, seq := range vs.blockchainLengths {
+			if maxLen < seq {
+				maxLen = seq
+			}
+		}
+		return nil
+	})
+	return maxLen
+}
+
+// HeadBkSeq returns the head sequence
+func (vs *Visor) HeadBkSeq() uint64 {
+	var seq uint64
+	vs.strand(func() error {
+		seq = vs.v.HeadBkSeq()
+		return nil
+	})
+	return seq
+}
+
+// ExecuteSignedBlock executes signed block
+func (vs *Visor) ExecuteSignedBlock(b coin.SignedBlock) error {
+	return vs.strand(func() error {
+		return vs.executeSignedBlock(b)
+	})
+}
+
+func (vs *Visor) executeSignedBlock(b coin.SignedBlock) error {
+	vs.stateLk.Lock()
+	defer vs.stateLk.Unlock()
+	return vs.v.ExecuteSignedBlock(b)
+}
+
+// GetSignedBlocksSince returns numbers of signed blocks since seq.
+func (vs *Visor) GetSignedBlocksSince(seq uint64, num uint64) (sbs []coin.SignedBlock, err error) {
+	err = vs.strand(func() error {
+		var err error
+		sbs, err = vs.v.GetSignedBlocksSince(seq, num)
+		return err
+	})
+	return
+}
+
+// UnConfirmFilterKnown returns all unknow transaction hashes
+func (vs *Visor) UnConfirmFilterKnown(txns []cipher.SHA256) []cipher.SHA256 {
+	var ts []cipher.SHA256
+	vs.strand(func() error {
+		ts = vs.v.Unconfirmed.FilterKnown(txns)
+		return nil
+	})
+	return ts
+}
+
+// UnConfirmKnow returns all know tansactions
+func (vs *Visor) UnConfirmKnow(hashes []cipher.SHA256) (txns coin.Transactions) {
+	vs.strand(func() error {
+		txns = vs.v.Unconfirmed.GetKnown(hashes)
+		return nil
+	})
+	return
+}
+
+// InjectTxn only try to append transaction into local blockchain, don't broadcast it.
+func (vs *Visor) InjectTxn(tx coin.Transaction) (know bool, err error) {
+	err = vs.strand(func() error {
+		var err error
+		know, err = vs.injectTxn(tx)
+		return err
+	})
+	return
+}
+
+func (vs *Visor) injectTxn(tx coin.Transaction) (bool, error) {
+	vs.stateLk.Lock()
+	defer vs.stateLk.Unlock()
+	return vs.v.InjectTxn(tx)
+}
+
+// Communication layer for the coin pkg
+
+// GetBlocksMessage sent to request blocks since LastBlock
+type GetBlocksMessage struct {
+	LastBlock       uint64
+	RequestedBlocks uint64
+	c               *gnet.MessageContext `enc:"-"`
+}
+
+// NewGetBlocksMessage creates GetBlocksMessage
+func NewGetBlocksMessage(lastBlock uint64, requestedBlocks uint64) *GetBlocksMessage {
+	return &GetBlocksMessage{
+		LastBlock:       lastBlock,
+		RequestedBlocks: requestedBlocks, //count of blocks requested
+	}
+}
+
+// Handle handles message
+func (gbm *GetBlocksMessage) Handle(mc *gnet.MessageContext,
+	daemon interface{}) error {
+	gbm.c = mc
+	return daemon.(*Daemon).recordMessageEvent(gbm, mc)
+}
+
+// Process should send number to be requested, with request
+func (gbm *GetBlocksMessage) Process(d *Daemon) {
+	// TODO -- we need the sig to be sent with the block, but only the master
+	// can sign blocks.  Thus the sig needs to be stored with the block.
+	// TODO -- move 20 to either Messages.Config or Visor.Config
+	if d.Visor.Config.Disabled {
+		return
+	}
+	d.Visor.async(func() {
+		vs := d.Visor
+		// Record this as this peer's highest block
+		vs.blockchainLengths[gbm.c.Addr] = gbm.LastBlock
+		// Fetch and return signed blocks since LastBlock, no more than the
+		// requester can decode
+		n := gbm.RequestedBlocks
+		if n > maxGiveBlocks {
+			n = maxGiveBlocks
+		}
+		blocks, err := vs.v.GetSignedBlocksSince(gbm.LastBlock, n)
+		if err != nil {
+			logger.Info("Get signed blocks failed: %v", err)
+			return
+		}
+
+		logger.Debug("Got %d blocks since %d", len(blocks), gbm.LastBlock)
+		if len(blocks) == 0 {
+			return
+		}
+		m := NewGiveBlocksMessage(blocks)
+		d.Pool.Pool.SendMessage(gbm.c.Addr, m)
+	})
+}
+
+// GiveBlocksMessage sent in response to GetBlocksMessage, or unsolicited
+type GiveBlocksMessage struct {
+	Blocks []coin.SignedBlock   `enc:",maxlen=256"`
+	c      *gnet.MessageContext `enc:"-"`
+}
+
+// NewGiveBlocksMessage creates GiveBlocksMessage
+func NewGiveBlocksMessage(blocks []coin.SignedBlock) *GiveBlocksMessage {
+	return &GiveBlocksMessage{
+		Blocks: blocks,
+	}
+}
+
+// Handle handle message
+func (gbm *GiveBlocksMessage) Handle(mc *gnet.MessageContext,
+	daemon interface{}) error {
+	gbm.c = mc
+	return daemon.(*Daemon).recordMessageEvent(gbm, mc)
+}
+
+// Process process message
+func (gbm *GiveBlocksMessage) Process(d *Daemon) {
+	if d.Visor.Config.Disabled {
+		logger.Critical("Visor disabled, ignoring GiveBlocksMessage")
+		return
+	}
+	d.Visor.async(func() {
+		gbm.process(d)
+	})
+}
+
+func (gbm *GiveBlocksMessage) process(d *Daemon) {
+	vs := d.Visor
+	processed := 0
+	maxSeq := vs.v.HeadBkSeq()
+	for _, b := range gbm.Blocks {
+		// To minimize waste when receiving multiple responses from peers
+		// we only break out of the loop if the block itself is invalid.
+		// E.g. if we request 20 blocks since 0 from 2 peers, and one peer
+		// replies with 15 and the other 20, if we did not do this check and
+		// the reply with 15 was received first, we would toss the one with 20
+		// even though we could process it at the time.
+		if b.Seq() <= maxSeq {
+			continue
+		}
+
+		err := vs.executeSignedBlock(b)
+		if err == nil {
+			logger.Critical("Added new block %d", b.Block.Head.BkSeq,
+				logging.F("seq", b.Block.Head.BkSeq), logging.F("peer", gbm.c.Addr))
+			processed++
+		} else {
+			logger.Critical("Failed to execute received block: %v", err,
+				logging.F("seq", b.Block.Head.BkSeq), logging.F("peer", gbm.c.Addr))
+			// Blocks must be received in order, so if one fails its assumed
+			// the rest are failing
+			break
+		}
+	}
+	if processed == 0 {
+		return
+	}
+
+	headBkSeq := vs.v.HeadBkSeq()
+	// Announce our new blocks to peers
+	m1 := NewAnnounceBlocksMessage(headBkSeq)
+	d.Pool.Pool.BroadcastMessage(m1)
+	//request more blocks.
+	m2 := NewGetBlocksMessage(headBkSeq, vs.Config.BlocksResponseCount)
+	d.Pool.Pool.BroadcastMessage(m2)
+}
+
+// AnnounceBlocksMessage tells a peer our highest known BkSeq. The receiving peer can choose
+// to send GetBlocksMessage in response
+type AnnounceBlocksMessage struct {
+	MaxBkSeq uint64
+	c        *gnet.MessageContext `enc:"-"`
+}
+
+// NewAnnounceBlocksMessage creates message
+func NewAnnounceBlocksMessage(seq uint64) *AnnounceBlocksMessage {
+	return &AnnounceBlocksMessage{
+		MaxBkSeq: seq,
+	}
+}
+
+// Handle handles message
+func (abm *AnnounceBlocksMessage) Handle(mc *gnet.MessageContext,
+	daemon interface{}) error {
+	abm.c = mc
+	return daemon.(*Daemon).recordMessageEvent(abm, mc)
+}
+
+// Process process message
+func (abm *AnnounceBlocksMessage) Process(d *Daemon) {
+	if d.Visor.Config.Disabled {
+		return
+	}
+	d.Visor.async(func() {
+		headBkSeq := d.Visor.v.HeadBkSeq()
+		if headBkSeq >= abm.MaxBkSeq {
+			return
+		}
+		//should this be block get request for current sequence?
+		//if client is not caught up, wont attempt to get block
+		m := NewGetBlocksMessage(headBkSeq, d.Visor.Config.BlocksResponseCount)
+		d.Pool.Pool.SendMessage(abm.c.Addr, m)
+	})
+}
+
+// SendingTxnsMessage send transaction message interface
+type SendingTxnsMessage interface {
+	GetTxns() []cipher.SHA256
+}
+
+// AnnounceTxnsMessage tells a peer that we have these transactions
+type AnnounceTxnsMessage struct {
+	Txns []cipher.SHA256      `enc:",maxlen=256"`
+	c    *gnet.MessageContext `enc:"-"`
+}
+
+// NewAnnounceTxnsMessage creates announce txns message
+func NewAnnounceTxnsMessage(txns []cipher.SHA256) *AnnounceTxnsMessage {
+	return &AnnounceTxnsMessage{
+		Txns: txns,
+	}
+}
+
+// GetTxns returns txns
+func (atm *AnnounceTxnsMessage) GetTxns() []cipher.SHA256 {
+	return atm.Txns
+}
+
+// Handle handle message
+func (atm *AnnounceTxnsMessage) Handle(mc *gnet.MessageContext,
+	daemon interface{}) error {
+	atm.c = mc
+	return daemon.(*Daemon).recordMessageEvent(atm, mc)
+}
+
+// Process process message
+func (atm *AnnounceTxnsMessage) Process(d *Daemon) {
+	if d.Visor.Config.Disabled {
+		return
+	}
+	// The peer has the transactions it announces
+	d.Visor.SetTxnsKnown(atm.c.Addr, atm.Txns)
+
+	d.Visor.async(func() {
+		unknown := d.Visor.v.Unconfirmed.FilterKnown(atm.Txns)
+		if len(unknown) == 0 {
+			return
+		}
+		m := NewGetTxnsMessage(unknown)
+		d.Pool.Pool.SendMessage(atm.c.Addr, m)
+	})
+}
+
+// GetTxnsMessage request transactions of given hash
+type GetTxnsMessage struct {
+	Txns []cipher.SHA256      `enc:",maxlen=256"`
+	c    *gnet.MessageContext `enc:"-"`
+}
+
+// NewGetTxnsMessage creates GetTxnsMessage
+func NewGetTxnsMessage(txns []cipher.SHA256) *GetTxnsMessage {
+	return &GetTxnsMessage{
+		Txns: txns,
+	}
+}
+
+// Handle handle message
+func (gtm *GetTxnsMessage) Handle(mc *gnet.MessageContext,
+	daemon interface{}) error {
+	gtm.c = mc
+	return daemon.(*Daemon).recordMessageEvent(gtm, mc)
+}
+
+// Process process message
+func (gtm *GetTxnsMessage) Process(d *Daemon) {
+	if d.Visor.Config.Disabled {
+		return
+	}
+	// Don't send the txns that the peer already has
+	missing := d.Visor.knownTxns.FilterHas(gtm.c.Addr, gtm.Txns)
+	if len(missing) == 0 {
+		return
+	}
+
+	d.Visor.async(func() {
+		// Locate all txns from the unconfirmed pool
+		// reply to sender with GiveTxnsMessage
+		known := d.Visor.v.Unconfirmed.GetKnown(missing)
+		if len(known) == 0 {
+			return
+		}
+		logger.Debug("%d/%d txns known", len(known), len(gtm.Txns))
+		m := NewGiveTxnsMessage(known)
+		if err := d.Pool.Pool.SendMessage(gtm.c.Addr, m); err != nil {
+			logger.Debug("Send GiveTxnsMessage to %s failed: %v", gtm.c.Addr, err)
+			return
+		}
+		d.Visor.SetTxnsKnown(gtm.c.Addr, known.Hashes())
+	})
+}
+
+// GiveTxnsMessage tells the transaction of given hashes
+type GiveTxnsMessage struct {
+	Txns coin.Transactions    `enc:",maxlen=256"`
+	c    *gnet.MessageContext `enc:"-"`
+}
+
+// NewGiveTxnsMessage creates GiveTxnsMessage
+func NewGiveTxnsMessage(txns coin.Transactions) *GiveTxnsMessage {
+	return &GiveTxnsMessage{
+		Txns: txns,
+	}
+}
+
+// GetTxns returns transactions hashes
+func (gtm *GiveTxnsMessage) GetTxns() []cipher.SHA256 {
+	return gtm.Txns.Hashes()
+}
+
+// Handle handle message
+func (gtm *GiveTxnsMessage) Handle(mc *gnet.MessageContext,
+	daemon interface{}) error {
+	gtm.c = mc
+	return daemon.(*Daemon).recordMessageEvent(gtm, mc)
+}
+
+// Process process message
+func (gtm *GiveTxnsMessage) Process(d *Daemon) {
+	if d.Visor.Config.Disabled {
+		return
+	}
+	if len(gtm.Txns) > 32 {
+		logger.Warning("More than 32 transactions in pool. Implement breaking transactions transmission into multiple packets")
+	}
+
+	// The peer has the transactions it sends
+	d.Visor.SetTxnsKnown(gtm.c.Addr, gtm.Txns.Hashes())
+
+	d.Visor.async(func() {
+		gtm.process(d)
+	})
+}
+
+func (gtm *GiveTxnsMessage) process(d *Daemon) {
+	hashes := make([]cipher.SHA256, 0, len(gtm.Txns))
+	// Update unconfirmed pool with these transactions
+	for _, txn := range gtm.Txns {
+		// Only announce transactions that are new to us, so that peers can't
+		// spam relays
+		known, err := d.Visor.injectTxn(txn)
+		if err != nil {
+			logger.Warning("Failed to record transaction %s: %v", txn.Hash().Hex(), err,
+				logging.F("txid", txn.Hash().Hex()), logging.F("peer", gtm.c.Addr))
+			continue
+		}
+
+		if known {
+			logger.Warning("Duplicate Transaction: %s", txn.Hash().Hex(),
+				logging.F("txid", txn.Hash().Hex()), logging.F("peer", gtm.c.Addr))
+		} else {
+			hashes = append(hashes, txn.Hash())
+		}
+	}
+	// Announce these transactions to peers
+	if len(hashes) != 0 {
+		logger.Debugf("Announce %d transactions", len(hashes))
+		d.Visor.announceTxns(d.Pool.Pool, hashes)
+	}
+}
+
+// BlockchainLengths an array of uint64
+type BlockchainLengths []uint64
+
+// Len for sorting
+func (bcl BlockchainLengths) Len() int {
+	return len(bcl)
+}
+
+// Swap for sorting
+func (bcl BlockchainLengths) Swap(i, j int) {
+	bcl[i], bcl[j] = bcl[j], bcl[i]
+}
+
+// Less for sorting
+func (bcl BlockchainLengths) Less(i, j int) bool {
+	return bcl[i] < bcl[j]
+}
+
+type byTxnRecvTime []visor.UnconfirmedTxn
+
+func (txs byTxnRecvTime) Len() int {
+	return len(txs)
+}
+
+func (txs byTxnRecvTime) Swap(i, j int) {
+	txs[i], txs[j] = txs[j], txs[i]
+}
+
+func (txs byTxnRecvTime) Less(i, j int) bool {
+	return txs[i].Received < txs[j].Received
+}
@@ -2,13 +2,20 @@ package daemon
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/boltdb/bolt"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skycoin/skycoin/src/cipher"
 	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
 	"github.com/skycoin/skycoin/src/testutil"
 	"github.com/skycoin/skycoin/src/visor"
 )
@@ -419,3 +426,291 @@ func TestSplitHashes(t *testing.T) {
 		})
 	}
 }
+
+// recordingBroadcaster records the messages broadcast by the visor
+type recordingBroadcaster struct {
+	sync.Mutex
+	msgs []gnet.Message
+}
+
+func (rb *recordingBroadcaster) BroadcastMessage(msg gnet.Message) error {
+	rb.Lock()
+	defer rb.Unlock()
+	rb.msgs = append(rb.msgs, msg)
+	return nil
+}
+
+func (rb *recordingBroadcaster) SendMessage(addr string, msg gnet.Message) error {
+	return nil
+}
+
+func (rb *recordingBroadcaster) GetConnections() ([]gnet.Connection, error) {
+	return nil, nil
+}
+
+func (rb *recordingBroadcaster) messages() []gnet.Message {
+	rb.Lock()
+	defer rb.Unlock()
+	return append([]gnet.Message(nil), rb.msgs...)
+}
+
+// setupMasterVisor creates a master Visor on a temporary database whose
+// tickers don't fire during the test
+func setupMasterVisor(t *testing.T) (*Visor, func()) {
+	dir, err := ioutil.TempDir("", "visor")
+	require.NoError(t, err)
+
+	c := NewVisorConfig()
+	c.BlocksRequestRate = time.Hour
+	c.BlocksAnnounceRate = time.Hour
+	c.TxnsAnnounceRate = time.Hour
+	c.Config.IsMaster = true
+	c.Config.BlockCreationInterval = 3600
+	c.Config.UnconfirmedRefreshRate = time.Hour
+	c.Config.BlockchainPubkey = GenesisPublic
+	c.Config.BlockchainSeckey = GenesisSecret
+	c.Config.GenesisAddress = GenesisAddress
+	c.Config.GenesisCoinVolume = GenesisCoins
+	c.Config.GenesisTimestamp = GenesisTime
+	c.Config.DBPath = filepath.Join(dir, "data.db")
+	c.Config.WalletDirectory = filepath.Join(dir, "wallets")
+
+	vs, err := NewVisor(c)
+	require.NoError(t, err)
+
+	return vs, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestVisorCreateBlockNow(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+
+	pool := &recordingBroadcaster{}
+	errC := vs.Start(pool)
+
+	// wait for the genesis block
+	for i := 0; i < 100; i++ {
+		var uxs coin.UxArray
+		vs.view(func() {
+			uxs, _ = vs.v.Blockchain.Unspent().GetAll()
+		})
+		if len(uxs) != 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// no unconfirmed txns
+	require.Error(t, vs.CreateBlockNow())
+
+	_, _, addr := MakeAddress()
+	txn := createGenesisSpendTransaction(t, vs.v.Blockchain, addr, GenesisCoins, 0, 0)
+	_, err := vs.InjectTxn(txn)
+	require.NoError(t, err)
+
+	require.NoError(t, vs.CreateBlockNow())
+	require.Equal(t, uint64(1), vs.HeadBkSeq())
+
+	msgs := pool.messages()
+	require.Len(t, msgs, 1)
+	gbm, ok := msgs[0].(*GiveBlocksMessage)
+	require.True(t, ok)
+	require.Len(t, gbm.Blocks, 1)
+	require.Equal(t, txn, gbm.Blocks[0].Block.Body.Transactions[0])
+
+	vs.Shutdown()
+	select {
+	case err := <-errC:
+		require.NoError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("the visor loop did not return after Shutdown")
+	}
+
+	require.Error(t, vs.CreateBlockNow())
+}
+
+func TestVisorCreateBlockNowNotMaster(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+	vs.Config.Config.IsMaster = false
+
+	vs.Start(&recordingBroadcaster{})
+	defer vs.Shutdown()
+
+	testutil.RequireError(t, vs.CreateBlockNow(), "Only master chain can create blocks")
+}
+
+func TestVisorRequestsAfterShutdown(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+
+	errC := vs.Start(&recordingBroadcaster{})
+	require.Equal(t, uint64(0), vs.HeadBkSeq())
+
+	vs.Shutdown()
+	select {
+	case err := <-errC:
+		require.NoError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("the visor loop did not return after Shutdown")
+	}
+
+	// the requests made once the Run loop stopped don't block, nor run
+	done := make(chan error, 1)
+	go func() {
+		done <- vs.strand(func() error {
+			t.Error("request ran after Shutdown")
+			return nil
+		})
+	}()
+	select {
+	case err := <-done:
+		require.Equal(t, ErrVisorClosed, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("request blocked after Shutdown")
+	}
+
+	_, err := vs.InjectTxn(coin.Transaction{})
+	require.Equal(t, ErrVisorClosed, err)
+	require.Equal(t, ErrVisorClosed, vs.ExecuteSignedBlock(coin.SignedBlock{}))
+}
+
+func TestVisorZeroRates(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+
+	// a rate that isn't positive disables its task instead of panicking
+	vs.Config.BlocksRequestRate = 0
+	vs.Config.BlocksAnnounceRate = 0
+	vs.Config.TxnsAnnounceRate = -time.Second
+	vs.Config.Config.UnconfirmedRefreshRate = 0
+	vs.Config.Config.BlockCreationInterval = 0
+
+	errC := vs.Start(&recordingBroadcaster{})
+	require.Equal(t, uint64(0), vs.HeadBkSeq())
+
+	vs.Shutdown()
+	select {
+	case err := <-errC:
+		require.NoError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("the visor loop did not return after Shutdown")
+	}
+}
+
+func TestGiveTxnsMessageDoesntWaitForVisor(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+	vs.Start(&recordingBroadcaster{})
+	defer vs.Shutdown()
+
+	// keep the Run loop busy, like a slow block creation
+	release := make(chan struct{})
+	busy := make(chan struct{})
+	go vs.strand(func() error {
+		close(busy)
+		<-release
+		return nil
+	})
+	<-busy
+
+	m := NewGiveTxnsMessage(coin.Transactions{coin.Transaction{}})
+	m.c = &gnet.MessageContext{Addr: "1.2.3.4:6000"}
+	done := make(chan struct{})
+	go func() {
+		m.Process(&Daemon{Visor: vs})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("GiveTxnsMessage.Process waited for the visor loop")
+	}
+	close(release)
+}
+
+// addrConn is a net.Conn that only has a remote address
+type addrConn struct {
+	net.Conn
+	addr net.Addr
+}
+
+func (c addrConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// connsBroadcaster has connections to addrs and records the messages sent to
+// each
+type connsBroadcaster struct {
+	recordingBroadcaster
+	addrs []*net.TCPAddr
+	sent  map[string][]gnet.Message
+}
+
+func (cb *connsBroadcaster) SendMessage(addr string, msg gnet.Message) error {
+	cb.Lock()
+	defer cb.Unlock()
+	cb.sent[addr] = append(cb.sent[addr], msg)
+	return nil
+}
+
+func (cb *connsBroadcaster) GetConnections() ([]gnet.Connection, error) {
+	conns := make([]gnet.Connection, len(cb.addrs))
+	for i, a := range cb.addrs {
+		conns[i].Conn = addrConn{addr: a}
+	}
+	return conns, nil
+}
+
+func (cb *connsBroadcaster) sentTo(addr string) int {
+	cb.Lock()
+	defer cb.Unlock()
+	return len(cb.sent[addr])
+}
+
+func TestAnnounceTxnsToClosedConnection(t *testing.T) {
+	vs, cleanup := setupMasterVisor(t)
+	defer cleanup()
+	vs.Config.TxnsAnnounceMaxDelay = 100 * time.Millisecond
+
+	closed := &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 6000}
+	open := &net.TCPAddr{IP: net.IPv4(5, 6, 7, 8), Port: 6000}
+	pool := &connsBroadcaster{
+		addrs: []*net.TCPAddr{closed, open},
+		sent:  make(map[string][]gnet.Message),
+	}
+	errC := vs.Start(pool)
+
+	hashes := []cipher.SHA256{cipher.SumSHA256([]byte("txn"))}
+	require.NoError(t, vs.strand(func() error {
+		vs.announceTxns(pool, hashes)
+		return nil
+	}))
+	vs.RemoveConnection(closed.String())
+
+	time.Sleep(300 * time.Millisecond)
+	var pending int
+	require.NoError(t, vs.strand(func() error {
+		pending = len(vs.announceTimers)
+		return nil
+	}))
+	require.Equal(t, 0, pending)
+	require.Equal(t, 0, pool.sentTo(closed.String()))
+	require.Equal(t, 0, vs.knownTxns.Len(closed.String()))
+	require.Equal(t, 1, pool.sentTo(open.String()))
+
+	// the announcements pending at shutdown are dropped
+	vs.Config.TxnsAnnounceMaxDelay = time.Hour
+	require.NoError(t, vs.strand(func() error {
+		vs.announceTxns(pool, []cipher.SHA256{cipher.SumSHA256([]byte("txn2"))})
+		pending = len(vs.announceTimers)
+		return nil
+	}))
+	require.Equal(t, 2, pending)
+	vs.Shutdown()
+	require.NoError(t, <-errC)
+	require.Empty(t, vs.announceTimers)
+}
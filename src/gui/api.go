@@ -0,0 +1,512 @@
+package gui
+
+// Routes of the REST API, served under /api/v1 and at their legacy paths
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+	"github.com/skycoin/skycoin/src/wallet"
+
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+)
+
+// apiV1Prefix is the path prefix of the version 1 API routes
+const apiV1Prefix = "/api/v1"
+
+// maxJSONBodySize limits the size of the JSON request bodies
+const maxJSONBodySize = 1 << 20
+
+// apiParam is a parameter of an API route
+type apiParam struct {
+	name     string
+	typ      string // string, integer or boolean
+	required bool
+	desc     string
+}
+
+// apiRoute describes an API route.  The routes are registered at their
+// legacy path, which is deprecated, and under /api/v1 at their v1 path.
+type apiRoute struct {
+	// path under /api/v1, the route is not served under /api/v1 if empty
+	v1 string
+	// scope a key must be granted to call the route
+	scope   auth.Scope
+	methods []string
+	summary string
+	// query are the URL parameters, body the fields of the POST body
+	query []apiParam
+	body  []apiParam
+	// response is a value of the type of the response body
+	response interface{}
+	// contentType of the response if it's not JSON
+	contentType string
+}
+
+var (
+	get     = []string{http.MethodGet}
+	post    = []string{http.MethodPost}
+	getPost = []string{http.MethodGet, http.MethodPost}
+
+	addrsParam   = apiParam{"addrs", "string", true, "Comma separated addresses"}
+	txidParam    = apiParam{"txid", "string", true, "Transaction id"}
+	addressParam = apiParam{"address", "string", true, "Address"}
+	walletParam  = apiParam{"id", "string", true, "Wallet id, the wallet file name"}
+)
+
+// apiRoutes are the API routes, by legacy path
+var apiRoutes = map[string]apiRoute{
+	"/csrf": {
+		v1: "/csrf", scope: auth.ScopePublic, methods: get,
+		summary:  "Returns a CSRF token, to be sent in the X-CSRF-Token header of POST requests",
+		response: CSRFResponse{},
+	},
+
+	"/version": {
+		v1: "/version", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the version of the node",
+		response: visor.BuildInfo{},
+	},
+	"/outputs": {
+		v1: "/outputs", scope: auth.ScopeRead, methods: get,
+		summary: "Returns the unspent outputs of addresses or with hashes, all of them if neither is set",
+		query: []apiParam{
+			{"addrs", "string", false, "Comma separated addresses"},
+			{"hashes", "string", false, "Comma separated output hashes"},
+		},
+		response: visor.ReadableOutputSet{},
+	},
+	"/balance": {
+		v1: "/balance", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the confirmed and predicted balance of addresses",
+		query:    []apiParam{addrsParam},
+		response: wallet.BalancePair{},
+	},
+	"/address_pending": {
+		v1: "/address_pending", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the outputs of addresses spent and received by the unconfirmed transactions, and the transactions",
+		query:    []apiParam{addrsParam},
+		response: visor.PendingTxns{},
+	},
+	"/events": {
+		v1: "/events", scope: auth.ScopeRead, methods: get,
+		summary: "Streams blocks, unconfirmed transactions, confirmations and address activity as server-sent events",
+		query: []apiParam{
+			{"blocks", "boolean", false, "Send block events"},
+			{"txns", "boolean", false, "Send unconfirmed transaction events"},
+			{"txids", "string", false, "Comma separated transaction ids whose confirmation is sent"},
+			{"addrs", "string", false, "Comma separated addresses whose activity is sent"},
+			{"since", "integer", false, "Block seq to resume from"},
+		},
+		contentType: "text/event-stream",
+	},
+
+	"/blockchain/metadata": {
+		v1: "/blockchain/metadata", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the head block and the unspent output and unconfirmed transaction counts",
+		response: visor.BlockchainMetadata{},
+	},
+	"/blockchain/progress": {
+		v1: "/blockchain/progress", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the sync progress of the blockchain",
+		response: daemon.BlockchainProgress{},
+	},
+	"/block": {
+		v1: "/block", scope: auth.ScopeRead, methods: get,
+		summary: "Returns a block by hash or seq, one of them must be set",
+		query: []apiParam{
+			{"hash", "string", false, "Block hash"},
+			{"seq", "integer", false, "Block seq"},
+		},
+		response: visor.ReadableBlock{},
+	},
+	"/blocks": {
+		v1: "/blocks", scope: auth.ScopeRead, methods: get,
+		summary: "Returns the blocks in a range of seqs",
+		query: []apiParam{
+			{"start", "integer", true, "Seq of the first block"},
+			{"end", "integer", true, "Seq of the last block"},
+		},
+		response: visor.ReadableBlocks{},
+	},
+	"/last_blocks": {
+		v1: "/last_blocks", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the last blocks",
+		query:    []apiParam{{"num", "integer", true, "Number of blocks"}},
+		response: visor.ReadableBlocks{},
+	},
+
+	"/explorer/address": {
+		v1: "/explorer/address", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the transactions of an address",
+		query:    []apiParam{addressParam},
+		response: []ReadableTransaction{},
+	},
+	// deprecated, replaced by /coinSupply
+	"/explorer/getEffectiveOutputs": {
+		scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the coin supply",
+		response: DeprecatedCoinSupply{},
+	},
+	"/coinSupply": {
+		v1: "/coin_supply", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the coin supply",
+		response: CoinSupply{},
+	},
+
+	"/network/connection": {
+		v1: "/network/connection", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns a connection by address",
+		query:    []apiParam{{"addr", "string", true, "Address of the peer, ip:port"}},
+		response: daemon.Connection{},
+	},
+	"/network/connections": {
+		v1: "/network/connections", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the connections",
+		response: daemon.Connections{},
+	},
+	"/network/defaultConnections": {
+		v1: "/network/default_connections", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the default peers",
+		response: []string{},
+	},
+	"/network/connections/trust": {
+		v1: "/network/connections/trust", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the trusted peers",
+		response: []string{},
+	},
+	"/network/connections/exchange": {
+		v1: "/network/connections/exchange", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the peers that can be exchanged with other peers",
+		response: []string{},
+	},
+	"/network/compression": {
+		v1: "/network/compression", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the compression stats of sent messages, by message type",
+		response: map[string]gnet.CompressionStat{},
+	},
+
+	"/pendingTxs": {
+		v1: "/pending_txs", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the unconfirmed transactions",
+		response: []visor.ReadableUnconfirmedTxn{},
+	},
+	// deprecated, the last transactions are not kept across restarts
+	"/lastTxs": {
+		scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the last confirmed transactions",
+		response: []visor.TransactionResult{},
+	},
+	"/transaction": {
+		v1: "/transaction", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns a transaction by id",
+		query:    []apiParam{txidParam},
+		response: visor.TransactionResult{},
+	},
+	"/rawtx": {
+		v1: "/rawtx", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns a transaction by id, hex encoded",
+		query:    []apiParam{txidParam},
+		response: "",
+	},
+	"/injectTransaction": {
+		v1: "/inject_transaction", scope: auth.ScopeWalletSpend, methods: post,
+		summary:  "Broadcasts a transaction and returns its id",
+		body:     []apiParam{{"rawtx", "string", true, "Hex encoded transaction"}},
+		response: "",
+	},
+	"/resendUnconfirmedTxns": {
+		v1: "/resend_unconfirmed_txns", scope: auth.ScopeAdmin, methods: post,
+		summary:  "Announces the unconfirmed transactions to the peers again",
+		response: daemon.ResendResult{},
+	},
+
+	"/uxout": {
+		v1: "/uxout", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns an output by id",
+		query:    []apiParam{{"uxid", "string", true, "Output id"}},
+		response: historydb.UxOutJSON{},
+	},
+	"/address_uxouts": {
+		v1: "/address_uxouts", scope: auth.ScopeRead, methods: get,
+		summary:  "Returns the outputs an address received, spent or not",
+		query:    []apiParam{addressParam},
+		response: []historydb.UxOutJSON{},
+	},
+
+	"/wallet": {
+		v1: "/wallet", scope: auth.ScopeWalletRead, methods: get,
+		summary:  "Returns a wallet",
+		query:    []apiParam{walletParam},
+		response: wallet.Wallet{},
+	},
+	"/wallet/balance": {
+		v1: "/wallet/balance", scope: auth.ScopeWalletRead, methods: get,
+		summary:  "Returns the confirmed and predicted balance of a wallet",
+		query:    []apiParam{walletParam},
+		response: wallet.BalancePair{},
+	},
+	"/wallet/transactions": {
+		v1: "/wallet/transactions", scope: auth.ScopeWalletRead, methods: get,
+		summary:  "Returns the unconfirmed transactions of a wallet",
+		query:    []apiParam{walletParam},
+		response: []visor.UnconfirmedTxnResult{},
+	},
+	"/wallets": {
+		v1: "/wallets", scope: auth.ScopeWalletRead, methods: get,
+		summary:  "Returns the loaded wallets",
+		response: []wallet.ReadableWallet{},
+	},
+	"/wallets/folderName": {
+		v1: "/wallets/folder_name", scope: auth.ScopeWalletRead, methods: get,
+		summary:  "Returns the wallet directory",
+		response: WalletFolder{},
+	},
+	"/wallet/create": {
+		v1: "/wallet/create", scope: auth.ScopeWalletSpend, methods: post,
+		summary: "Creates a wallet",
+		body: []apiParam{
+			{"seed", "string", true, "Wallet seed"},
+			{"label", "string", true, "Wallet label"},
+		},
+		response: wallet.ReadableWallet{},
+	},
+	"/wallet/newAddress": {
+		v1: "/wallet/new_address", scope: auth.ScopeWalletSpend, methods: post,
+		summary: "Creates addresses in a wallet",
+		body: []apiParam{
+			walletParam,
+			{"num", "integer", false, "Number of addresses, 1 by default"},
+		},
+		response: NewAddressesResponse{},
+	},
+	"/wallet/newSeed": {
+		v1: "/wallet/new_seed", scope: auth.ScopeWalletSpend, methods: get,
+		summary:  "Returns a new random seed",
+		response: NewSeedResponse{},
+	},
+	"/wallet/update": {
+		v1: "/wallet/update", scope: auth.ScopeWalletSpend, methods: post,
+		summary: "Changes the label of a wallet",
+		body: []apiParam{
+			walletParam,
+			{"label", "string", true, "Wallet label"},
+		},
+		response: "",
+	},
+	"/wallet/spend": {
+		v1: "/wallet/spend", scope: auth.ScopeWalletSpend, methods: post,
+		summary: "Sends coins from a wallet to an address",
+		body: []apiParam{
+			walletParam,
+			{"dst", "string", true, "Destination address"},
+			{"coins", "integer", true, "Droplets to send"},
+		},
+		response: SpendResult{},
+	},
+	"/wallets/reload": {
+		v1: "/wallets/reload", scope: auth.ScopeAdmin, methods: post,
+		summary:  "Loads and unloads the wallets of the wallet directory",
+		response: "",
+	},
+
+	"/logging/levels": {
+		v1: "/logging/levels", scope: auth.ScopeAdmin, methods: getPost,
+		summary: "Returns the log levels of the modules, or sets the level of a module",
+		body: []apiParam{
+			{"module", "string", false, "Module, the modules without their own level if empty"},
+			{"level", "string", true, "Log level"},
+		},
+		response: map[string]string{},
+	},
+}
+
+// ErrorResponse is the body of the error responses of the /api/v1 routes
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody describes an error
+type ErrorBody struct {
+	// Code is the HTTP status code
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// jsonResponseWriter writes the error responses of a handler as JSON error
+// objects.  The handlers write their errors as plain text with the status
+// code in front, e.g. "400 Bad Request - missing wallet id".
+type jsonResponseWriter struct {
+	http.ResponseWriter
+	path   string
+	status int
+	// err is the text of the error response
+	err bytes.Buffer
+}
+
+func (jw *jsonResponseWriter) WriteHeader(status int) {
+	if jw.status != 0 {
+		return
+	}
+	jw.status = status
+
+	if status >= http.StatusBadRequest {
+		// written by finish
+		return
+	}
+
+	if jw.Header().Get("Content-Type") == "" {
+		jw.Header().Set("Content-Type", "application/json")
+	}
+	jw.ResponseWriter.WriteHeader(status)
+}
+
+func (jw *jsonResponseWriter) Write(b []byte) (int, error) {
+	if jw.status == 0 {
+		jw.WriteHeader(http.StatusOK)
+	}
+
+	if jw.status >= http.StatusBadRequest {
+		return jw.err.Write(b)
+	}
+	return jw.ResponseWriter.Write(b)
+}
+
+// Flush flushes the response, the event streams need it
+func (jw *jsonResponseWriter) Flush() {
+	if jw.status == 0 {
+		jw.WriteHeader(http.StatusOK)
+	}
+
+	if f, ok := jw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish writes the error response, if the handler wrote one
+func (jw *jsonResponseWriter) finish() {
+	switch {
+	case jw.status == 0:
+		// the handler wrote nothing
+		logger.Error("Empty response to %s", jw.path)
+		jw.status = http.StatusInternalServerError
+		fmt.Fprint(&jw.err, http.StatusText(http.StatusInternalServerError))
+	case jw.status < http.StatusBadRequest:
+		return
+	}
+
+	msg := strings.TrimSpace(jw.err.String())
+	msg = strings.TrimPrefix(msg, strconv.Itoa(jw.status)+" ")
+	if msg == "" {
+		msg = http.StatusText(jw.status)
+	}
+
+	writeError(jw.ResponseWriter, jw.status, msg)
+}
+
+// writeError writes an ErrorResponse
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Del("Content-Length")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(ErrorResponse{Error: ErrorBody{Code: status, Message: msg}}); err != nil {
+		logger.Error("Write error response failed: %v", err)
+	}
+}
+
+// jsonAPI serves the /api/v1 routes: JSON request bodies are read as the
+// form values of the request, and errors are written as ErrorResponse
+func jsonAPI(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jw := &jsonResponseWriter{ResponseWriter: w, path: r.URL.Path}
+		defer jw.finish()
+
+		if err := parseJSONBody(r); err != nil {
+			wh.Error400(jw, err.Error())
+			return
+		}
+
+		h.ServeHTTP(jw, r)
+	})
+}
+
+// parseJSONBody adds the fields of a JSON object body to the form values of
+// r, so that the handlers read them with r.FormValue.  The body fields come
+// before the URL parameters of the same name.  The body can still be read.
+func parseJSONBody(r *http.Request) error {
+	if r.Body == nil {
+		return nil
+	}
+
+	mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mt != "application/json" {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxJSONBodySize))
+	r.Body.Close()
+	if err != nil {
+		return fmt.Errorf("read body failed: %v", err)
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	fields := map[string]interface{}{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&fields); err != nil {
+		return fmt.Errorf("invalid JSON body: %v", err)
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+
+	for k, v := range fields {
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case json.Number:
+			s = v.String()
+		case bool:
+			s = strconv.FormatBool(v)
+		case nil:
+			continue
+		default:
+			return fmt.Errorf("invalid JSON body: %s is not a string, number or boolean", k)
+		}
+
+		r.PostForm.Set(k, s)
+		r.Form[k] = append([]string{s}, r.Form[k]...)
+	}
+
+	return nil
+}
+
+// deprecated marks the responses of a legacy route as deprecated, with a
+// link to the v1 route if it exists
+func deprecated(v1 string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+		if v1 != "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, apiV1Prefix, v1))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// apiNotFound answers the /api/v1 paths that aren't routes
+func apiNotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+}
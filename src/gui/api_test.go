@@ -0,0 +1,214 @@
+package gui
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/testutil/harness"
+
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+)
+
+func TestJSONAPI(t *testing.T) {
+	cases := []struct {
+		name    string
+		handler http.HandlerFunc
+		status  int
+		body    string
+	}{
+		{
+			name: "error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				wh.Error400(w, "missing wallet id")
+			},
+			status: http.StatusBadRequest,
+			body:   `{"error":{"code":400,"message":"Bad Request - missing wallet id"}}`,
+		},
+		{
+			name: "not found",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				wh.Error404(w)
+			},
+			status: http.StatusNotFound,
+			body:   `{"error":{"code":404,"message":"Not Found"}}`,
+		},
+		{
+			name:    "no response",
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+			status:  http.StatusInternalServerError,
+			body:    `{"error":{"code":500,"message":"Internal Server Error"}}`,
+		},
+		{
+			name: "ok",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				wh.SendOr404(w, map[string]int{"seq": 3})
+			},
+			status: http.StatusOK,
+			body:   `{"seq":3}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			jsonAPI(tc.handler).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/block", nil))
+			require.Equal(t, tc.status, w.Code)
+			require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			require.JSONEq(t, tc.body, w.Body.String())
+		})
+	}
+}
+
+func TestParseJSONBody(t *testing.T) {
+	var form map[string][]string
+	var body string
+	h := jsonAPI(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.FormValue("id")
+		form = r.Form
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(b)
+		wh.SendOr404(w, id)
+	}))
+
+	send := func(contentType, b string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/wallet/spend?id=query&dst=addr", strings.NewReader(b))
+		r.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	b := `{"id": "body", "coins": 1000000, "confirmed": true, "label": null}`
+	w := send("application/json; charset=utf-8", b)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `"body"`, w.Body.String())
+	require.Equal(t, map[string][]string{
+		"id":        {"body", "query"},
+		"dst":       {"addr"},
+		"coins":     {"1000000"},
+		"confirmed": {"true"},
+	}, form)
+	// the body can still be read
+	require.Equal(t, b, body)
+
+	w = send("application/x-www-form-urlencoded", "id=form")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `"form"`, w.Body.String())
+
+	for _, b := range []string{`{"id":`, `["id"]`, `{"id": {"name": "x"}}`, `{"ids": ["x"]}`} {
+		w = send("application/json", b)
+		require.Equal(t, http.StatusBadRequest, w.Code, b)
+		var rsp ErrorResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&rsp))
+		require.Equal(t, http.StatusBadRequest, rsp.Error.Code)
+		require.Contains(t, rsp.Error.Message, "invalid JSON body")
+	}
+}
+
+func TestAPIRoutes(t *testing.T) {
+	c := harness.New(t, 1, nil)
+	defer c.Close()
+
+	dir, err := ioutil.TempDir("", "gui")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, indexPage), []byte("index"), 0600))
+
+	mux := NewGUIMux(dir, c.Master().Daemon.Gateway, MuxConfig{DisableCSRF: true})
+	defer mux.Close()
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	// the document has the paths and methods of the v1 routes
+	w := serve(http.MethodGet, "/api/v1/openapi.json")
+	require.Equal(t, http.StatusOK, w.Code)
+	var doc struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&doc))
+	require.Equal(t, "3.0.0", doc.OpenAPI)
+
+	documented := map[string][]string{}
+	for path, ops := range doc.Paths {
+		for method := range ops {
+			documented[path] = append(documented[path], strings.ToUpper(method))
+		}
+		sort.Strings(documented[path])
+	}
+
+	routes := map[string][]string{}
+	for _, route := range apiRoutes {
+		if route.v1 != "" {
+			routes[route.v1] = route.methods
+		}
+	}
+	require.Equal(t, routes, documented)
+
+	// the handlers accept the documented methods
+	for path, route := range apiRoutes {
+		allowed := map[string]bool{}
+		for _, method := range route.methods {
+			allowed[method] = true
+		}
+
+		for _, method := range []string{http.MethodGet, http.MethodPost} {
+			if allowed[method] && route.contentType != "" {
+				// streams don't complete
+				continue
+			}
+
+			paths := []string{path}
+			if route.v1 != "" {
+				paths = append(paths, apiV1Prefix+route.v1)
+			}
+
+			for _, p := range paths {
+				w := serve(method, p)
+				if allowed[method] {
+					require.NotEqual(t, http.StatusMethodNotAllowed, w.Code, "%s %s", method, p)
+				} else {
+					require.Equal(t, http.StatusMethodNotAllowed, w.Code, "%s %s", method, p)
+				}
+			}
+		}
+	}
+
+	// v1 errors are JSON, legacy errors are text
+	w = serve(http.MethodGet, "/api/v1/block")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.JSONEq(t, `{"error":{"code":400,"message":"Bad Request - should specify one filter, hash or seq"}}`, w.Body.String())
+	require.Empty(t, w.Header().Get("Deprecation"))
+
+	w = serve(http.MethodGet, "/block")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, "400 Bad Request - should specify one filter, hash or seq\n", w.Body.String())
+	require.Equal(t, "true", w.Header().Get("Deprecation"))
+	require.Equal(t, `</api/v1/block>; rel="successor-version"`, w.Header().Get("Link"))
+
+	w = serve(http.MethodGet, "/lastTxs")
+	require.Equal(t, "true", w.Header().Get("Deprecation"))
+	require.Empty(t, w.Header().Get("Link"))
+
+	w = serve(http.MethodGet, "/api/v1/wallet?id=missing.wlt")
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"error":{"code":404,"message":"Not Found - wallet missing.wlt doesn't exist"}}`, w.Body.String())
+
+	w = serve(http.MethodGet, "/api/v1/lastTxs")
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"error":{"code":404,"message":"Not Found"}}`, w.Body.String())
+}
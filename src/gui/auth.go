@@ -0,0 +1,71 @@
+package gui
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+)
+
+// Mux registers the handlers of routes, it is implemented by http.ServeMux
+type Mux interface {
+	Handle(pattern string, handler http.Handler)
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+}
+
+// scopedMux registers the handlers of the API routes behind the Host, Origin
+// and CSRF checks and the scope of their route.  A route is registered at its
+// legacy path and under /api/v1.  Every route must be in apiRoutes.
+type scopedMux struct {
+	mux  *http.ServeMux
+	c    MuxConfig
+	csrf *csrfTokens
+}
+
+func (sm scopedMux) Handle(pattern string, handler http.Handler) {
+	route, ok := apiRoutes[pattern]
+	if !ok {
+		panic(fmt.Sprintf("route %s is not in apiRoutes", pattern))
+	}
+
+	sm.mux.Handle(pattern, deprecated(route.v1, sm.check(pattern, route.scope, handler)))
+	if route.v1 != "" {
+		v1 := apiV1Prefix + route.v1
+		sm.mux.Handle(v1, jsonAPI(sm.check(v1, route.scope, handler)))
+	}
+}
+
+// check puts h behind the checks of the route
+func (sm scopedMux) check(route string, scope auth.Scope, h http.Handler) http.Handler {
+	h = requireCSRF(sm.csrf, sm.c.Keys, h)
+	h = requireScope(sm.c.Keys, route, scope, h)
+	h = requireOrigin(sm.c.CORSOrigins, h)
+	return requireHost(sm.c.Hosts, h)
+}
+
+func (sm scopedMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	sm.Handle(pattern, http.HandlerFunc(handler))
+}
+
+// requireScope serves the requests authenticated with a key granted scope.
+// Every request is served if keys is nil.
+func requireScope(keys *auth.Keys, route string, scope auth.Scope, h http.Handler) http.Handler {
+	if keys == nil {
+		return h
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch _, err := keys.Authorize(r, route, scope); err {
+		case nil:
+			h.ServeHTTP(w, r)
+		case auth.ErrForbidden:
+			wh.HTTPError(w, http.StatusForbidden, "Forbidden")
+		default:
+			// browsers prompt for the key name and secret
+			w.Header().Set("WWW-Authenticate", `Basic realm="shellcoin"`)
+			wh.HTTPError(w, http.StatusUnauthorized, "Unauthorized")
+		}
+	})
+}
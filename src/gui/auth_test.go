@@ -0,0 +1,80 @@
+package gui
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+	"github.com/skycoin/skycoin/src/testutil/harness"
+)
+
+func TestNewGUIMuxAuth(t *testing.T) {
+	c := harness.New(t, 1, nil)
+	defer c.Close()
+
+	dir, err := ioutil.TempDir("", "gui")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, indexPage), []byte("index"), 0600))
+
+	keys, err := auth.NewKeys([]auth.Key{
+		{Name: "reader", Secret: "reader-secret-0123", Scopes: []auth.Scope{auth.ScopeRead}},
+		{Name: "admin", Secret: "admin-secret-01234", Scopes: []auth.Scope{auth.ScopeAdmin}},
+	})
+	require.NoError(t, err)
+
+	mux := NewGUIMux(dir, c.Master().Daemon.Gateway, MuxConfig{Keys: keys})
+	defer mux.Close()
+
+	// every route is registered
+	for path, route := range apiRoutes {
+		_, pattern := mux.Handler(httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, path, pattern)
+
+		if route.v1 != "" {
+			_, pattern := mux.Handler(httptest.NewRequest(http.MethodGet, apiV1Prefix+route.v1, nil))
+			require.Equal(t, apiV1Prefix+route.v1, pattern)
+		}
+	}
+
+	cases := []struct {
+		name   string
+		path   string
+		user   string
+		secret string
+		status int
+	}{
+		{"index is public", "/", "", "", http.StatusOK},
+		{"no credentials", "/version", "", "", http.StatusUnauthorized},
+		{"wrong secret", "/version", "reader", "admin-secret-01234", http.StatusUnauthorized},
+		{"read", "/version", "reader", "reader-secret-0123", http.StatusOK},
+		{"forbidden", "/wallets", "reader", "reader-secret-0123", http.StatusForbidden},
+		{"admin", "/wallets", "admin", "admin-secret-01234", http.StatusOK},
+		{"v1 no credentials", "/api/v1/version", "", "", http.StatusUnauthorized},
+		{"v1 forbidden", "/api/v1/wallets", "reader", "reader-secret-0123", http.StatusForbidden},
+		{"v1 read", "/api/v1/version", "reader", "reader-secret-0123", http.StatusOK},
+		{"openapi is public", "/api/v1/openapi.json", "", "", http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.user != "" {
+				r.SetBasicAuth(tc.user, tc.secret)
+			}
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			require.Equal(t, tc.status, w.Code)
+			if tc.status == http.StatusUnauthorized {
+				require.Equal(t, `Basic realm="shellcoin"`, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
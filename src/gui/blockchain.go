@@ -11,14 +11,12 @@ import (
 	"github.com/skycoin/skycoin/src/coin"
 	wh "github.com/skycoin/skycoin/src/util/http"
 	"github.com/skycoin/skycoin/src/visor" //http,json helpers
-
-	"github.com/skycoin/skycoin/src/daemon"
 )
 
 const lastBlockNum = 10
 
 // RegisterBlockchainHandlers registers blockchain handlers
-func RegisterBlockchainHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
+func RegisterBlockchainHandlers(mux Mux, gateway Gatewayer) {
 	mux.HandleFunc("/blockchain/metadata", blockchainHandler(gateway))
 	mux.HandleFunc("/blockchain/progress", blockchainProgressHandler(gateway))
 
@@ -32,14 +30,24 @@ func RegisterBlockchainHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
 	mux.HandleFunc("/last_blocks", getLastBlocks(gateway))
 }
 
-func blockchainHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func blockchainHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wh.SendOr404(w, gateway.GetBlockchainMetadata())
 	}
 }
 
-func blockchainProgressHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func blockchainProgressHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wh.SendOr404(w, gateway.GetBlockchainProgress())
 	}
 }
@@ -48,7 +56,7 @@ func blockchainProgressHandler(gateway *daemon.Gateway) http.HandlerFunc {
 // method: GET
 // url: /block?hash=[:hash]  or /block?seq[:seq]
 // params: hash or seq, should only specify one filter.
-func getBlock(gate *daemon.Gateway) http.HandlerFunc {
+func getBlock(gate Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -100,7 +108,7 @@ func getBlock(gate *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func getBlocks(gateway *daemon.Gateway) http.HandlerFunc {
+func getBlocks(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -129,7 +137,7 @@ func getBlocks(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // get last N blocks
-func getLastBlocks(gateway *daemon.Gateway) http.HandlerFunc {
+func getLastBlocks(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -0,0 +1,177 @@
+package gui
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+func TestBlockchainHandlers(t *testing.T) {
+	b := coin.SignedBlock{
+		Block: coin.Block{
+			Head: coin.BlockHeader{BkSeq: 3, Time: 1500000000},
+			Body: coin.BlockBody{Transactions: coin.Transactions{makeTransaction(t)}},
+		},
+	}
+	rb, err := visor.NewReadableBlock(&b.Block)
+	require.NoError(t, err)
+	blocks := &visor.ReadableBlocks{Blocks: []visor.ReadableBlock{*rb}}
+	hash := b.HashHeader()
+
+	_, errHash := cipher.SHA256FromHex("abc")
+	metadata := map[string]uint64{"unspents": 2}
+
+	testHandlers(t, []handlerCase{
+		{
+			name: "metadata",
+			path: "/api/v1/blockchain/metadata",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlockchainMetadata").Return(metadata)
+			},
+			status: http.StatusOK,
+			rsp:    metadata,
+		},
+		{
+			name: "metadata not found",
+			path: "/api/v1/blockchain/metadata",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlockchainMetadata").Return(nil)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name:   "metadata method",
+			method: http.MethodPost,
+			path:   "/api/v1/blockchain/metadata",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name: "progress",
+			path: "/api/v1/blockchain/progress",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlockchainProgress").Return(metadata)
+			},
+			status: http.StatusOK,
+			rsp:    metadata,
+		},
+		{
+			name:   "block without filter",
+			path:   "/api/v1/block",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - should specify one filter, hash or seq",
+		},
+		{
+			name:   "block with both filters",
+			path:   "/api/v1/block?seq=3&hash=" + hash.Hex(),
+			status: http.StatusBadRequest,
+			err:    "Bad Request - should only specify one filter, hash or seq",
+		},
+		{
+			name:   "block invalid hash",
+			path:   "/api/v1/block?hash=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - " + errHash.Error(),
+		},
+		{
+			name:   "block invalid seq",
+			path:   "/api/v1/block?seq=x",
+			status: http.StatusBadRequest,
+			err:    `Bad Request - strconv.ParseUint: parsing "x": invalid syntax`,
+		},
+		{
+			name: "block by hash",
+			path: "/api/v1/block?hash=" + hash.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlockByHash", hash).Return(b, true)
+			},
+			status: http.StatusOK,
+			rsp:    rb,
+		},
+		{
+			name: "block by seq",
+			path: "/api/v1/block?seq=3",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlockBySeq", uint64(3)).Return(b, true)
+			},
+			status: http.StatusOK,
+			rsp:    rb,
+		},
+		{
+			name: "block not found",
+			path: "/api/v1/block?seq=4",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlockBySeq", uint64(4)).Return(coin.SignedBlock{}, false)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name:   "blocks invalid start",
+			path:   "/api/v1/blocks?start=x&end=3",
+			status: http.StatusBadRequest,
+			err:    `Bad Request - Invalid start value "x"`,
+		},
+		{
+			name:   "blocks invalid end",
+			path:   "/api/v1/blocks?start=3",
+			status: http.StatusBadRequest,
+			err:    `Bad Request - Invalid end value ""`,
+		},
+		{
+			name: "blocks error",
+			path: "/api/v1/blocks?start=3&end=1",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlocks", uint64(3), uint64(1)).Return(nil, errors.New("invalid range"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - Get blocks failed: invalid range",
+		},
+		{
+			name: "blocks",
+			path: "/api/v1/blocks?start=3&end=3",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBlocks", uint64(3), uint64(3)).Return(blocks, nil)
+			},
+			status: http.StatusOK,
+			rsp:    blocks,
+		},
+		{
+			name:   "last blocks without num",
+			path:   "/api/v1/last_blocks",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - Param: num is empty",
+		},
+		{
+			name:   "last blocks invalid num",
+			path:   "/api/v1/last_blocks?num=-1",
+			status: http.StatusBadRequest,
+			err:    `Bad Request - strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		{
+			name: "last blocks error",
+			path: "/api/v1/last_blocks?num=2",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetLastBlocks", uint64(2)).Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - Get last 2 blocks failed: db closed",
+		},
+		{
+			name: "last blocks",
+			path: "/api/v1/last_blocks?num=1",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetLastBlocks", uint64(1)).Return(blocks, nil)
+			},
+			status: http.StatusOK,
+			rsp:    blocks,
+		},
+	})
+}
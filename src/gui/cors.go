@@ -0,0 +1,103 @@
+package gui
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+)
+
+const corsMaxAge = 600 // seconds
+
+// loopbackHosts returns the Host header values of the loopback address addr,
+// written as ip:port.  Returns nil if addr is not a loopback address, its
+// host names are not known.
+func loopbackHosts(addr string) []string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil
+	}
+
+	if host != "localhost" {
+		ip := net.ParseIP(host)
+		if ip == nil || !ip.IsLoopback() {
+			return nil
+		}
+	}
+
+	return []string{
+		net.JoinHostPort("localhost", port),
+		net.JoinHostPort("127.0.0.1", port),
+		net.JoinHostPort("::1", port),
+	}
+}
+
+// requireHost serves the requests whose Host header is one of hosts, so
+// that the APIs can't be reached through another name resolving to the node,
+// e.g. by DNS rebinding.  Every request is served if hosts is empty.
+func requireHost(hosts []string, h http.Handler) http.Handler {
+	if len(hosts) == 0 {
+		return h
+	}
+
+	allowed := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		allowed[strings.ToLower(host)] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowed[strings.ToLower(r.Host)] {
+			logger.Warning("Rejected request for %s with Host %s from %s", r.URL.Path, r.Host, r.RemoteAddr)
+			wh.HTTPError(w, http.StatusForbidden, "Forbidden - invalid Host")
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+// requireOrigin serves the requests without Origin header, the requests from
+// pages of the node and the requests from the CORS origins.  A trusted origin
+// is answered with the CORS headers, and its preflight requests are answered
+// without calling h.
+func requireOrigin(corsOrigins []string, h http.Handler) http.Handler {
+	trusted := make(map[string]bool, len(corsOrigins))
+	for _, o := range corsOrigins {
+		trusted[strings.ToLower(strings.TrimSuffix(o, "/"))] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if trusted[strings.ToLower(origin)] {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST")
+				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, "+csrfHeader)
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(corsMaxAge))
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		logger.Warning("Rejected request for %s with Origin %s from %s", r.URL.Path, origin, r.RemoteAddr)
+		wh.HTTPError(w, http.StatusForbidden, "Forbidden - invalid Origin")
+	})
+}
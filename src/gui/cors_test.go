@@ -0,0 +1,82 @@
+package gui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoopbackHosts(t *testing.T) {
+	hosts := []string{"localhost:7520", "127.0.0.1:7520", "[::1]:7520"}
+	require.Equal(t, hosts, loopbackHosts("127.0.0.1:7520"))
+	require.Equal(t, hosts, loopbackHosts("localhost:7520"))
+	require.Equal(t, hosts, loopbackHosts("[::1]:7520"))
+	require.Nil(t, loopbackHosts("0.0.0.0:7520"))
+	require.Nil(t, loopbackHosts("10.0.0.1:7520"))
+	require.Nil(t, loopbackHosts(":7520"))
+
+	c := MuxConfig{Hosts: []string{"node.lan:7520"}}
+	require.Equal(t, append([]string{"127.0.0.1:7520"}, append(hosts, "node.lan:7520")...), c.withHost("127.0.0.1:7520").Hosts)
+	require.Equal(t, []string{"0.0.0.0:7520", "node.lan:7520"}, c.withHost("0.0.0.0:7520").Hosts)
+	// the Host check isn't disabled on a public address
+	require.Equal(t, []string{"192.168.1.10:7520"}, MuxConfig{}.withHost("192.168.1.10:7520").Hosts)
+}
+
+func TestRequireHostAndOrigin(t *testing.T) {
+	mux := http.NewServeMux()
+	api := scopedMux{
+		mux: mux,
+		c: MuxConfig{
+			Hosts:       []string{"127.0.0.1:7520", "localhost:7520"},
+			CORSOrigins: []string{"https://dashboard.example.com/"},
+		},
+	}
+	api.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {})
+
+	cases := []struct {
+		name   string
+		method string
+		host   string
+		header map[string]string
+		status int
+		cors   bool
+	}{
+		{"allowed host", http.MethodGet, "127.0.0.1:7520", nil, http.StatusOK, false},
+		{"host is case insensitive", http.MethodGet, "LOCALHOST:7520", nil, http.StatusOK, false},
+		{"rebound host", http.MethodGet, "attacker.example.com:7520", nil, http.StatusForbidden, false},
+		{"same origin", http.MethodGet, "127.0.0.1:7520",
+			map[string]string{"Origin": "http://127.0.0.1:7520"}, http.StatusOK, false},
+		{"other origin", http.MethodGet, "127.0.0.1:7520",
+			map[string]string{"Origin": "http://attacker.example.com"}, http.StatusForbidden, false},
+		{"cors origin", http.MethodGet, "127.0.0.1:7520",
+			map[string]string{"Origin": "https://dashboard.example.com"}, http.StatusOK, true},
+		{"cors preflight", http.MethodOptions, "127.0.0.1:7520",
+			map[string]string{"Origin": "https://dashboard.example.com", "Access-Control-Request-Method": "POST"},
+			http.StatusNoContent, true},
+		{"preflight of other origin", http.MethodOptions, "127.0.0.1:7520",
+			map[string]string{"Origin": "http://attacker.example.com", "Access-Control-Request-Method": "POST"},
+			http.StatusForbidden, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, "/version", nil)
+			r.Host = tc.host
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			require.Equal(t, tc.status, w.Code)
+
+			if tc.cors {
+				require.Equal(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+			} else {
+				require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package gui
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+	"github.com/skycoin/skycoin/src/cipher"
+
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+)
+
+const (
+	// csrfHeader is the header the CSRF token is sent in
+	csrfHeader = "X-CSRF-Token"
+	// csrfTokenMaxAge is how long a CSRF token is valid
+	csrfTokenMaxAge = 30 * time.Minute
+)
+
+var (
+	errCSRFTokenInvalid = errors.New("invalid CSRF token")
+	errCSRFTokenExpired = errors.New("expired CSRF token")
+)
+
+// csrfTokens creates and verifies CSRF tokens.  A token is its expiry time
+// signed with a key that is created at startup, so tokens don't outlive the
+// process.
+type csrfTokens struct {
+	key []byte
+}
+
+func newCSRFTokens() *csrfTokens {
+	return &csrfTokens{key: cipher.RandByte(32)}
+}
+
+// create returns a token that expires csrfTokenMaxAge after now
+func (ct *csrfTokens) create(now time.Time) string {
+	b := make([]byte, 8, 8+sha256.Size)
+	binary.BigEndian.PutUint64(b, uint64(now.Add(csrfTokenMaxAge).Unix()))
+	return base64.RawURLEncoding.EncodeToString(append(b, ct.sign(b)...))
+}
+
+// verify checks that token was created by ct and has not expired
+func (ct *csrfTokens) verify(token string, now time.Time) error {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != 8+sha256.Size {
+		return errCSRFTokenInvalid
+	}
+
+	if !hmac.Equal(b[8:], ct.sign(b[:8])) {
+		return errCSRFTokenInvalid
+	}
+
+	if now.Unix() > int64(binary.BigEndian.Uint64(b[:8])) {
+		return errCSRFTokenExpired
+	}
+
+	return nil
+}
+
+func (ct *csrfTokens) sign(b []byte) []byte {
+	mac := hmac.New(sha256.New, ct.key)
+	mac.Write(b)
+	return mac.Sum(nil)
+}
+
+// CSRFResponse is the response of /csrf
+type CSRFResponse struct {
+	Token string `json:"csrf_token"`
+}
+
+// Returns a CSRF token, to be sent in the X-CSRF-Token header of the requests
+// that change the state of the node
+// URI: /csrf
+// Method: GET
+func csrfHandler(ct *csrfTokens) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
+		if ct == nil {
+			wh.Error404(w)
+			return
+		}
+
+		wh.SendOr404(w, CSRFResponse{Token: ct.create(time.Now())})
+	}
+}
+
+// requireCSRF serves the requests with an unsafe method only if they carry a
+// valid CSRF token.  Requests authenticated with a bearer token of keys can't
+// be forged by a web page, they don't need a CSRF token.  Every request is
+// served if ct is nil.
+func requireCSRF(ct *csrfTokens, keys *auth.Keys, h http.Handler) http.Handler {
+	if ct == nil {
+		return h
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if keys != nil && strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			if k, err := keys.Authenticate(r); err == nil && k != nil {
+				h.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if err := ct.verify(r.Header.Get(csrfHeader), time.Now()); err != nil {
+			wh.HTTPError(w, http.StatusForbidden, "Forbidden - "+err.Error())
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
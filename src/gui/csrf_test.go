@@ -0,0 +1,106 @@
+package gui
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+)
+
+func TestCSRFTokens(t *testing.T) {
+	ct := newCSRFTokens()
+	now := time.Now()
+
+	token := ct.create(now)
+	require.NoError(t, ct.verify(token, now))
+	require.NoError(t, ct.verify(token, now.Add(csrfTokenMaxAge)))
+	require.Equal(t, errCSRFTokenExpired, ct.verify(token, now.Add(csrfTokenMaxAge+time.Second)))
+
+	// tokens of another process are invalid
+	require.Equal(t, errCSRFTokenInvalid, newCSRFTokens().verify(token, now))
+
+	for _, bad := range []string{"", "abc", token[:len(token)-1], token + "A"} {
+		require.Equal(t, errCSRFTokenInvalid, ct.verify(bad, now), bad)
+	}
+}
+
+func TestRequireCSRF(t *testing.T) {
+	mux := http.NewServeMux()
+	api := scopedMux{mux: mux, csrf: newCSRFTokens()}
+	api.HandleFunc("/csrf", csrfHandler(api.csrf))
+	api.HandleFunc("/wallet/spend", func(w http.ResponseWriter, r *http.Request) {})
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/csrf", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var rsp CSRFResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&rsp))
+
+	cases := []struct {
+		name   string
+		method string
+		header map[string]string
+		status int
+	}{
+		{"get", http.MethodGet, nil, http.StatusOK},
+		{"post without token", http.MethodPost, nil, http.StatusForbidden},
+		{"post with invalid token", http.MethodPost, map[string]string{csrfHeader: "abc"}, http.StatusForbidden},
+		{"post with token", http.MethodPost, map[string]string{csrfHeader: rsp.Token}, http.StatusOK},
+		// without keys a bearer token authenticates nothing
+		{"post with bearer token", http.MethodPost, map[string]string{"Authorization": "Bearer secret"}, http.StatusForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(tc.method, "/wallet/spend", nil)
+			for k, v := range tc.header {
+				r.Header.Set(k, v)
+			}
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			require.Equal(t, tc.status, w.Code)
+		})
+	}
+
+	// requests authenticated with a bearer token don't need a CSRF token
+	keys, err := auth.NewKeys([]auth.Key{
+		{Name: "bot", Secret: "0123456789abcdef", Scopes: []auth.Scope{auth.ScopeWalletSpend}},
+	})
+	require.NoError(t, err)
+	mux = http.NewServeMux()
+	api = scopedMux{mux: mux, c: MuxConfig{Keys: keys}, csrf: newCSRFTokens()}
+	api.HandleFunc("/wallet/spend", func(w http.ResponseWriter, r *http.Request) {})
+
+	r := httptest.NewRequest(http.MethodPost, "/wallet/spend", nil)
+	r.Header.Set("Authorization", "Bearer 0123456789abcdef")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// browsers send basic auth by themselves
+	r = httptest.NewRequest(http.MethodPost, "/wallet/spend", nil)
+	r.SetBasicAuth("bot", "0123456789abcdef")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	// the check can be disabled
+	mux = http.NewServeMux()
+	api = scopedMux{mux: mux}
+	api.HandleFunc("/csrf", csrfHandler(api.csrf))
+	api.HandleFunc("/wallet/spend", func(w http.ResponseWriter, r *http.Request) {})
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/wallet/spend", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/csrf", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
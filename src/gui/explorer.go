@@ -11,7 +11,7 @@ import (
 )
 
 // RegisterExplorerHandlers register explorer handlers
-func RegisterExplorerHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
+func RegisterExplorerHandlers(mux Mux, gateway Gatewayer) {
 	// get set of pending transactions
 	mux.HandleFunc("/explorer/address", getTransactionsForAddress(gateway))
 
@@ -43,7 +43,7 @@ type CoinSupply struct {
 	LockedAddresses []string `json:"locked_distribution_addresses"`
 }
 
-func getCoinSupply(gateway *daemon.Gateway) http.HandlerFunc {
+func getCoinSupply(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		supply, _ := coinSupply(gateway, w, r)
 		if supply != nil {
@@ -53,7 +53,7 @@ func getCoinSupply(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // TODO: DEPRECATED Remove for v21 release
-func getEffectiveOutputs(gateway *daemon.Gateway) http.HandlerFunc {
+func getEffectiveOutputs(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		_, oldSupply := coinSupply(gateway, w, r)
 		if oldSupply != nil {
@@ -62,7 +62,7 @@ func getEffectiveOutputs(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func coinSupply(gateway *daemon.Gateway, w http.ResponseWriter, r *http.Request) (*CoinSupply, *DeprecatedCoinSupply) {
+func coinSupply(gateway Gatewayer, w http.ResponseWriter, r *http.Request) (*CoinSupply, *DeprecatedCoinSupply) {
 	if r.Method != http.MethodGet {
 		wh.Error405(w)
 		return nil, nil
@@ -137,7 +137,7 @@ func coinSupply(gateway *daemon.Gateway, w http.ResponseWriter, r *http.Request)
 
 // method: GET
 // url: /explorer/address?address=${address}
-func getTransactionsForAddress(gateway *daemon.Gateway) http.HandlerFunc {
+func getTransactionsForAddress(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
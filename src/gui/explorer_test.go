@@ -0,0 +1,165 @@
+package gui
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/util/droplet"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+)
+
+func TestExplorerHandlers(t *testing.T) {
+	// the first unlocked distribution address spent its coins
+	unlocked := visor.GetUnlockedDistributionAddresses()
+	balance, err := droplet.ToString(visor.DistributionAddressInitialBalance * droplet.Multiplier)
+	require.NoError(t, err)
+
+	var outputs visor.ReadableOutputSet
+	for _, addr := range unlocked[1:] {
+		outputs.HeadOutputs = append(outputs.HeadOutputs, visor.ReadableOutput{Address: addr, Coins: balance})
+	}
+
+	toString := func(coins uint64) string {
+		s, err := droplet.ToString(coins * droplet.Multiplier)
+		require.NoError(t, err)
+		return s
+	}
+	supply := CoinSupply{
+		CurrentSupply:     balance,
+		TotalSupply:       toString(uint64(len(unlocked)) * visor.DistributionAddressInitialBalance),
+		MaxSupply:         toString(visor.MaxCoinSupply),
+		UnlockedAddresses: unlocked,
+		LockedAddresses:   visor.GetLockedDistributionAddresses(),
+	}
+	oldSupply := DeprecatedCoinSupply{
+		CoinSupply:                                        supply,
+		DeprecatedCurrentSupply:                           visor.DistributionAddressInitialBalance * droplet.Multiplier,
+		DeprecatedCoinCap:                                 visor.MaxCoinSupply,
+		DeprecatedUndistributedLockedCoinBalance:          uint64(len(unlocked)-1) * visor.DistributionAddressInitialBalance * droplet.Multiplier,
+		DeprecatedUndistributedLockedCoinHoldingAddresses: visor.GetDistributionAddresses(),
+	}
+
+	unlockedFilter := mock.MatchedBy(func(filters []daemon.OutputsFilter) bool {
+		return len(filters) == 1
+	})
+
+	// the transactions of an address, with the owner of their input
+	txn := makeTransaction(t)
+	tx := &visor.Transaction{Txn: txn, Status: visor.NewConfirmedTransactionStatus(1, 2)}
+	rtx, err := visor.NewReadableTransaction(tx)
+	require.NoError(t, err)
+	txns := &visor.TransactionResults{
+		Txns: []visor.TransactionResult{{Transaction: *rtx, Status: tx.Status, Time: 1500000000}},
+	}
+
+	addr := testutil.MakeAddress()
+	owner := testutil.MakeAddress()
+	ux := &historydb.UxOut{Out: coin.UxOut{Body: coin.UxBody{Address: owner, Coins: 1e6}}}
+	inputs := []visor.ReadableTransactionInput{visor.NewReadableTransactionInput(txn.In[0].Hex(), owner.String())}
+
+	testHandlers(t, []handlerCase{
+		{
+			name: "coin supply",
+			path: "/api/v1/coin_supply",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", unlockedFilter).Return(outputs, nil)
+			},
+			status: http.StatusOK,
+			rsp:    supply,
+		},
+		{
+			name: "coin supply error",
+			path: "/api/v1/coin_supply",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", unlockedFilter).Return(visor.ReadableOutputSet{}, errors.New("db closed"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name: "coin supply invalid balance",
+			path: "/api/v1/coin_supply",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", unlockedFilter).Return(visor.ReadableOutputSet{
+					HeadOutputs: []visor.ReadableOutput{{Address: unlocked[0], Coins: "x"}},
+				}, nil)
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name:   "coin supply method",
+			method: http.MethodPost,
+			path:   "/api/v1/coin_supply",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name: "effective outputs",
+			path: "/explorer/getEffectiveOutputs",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", unlockedFilter).Return(outputs, nil)
+			},
+			status: http.StatusOK,
+			rsp:    oldSupply,
+		},
+		{
+			name:   "address transactions without address",
+			path:   "/api/v1/explorer/address",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - address is empty",
+		},
+		{
+			name:   "address transactions invalid address",
+			path:   "/api/v1/explorer/address?address=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - invalid address",
+		},
+		{
+			name: "address transactions error",
+			path: "/api/v1/explorer/address?address=" + addr.String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressTxns", addr).Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name: "address transactions missing input",
+			path: "/api/v1/explorer/address?address=" + addr.String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressTxns", addr).Return(txns, nil)
+				gateway.On("GetUxOutByID", txn.In[0]).Return(nil, nil)
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name: "address transactions",
+			path: "/api/v1/explorer/address?address=" + addr.String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressTxns", addr).Return(txns, nil)
+				gateway.On("GetUxOutByID", txn.In[0]).Return(ux, nil)
+			},
+			status: http.StatusOK,
+			rsp:    []ReadableTransaction{NewReadableTransaction(txns.Txns[0], inputs)},
+		},
+		{
+			name: "address without transactions",
+			path: "/api/v1/explorer/address?address=" + addr.String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressTxns", addr).Return(&visor.TransactionResults{}, nil)
+			},
+			status: http.StatusOK,
+			rsp:    []ReadableTransaction{},
+		},
+	})
+}
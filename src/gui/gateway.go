@@ -0,0 +1,62 @@
+package gui
+
+import (
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+//go:generate goautomock -template=testify Gatewayer
+
+// Gatewayer is the node the web interface serves, it's implemented by
+// *daemon.Gateway
+type Gatewayer interface {
+	// blockchain
+	GetBlockchainMetadata() interface{}
+	GetBlockchainProgress() interface{}
+	GetParsedHeight() int64
+	GetBlockByHash(hash cipher.SHA256) (coin.SignedBlock, bool)
+	GetBlockBySeq(seq uint64) (coin.SignedBlock, bool)
+	GetBlocks(start, end uint64) (*visor.ReadableBlocks, error)
+	GetLastBlocks(num uint64) (*visor.ReadableBlocks, error)
+	GetBuildInfo() visor.BuildInfo
+	BindBlockListener(ls visor.BlockListener) func()
+
+	// transactions and outputs
+	GetTransaction(txid cipher.SHA256) (*visor.Transaction, error)
+	GetLastTxs() ([]*visor.Transaction, error)
+	GetAllUnconfirmedTxns() []visor.UnconfirmedTxn
+	GetAddressTxns(a cipher.Address) (*visor.TransactionResults, error)
+	InjectTransaction(txn coin.Transaction) error
+	ResendUnconfirmedTxns() (*daemon.ResendResult, error)
+	BindTxnListener(ls visor.TxnListener) func()
+	GetUnspentOutputs(filters ...daemon.OutputsFilter) (visor.ReadableOutputSet, error)
+	GetUxOutByID(id cipher.SHA256) (*historydb.UxOut, error)
+	GetAddrUxOuts(addr cipher.Address) ([]*historydb.UxOutJSON, error)
+	GetAddressesBalance(addrs []cipher.Address) (wallet.BalancePair, error)
+	GetAddressesPending(addrs []cipher.Address) (*visor.PendingTxns, error)
+
+	// network
+	GetConnection(addr string) interface{}
+	GetConnections() interface{}
+	GetDefaultConnections() interface{}
+	GetTrustConnections() interface{}
+	GetExchgConnection() interface{}
+	GetCompressionStats() map[string]gnet.CompressionStat
+
+	// wallets
+	GetWallet(wltID string) (wallet.Wallet, bool)
+	GetWallets() wallet.Wallets
+	GetWalletDir() string
+	GetWalletBalance(wltID string) (wallet.BalancePair, error)
+	GetWalletUnconfirmedTxns(wltID string) ([]visor.UnconfirmedTxnResult, error)
+	NewWallet(wltName string, options ...wallet.Option) (wallet.Wallet, error)
+	NewAddresses(wltID string, n int) ([]cipher.Address, error)
+	UpdateWalletLabel(wltID, label string) error
+	Spend(wltID string, amt wallet.Balance, dest cipher.Address) (*coin.Transaction, error)
+	ReloadWallets() error
+}
@@ -0,0 +1,102 @@
+package gui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+)
+
+var _ Gatewayer = &daemon.Gateway{}
+
+// handlerCase is a request to the web interface of a gateway mock
+type handlerCase struct {
+	name   string
+	method string
+	path   string
+	// form is sent form encoded, body is sent as JSON
+	form url.Values
+	body string
+	// gateway sets the calls expected by the request
+	gateway func(gateway *GatewayerMock)
+	status  int
+	// rsp is the response of successful requests, err the error message of
+	// the others
+	rsp interface{}
+	err string
+}
+
+// testHandlers serves each case with a new gateway mock
+func testHandlers(t *testing.T, cases []handlerCase) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := NewGatewayerMock()
+			gateway.On("BindBlockListener", mock.Anything).Return(func() {})
+			gateway.On("BindTxnListener", mock.Anything).Return(func() {})
+			if tc.gateway != nil {
+				tc.gateway(gateway)
+			}
+
+			mux := NewGUIMux("", gateway, MuxConfig{DisableCSRF: true})
+			defer mux.Close()
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			var r *http.Request
+			switch {
+			case tc.form != nil:
+				r = httptest.NewRequest(method, tc.path, strings.NewReader(tc.form.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			case tc.body != "":
+				r = httptest.NewRequest(method, tc.path, strings.NewReader(tc.body))
+				r.Header.Set("Content-Type", "application/json")
+			default:
+				r = httptest.NewRequest(method, tc.path, nil)
+			}
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			require.Equal(t, tc.status, w.Code, w.Body.String())
+
+			switch {
+			case tc.status == http.StatusOK:
+				b, err := json.Marshal(tc.rsp)
+				require.NoError(t, err)
+				require.JSONEq(t, string(b), w.Body.String())
+			case strings.HasPrefix(tc.path, apiV1Prefix):
+				var rsp ErrorResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&rsp))
+				require.Equal(t, ErrorBody{Code: tc.status, Message: tc.err}, rsp.Error)
+			default:
+				require.Equal(t, fmt.Sprintf("%d %s\n", tc.status, tc.err), w.Body.String())
+			}
+
+			gateway.AssertExpectations(t)
+		})
+	}
+}
+
+// makeTransaction makes a signed transaction sending coins to a new address
+func makeTransaction(t *testing.T) coin.Transaction {
+	pub, sec := cipher.GenerateKeyPair()
+
+	var txn coin.Transaction
+	txn.PushInput(cipher.SumSHA256([]byte(t.Name())))
+	txn.PushOutput(cipher.AddressFromPubKey(pub), 1e6, 10)
+	txn.SignInputs([]cipher.SecKey{sec})
+	txn.UpdateHeader()
+	return txn
+}
@@ -1,27 +1,35 @@
 package gui
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/skycoin/skycoin/src/api/auth"
 	"github.com/skycoin/skycoin/src/cipher"
 	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/visor"
 
 	"github.com/skycoin/skycoin/src/util/file"
 	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
 
 	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/metrics"
 )
 
 var (
-	logger   = logging.MustGetLogger("gui")
-	listener net.Listener
-	quit     chan struct{}
+	logger = logging.MustGetLogger("gui")
+	server *http.Server
+
+	requestSeconds = metrics.NewHistogramVec("shellcoin_http_request_duration_seconds",
+		"Latency of web interface and REST API requests by route and status", nil, "path", "status")
 )
 
 const (
@@ -32,8 +40,7 @@ const (
 
 // LaunchWebInterface begins listening on http://$host, for enabling remote web access
 // Does NOT use HTTPS
-func LaunchWebInterface(host, staticDir string, daemon *daemon.Daemon) error {
-	quit = make(chan struct{})
+func LaunchWebInterface(host, staticDir string, gateway Gatewayer, c MuxConfig) error {
 	logger.Info("Starting web interface on http://%s", host)
 	logger.Warning("HTTPS not in use!")
 	appLoc, err := file.DetermineResourcePath(staticDir, resourceDir, devDir)
@@ -42,20 +49,19 @@ func LaunchWebInterface(host, staticDir string, daemon *daemon.Daemon) error {
 	}
 	logger.Info("Web resources directory: %s", appLoc)
 
-	listener, err = net.Listen("tcp", host)
+	listener, err := net.Listen("tcp", host)
 	if err != nil {
 		return err
 	}
 
 	// Runs http.Serve() in a goroutine
-	serve(listener, NewGUIMux(appLoc, daemon), quit)
+	serve(listener, NewGUIMux(appLoc, gateway, c.withHost(host)))
 	return nil
 }
 
 // LaunchWebInterfaceHTTPS begins listening on https://$host, for enabling remote web access
 // Uses HTTPS
-func LaunchWebInterfaceHTTPS(host, staticDir string, daemon *daemon.Daemon, certFile, keyFile string) error {
-	quit = make(chan struct{})
+func LaunchWebInterfaceHTTPS(host, staticDir string, gateway Gatewayer, c MuxConfig, certFile, keyFile string) error {
 	logger.Info("Starting web interface on https://%s", host)
 	logger.Info("Using %s for the certificate", certFile)
 	logger.Info("Using %s for the key", keyFile)
@@ -71,43 +77,119 @@ func LaunchWebInterfaceHTTPS(host, staticDir string, daemon *daemon.Daemon, cert
 		return err
 	}
 
-	listener, err = tls.Listen("tcp", host, &tls.Config{Certificates: certs})
+	listener, err := tls.Listen("tcp", host, &tls.Config{Certificates: certs})
 	if err != nil {
 		return err
 	}
 
 	// Runs http.Serve() in a goroutine
-	serve(listener, NewGUIMux(appLoc, daemon), quit)
+	serve(listener, NewGUIMux(appLoc, gateway, c.withHost(host)))
 	return nil
 }
 
-func serve(listener net.Listener, mux *http.ServeMux, q chan struct{}) {
+func serve(listener net.Listener, mux *GUIMux) {
+	srv := &http.Server{Handler: instrument(mux.ServeMux)}
+	// the event streams don't complete on their own
+	srv.RegisterOnShutdown(mux.Close)
+	server = srv
 	go func() {
-		for {
-			if err := http.Serve(listener, mux); err != nil {
-				select {
-				case <-q:
-					return
-				default:
-				}
-				continue
-			}
+		if err := srv.Serve(listener); err != http.ErrServerClosed {
+			logger.Error("Web interface stopped unexpectedly: %v", err)
 		}
 	}()
 }
 
-// Shutdown close http service
-func Shutdown() {
-	if quit != nil {
-		// must close quit first
-		close(quit)
-		listener.Close()
-		listener = nil
+// statusWriter records the status code written to the response
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (sw *statusWriter) WriteHeader(status int) {
+	sw.status = status
+	sw.ResponseWriter.WriteHeader(status)
+}
+
+// Flush flushes the response, the event streams need it
+func (sw *statusWriter) Flush() {
+	if f, ok := sw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// instrument records the latency of the requests served by mux.  Requests are
+// labeled with the pattern of the route they match, not their path, so that
+// the number of label values stays bounded.
+func instrument(mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		_, pattern := mux.Handler(r)
+		if pattern == "" {
+			pattern = "unmatched"
+		}
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		mux.ServeHTTP(sw, r)
+		requestSeconds.With(pattern, strconv.Itoa(sw.status)).ObserveSince(start)
+	})
+}
+
+// Shutdown stops accepting requests and waits up to timeout for the requests
+// in progress to complete
+func Shutdown(timeout time.Duration) {
+	if server == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Warning("Web interface shutdown timed out after %v, requests in progress were dropped", timeout)
+		server.Close()
 	}
+	server = nil
+}
+
+// MuxConfig configures the checks of the API routes of NewGUIMux
+type MuxConfig struct {
+	// Keys authenticate the requests, the API routes require a key granted
+	// their scope.  No key is required if nil.
+	Keys *auth.Keys
+	// Serve the requests that change the state of the node without CSRF token
+	DisableCSRF bool
+	// Host header values accepted, any is accepted if empty.  The web
+	// interface always accepts the address it listens on.
+	Hosts []string
+	// Origins of the pages allowed to call the APIs from a browser, besides
+	// the pages of the node, e.g. https://dashboard.example.com
+	CORSOrigins []string
+}
+
+// withHost adds the address the web interface listens on to the hosts, with
+// its other names if it's a loopback address.  Other names of a public
+// address must be configured, so that the Host check is never disabled.
+func (c MuxConfig) withHost(addr string) MuxConfig {
+	c.Hosts = append(append([]string{addr}, loopbackHosts(addr)...), c.Hosts...)
+	return c
+}
+
+// GUIMux is the http.ServeMux of the web interface.  It must be closed to end
+// its event streams and stop listening to the node.
+type GUIMux struct {
+	*http.ServeMux
+	streams *eventHub
 }
 
-// NewGUIMux creates an http.ServeMux with handlers registered
-func NewGUIMux(appLoc string, daemon *daemon.Daemon) *http.ServeMux {
+// Close ends the event streams of the mux and unregisters it from the gateway
+func (m *GUIMux) Close() {
+	m.streams.close()
+}
+
+// NewGUIMux creates an http.ServeMux with handlers registered.  The API
+// routes are served under /api/v1 and at their deprecated legacy paths, and
+// are checked as configured by c.  The web interface files are public.
+func NewGUIMux(appLoc string, gateway Gatewayer, c MuxConfig) *GUIMux {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", newIndexHandler(appLoc))
 
@@ -120,27 +202,54 @@ func NewGUIMux(appLoc string, daemon *daemon.Daemon) *http.ServeMux {
 		mux.Handle(route, http.FileServer(http.Dir(appLoc)))
 	}
 
-	mux.HandleFunc("/version", versionHandler(daemon.Gateway))
+	api := scopedMux{mux: mux, c: c}
+	if !c.DisableCSRF {
+		api.csrf = newCSRFTokens()
+	}
+
+	// CSRF token of the requests that change the state of the node
+	api.HandleFunc("/csrf", csrfHandler(api.csrf))
+
+	// OpenAPI document of the /api/v1 routes, JSON errors for other paths
+	mux.Handle(apiV1Prefix+"/openapi.json",
+		jsonAPI(api.check(apiV1Prefix+"/openapi.json", auth.ScopePublic, openAPIHandler(gateway))))
+	mux.Handle(apiV1Prefix+"/", http.HandlerFunc(apiNotFound))
+
+	api.HandleFunc("/version", versionHandler(gateway))
 
 	//get set of unspent outputs
-	mux.HandleFunc("/outputs", getOutputsHandler(daemon.Gateway))
+	api.HandleFunc("/outputs", getOutputsHandler(gateway))
 
 	// get balance of addresses
-	mux.HandleFunc("/balance", getBalanceHandler(daemon.Gateway))
+	api.HandleFunc("/balance", getBalanceHandler(gateway))
+
+	// get pending spends and receipts of addresses
+	api.HandleFunc("/address_pending", getAddressPendingHandler(gateway))
 
 	// Wallet interface
-	RegisterWalletHandlers(mux, daemon.Gateway)
+	RegisterWalletHandlers(api, gateway)
 	// Blockchain interface
-	RegisterBlockchainHandlers(mux, daemon.Gateway)
+	RegisterBlockchainHandlers(api, gateway)
 	// Network stats interface
-	RegisterNetworkHandlers(mux, daemon.Gateway)
+	RegisterNetworkHandlers(api, gateway)
 	// Transaction handler
-	RegisterTxHandlers(mux, daemon.Gateway)
+	RegisterTxHandlers(api, gateway)
 	// UxOUt api handler
-	RegisterUxOutHandlers(mux, daemon.Gateway)
+	RegisterUxOutHandlers(api, gateway)
 	// expplorer handler
-	RegisterExplorerHandlers(mux, daemon.Gateway)
-	return mux
+	RegisterExplorerHandlers(api, gateway)
+	// log levels handler
+	RegisterLoggingHandlers(api)
+
+	// event stream
+	streams := newEventHub()
+	streams.bind(gateway)
+	api.HandleFunc("/events", eventsHandler(gateway, streams))
+
+	return &GUIMux{
+		ServeMux: mux,
+		streams:  streams,
+	}
 }
 
 // Returns a http.HandlerFunc for index.html, where index.html is in appLoc
@@ -163,7 +272,7 @@ func newIndexHandler(appLoc string) http.HandlerFunc {
 // if addrs and hashes are not specificed, return all unspent outputs.
 // if both addrs and hashes are specificed, then both those filters are need to be matched.
 // if only specify one filter, then return outputs match the filter.
-func getOutputsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func getOutputsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -210,25 +319,17 @@ func getOutputsHandler(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func getBalanceHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func getBalanceHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
 			return
 		}
 
-		addrsParam := r.FormValue("addrs")
-		addrsStr := strings.Split(addrsParam, ",")
-		addrs := make([]cipher.Address, 0, len(addrsStr))
-		for _, addr := range addrsStr {
-			// trim space
-			addr = strings.Trim(addr, " ")
-			a, err := cipher.DecodeBase58Address(addr)
-			if err != nil {
-				wh.Error400(w, fmt.Sprintf("address %s is invalid: %v", addr, err))
-				return
-			}
-			addrs = append(addrs, a)
+		addrs, err := parseAddresses(r.FormValue("addrs"))
+		if err != nil {
+			wh.Error400(w, err.Error())
+			return
 		}
 
 		bal, err := gateway.GetAddressesBalance(addrs)
@@ -242,7 +343,57 @@ func getBalanceHandler(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func versionHandler(gateway *daemon.Gateway) http.HandlerFunc {
+// getAddressPendingHandler returns the outputs of addresses spent and
+// received by the unconfirmed transactions, and the transactions.
+// mode: GET
+// url: /address_pending?addrs=[:addrs]
+func getAddressPendingHandler(gateway Gatewayer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
+		addrsStr := r.FormValue("addrs")
+		if strings.Count(addrsStr, ",") >= visor.MaxPendingAddresses {
+			wh.Error400(w, fmt.Sprintf("at most %d addresses can be queried", visor.MaxPendingAddresses))
+			return
+		}
+
+		addrs, err := parseAddresses(addrsStr)
+		if err != nil {
+			wh.Error400(w, err.Error())
+			return
+		}
+
+		pending, err := gateway.GetAddressesPending(addrs)
+		if err != nil {
+			logger.Error("Get pending of addresses failed: %v", err)
+			wh.Error500(w)
+			return
+		}
+
+		wh.SendOr404(w, pending)
+	}
+}
+
+// parseAddresses parses comma separated addresses
+func parseAddresses(s string) ([]cipher.Address, error) {
+	addrsStr := strings.Split(s, ",")
+	addrs := make([]cipher.Address, 0, len(addrsStr))
+	for _, addr := range addrsStr {
+		// trim space
+		addr = strings.Trim(addr, " ")
+		a, err := cipher.DecodeBase58Address(addr)
+		if err != nil {
+			return nil, fmt.Errorf("address %s is invalid: %v", addr, err)
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+func versionHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
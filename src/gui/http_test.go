@@ -0,0 +1,178 @@
+package gui
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+func TestNodeHandlers(t *testing.T) {
+	buildInfo := visor.BuildInfo{Version: "0.20.4", Commit: "8798b5ee43c7ce43b9b75d57a1a6cd2c1295cd1e"}
+
+	addrs := []cipher.Address{testutil.MakeAddress(), testutil.MakeAddress()}
+	outputs := visor.ReadableOutputSet{
+		HeadOutputs: []visor.ReadableOutput{{Address: addrs[0].String(), Coins: "1.000000", Hours: 10}},
+	}
+	filters := func(n int) interface{} {
+		return mock.MatchedBy(func(filters []daemon.OutputsFilter) bool {
+			return len(filters) == n
+		})
+	}
+	bal := wallet.BalancePair{
+		Confirmed: wallet.NewBalance(10e6, 100),
+		Predicted: wallet.NewBalance(10e6, 100),
+	}
+
+	_, errAddr := cipher.DecodeBase58Address("abc")
+
+	pending := &visor.PendingTxns{
+		Addresses: []visor.AddressPending{{
+			Address:  addrs[0].String(),
+			Spends:   []visor.PendingOutput{},
+			Receipts: []visor.PendingOutput{{ReadableOutput: visor.ReadableOutput{Address: addrs[0].String(), Coins: "1.000000"}, Txid: "abc"}},
+			Incoming: wallet.NewBalance(1e6, 0),
+		}},
+		Transactions: []visor.TransactionResult{},
+	}
+
+	testHandlers(t, []handlerCase{
+		{
+			name: "version",
+			path: "/api/v1/version",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBuildInfo").Return(buildInfo)
+			},
+			status: http.StatusOK,
+			rsp:    buildInfo,
+		},
+		{
+			name:   "version method",
+			method: http.MethodPost,
+			path:   "/api/v1/version",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name: "openapi",
+			path: "/api/v1/openapi.json",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetBuildInfo").Return(buildInfo)
+			},
+			status: http.StatusOK,
+			rsp:    openAPI(buildInfo.Version),
+		},
+		{
+			name: "all outputs",
+			path: "/api/v1/outputs",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", filters(0)).Return(outputs, nil)
+			},
+			status: http.StatusOK,
+			rsp:    outputs,
+		},
+		{
+			name: "outputs",
+			path: "/api/v1/outputs?addrs=" + url.QueryEscape(addrs[0].String()+", "+addrs[1].String()) + "&hashes=abc",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", filters(2)).Return(outputs, nil)
+			},
+			status: http.StatusOK,
+			rsp:    outputs,
+		},
+		{
+			name: "outputs error",
+			path: "/api/v1/outputs?addrs=" + addrs[0].String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUnspentOutputs", filters(1)).Return(visor.ReadableOutputSet{}, errors.New("db closed"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name:   "balance invalid address",
+			path:   "/api/v1/balance?addrs=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - address abc is invalid: " + errAddr.Error(),
+		},
+		{
+			name: "balance error",
+			path: "/api/v1/balance?addrs=" + addrs[0].String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressesBalance", addrs[:1]).Return(wallet.BalancePair{}, errors.New("db closed"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name: "balance",
+			path: "/api/v1/balance?addrs=" + url.QueryEscape(addrs[0].String()+", "+addrs[1].String()),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressesBalance", addrs).Return(bal, nil)
+			},
+			status: http.StatusOK,
+			rsp:    bal,
+		},
+		{
+			name: "address pending",
+			path: "/api/v1/address_pending?addrs=" + addrs[0].String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressesPending", addrs[:1]).Return(pending, nil)
+			},
+			status: http.StatusOK,
+			rsp:    pending,
+		},
+		{
+			name:   "address pending invalid address",
+			path:   "/api/v1/address_pending?addrs=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - address abc is invalid: " + errAddr.Error(),
+		},
+		{
+			name:   "address pending too many addresses",
+			path:   "/api/v1/address_pending?addrs=" + strings.Repeat(addrs[0].String()+",", visor.MaxPendingAddresses) + addrs[0].String(),
+			status: http.StatusBadRequest,
+			err:    "Bad Request - at most 1000 addresses can be queried",
+		},
+		{
+			name: "address pending error",
+			path: "/address_pending?addrs=" + addrs[0].String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddressesPending", addrs[:1]).Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name:   "log levels",
+			path:   "/api/v1/logging/levels",
+			status: http.StatusOK,
+			rsp:    logging.ModuleLevels(),
+		},
+		{
+			name:   "log levels without level",
+			method: http.MethodPost,
+			path:   "/api/v1/logging/levels",
+			form:   url.Values{"module": {"gui"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - level is required",
+		},
+		{
+			name:   "log levels method",
+			method: http.MethodPut,
+			path:   "/api/v1/logging/levels",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package gui
+
+// Runtime log level settings
+
+import (
+	"net/http"
+
+	wh "github.com/skycoin/skycoin/src/util/http"
+	"github.com/skycoin/skycoin/src/util/logging"
+)
+
+// RegisterLoggingHandlers registers logging handlers
+func RegisterLoggingHandlers(mux Mux) {
+	// get or set the log levels of modules
+	mux.HandleFunc("/logging/levels", logLevelsHandler)
+}
+
+// get or set log levels
+// method: GET or POST
+// url: /logging/levels
+// params (POST): module, level. An empty module sets the level of the
+// modules that don't have their own.
+// returns the level of each module, "" is the level of the other modules
+func logLevelsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		level := r.FormValue("level")
+		if level == "" {
+			wh.Error400(w, "level is required")
+			return
+		}
+
+		module := r.FormValue("module")
+		if err := logging.SetModuleLevel(module, level); err != nil {
+			if err == logging.ErrNotInitialized {
+				wh.Error500(w)
+				return
+			}
+			wh.Error400(w, err.Error())
+			return
+		}
+
+		logger.Notice("Set log level of module %q to %s", module, level)
+	default:
+		wh.Error405(w)
+		return
+	}
+
+	wh.SendOr404(w, logging.ModuleLevels())
+}
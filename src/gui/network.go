@@ -8,45 +8,91 @@ import (
 	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
 )
 
-func connectionHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func connectionHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if addr := r.FormValue("addr"); addr == "" {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
+		addr := r.FormValue("addr")
+		if addr == "" {
+			wh.Error400(w, "addr is empty")
+			return
+		}
+
+		c, _ := gateway.GetConnection(addr).(*daemon.Connection)
+		if c == nil {
 			wh.Error404(w)
-		} else {
-			wh.SendOr404(w, gateway.GetConnection(addr))
+			return
 		}
+
+		wh.SendOr404(w, c)
 	}
 }
 
-func connectionsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func connectionsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wh.SendOr404(w, gateway.GetConnections())
 	}
 }
 
-func defaultConnectionsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func defaultConnectionsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wh.SendOr404(w, gateway.GetDefaultConnections())
 	}
 }
 
-func trustConnectionsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func trustConnectionsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wh.SendOr404(w, gateway.GetTrustConnections())
 	}
 }
 
-func exchgConnectionsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func exchgConnectionsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wh.SendOr404(w, gateway.GetExchgConnection())
 	}
 }
 
+// Returns the compression stats of sent messages, by message type
+func compressionStatsHandler(gateway Gatewayer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
+		wh.SendOr404(w, gateway.GetCompressionStats())
+	}
+}
+
 // RegisterNetworkHandlers registers network handlers
-func RegisterNetworkHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
+func RegisterNetworkHandlers(mux Mux, gateway Gatewayer) {
 	mux.HandleFunc("/network/connection", connectionHandler(gateway))
 	mux.HandleFunc("/network/connections", connectionsHandler(gateway))
 	mux.HandleFunc("/network/defaultConnections", defaultConnectionsHandler(gateway))
 	mux.HandleFunc("/network/connections/trust", trustConnectionsHandler(gateway))
 	mux.HandleFunc("/network/connections/exchange", exchgConnectionsHandler(gateway))
+	mux.HandleFunc("/network/compression", compressionStatsHandler(gateway))
 }
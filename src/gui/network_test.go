@@ -0,0 +1,106 @@
+package gui
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/daemon/gnet"
+)
+
+func TestNetworkHandlers(t *testing.T) {
+	c := &daemon.Connection{ID: 1, Addr: "127.0.0.1:6000", Outgoing: true, Introduced: true}
+	conns := &daemon.Connections{Connections: []*daemon.Connection{c}}
+	addrs := []string{"127.0.0.1:6000", "127.0.0.1:6001"}
+	stats := map[string]gnet.CompressionStat{
+		"GIVB": {Messages: 2, Compressed: 1, RawBytes: 300, TransmittedBytes: 200, Ratio: 0.67},
+	}
+
+	testHandlers(t, []handlerCase{
+		{
+			name:   "connection without addr",
+			path:   "/api/v1/network/connection",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - addr is empty",
+		},
+		{
+			name: "connection not found",
+			path: "/api/v1/network/connection?addr=127.0.0.1:6001",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetConnection", "127.0.0.1:6001").Return((*daemon.Connection)(nil))
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name: "connection",
+			path: "/api/v1/network/connection?addr=127.0.0.1:6000",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetConnection", "127.0.0.1:6000").Return(c)
+			},
+			status: http.StatusOK,
+			rsp:    c,
+		},
+		{
+			name:   "connection method",
+			method: http.MethodPost,
+			path:   "/api/v1/network/connection?addr=127.0.0.1:6000",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name: "connections",
+			path: "/api/v1/network/connections",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetConnections").Return(conns)
+			},
+			status: http.StatusOK,
+			rsp:    conns,
+		},
+		{
+			name: "default connections",
+			path: "/api/v1/network/default_connections",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetDefaultConnections").Return(addrs)
+			},
+			status: http.StatusOK,
+			rsp:    addrs,
+		},
+		{
+			name: "trusted connections",
+			path: "/api/v1/network/connections/trust",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetTrustConnections").Return(addrs)
+			},
+			status: http.StatusOK,
+			rsp:    addrs,
+		},
+		{
+			name: "exchangeable connections",
+			path: "/api/v1/network/connections/exchange",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetExchgConnection").Return(addrs)
+			},
+			status: http.StatusOK,
+			rsp:    addrs,
+		},
+		{
+			name: "no exchangeable connections",
+			path: "/api/v1/network/connections/exchange",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetExchgConnection").Return(nil)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name: "compression",
+			path: "/api/v1/network/compression",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetCompressionStats").Return(stats)
+			},
+			status: http.StatusOK,
+			rsp:    stats,
+		},
+	})
+}
@@ -0,0 +1,265 @@
+package gui
+
+// OpenAPI document of the /api/v1 routes, generated from apiRoutes
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/skycoin/skycoin/src/api/auth"
+
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+)
+
+// object is a JSON object of the OpenAPI document
+type object map[string]interface{}
+
+// openAPI returns the OpenAPI 3 document of the /api/v1 routes of apiRoutes.
+// version is the version of the node.
+func openAPI(version string) object {
+	s := schemas{}
+	paths := object{}
+	for _, route := range apiRoutes {
+		if route.v1 == "" {
+			continue
+		}
+
+		ops := object{}
+		for _, method := range route.methods {
+			ops[strings.ToLower(method)] = s.operation(method, route)
+		}
+		paths[route.v1] = ops
+	}
+
+	s.of(reflect.TypeOf(ErrorResponse{}))
+
+	return object{
+		"openapi": "3.0.0",
+		"info": object{
+			"title":   "shellcoin REST API",
+			"version": version,
+			"description": "The routes require an API key granted the scope in x-scope if the node " +
+				"is started with -api-keys. POST requests require a CSRF token, unless they have a bearer token.",
+		},
+		"servers": []object{{"url": apiV1Prefix}},
+		"paths":   paths,
+		"components": object{
+			"schemas": s,
+			"securitySchemes": object{
+				"bearer": object{"type": "http", "scheme": "bearer"},
+				"basic":  object{"type": "http", "scheme": "basic"},
+			},
+		},
+	}
+}
+
+// operation returns the operation of method on route
+func (s schemas) operation(method string, route apiRoute) object {
+	var params []object
+	for _, p := range route.query {
+		params = append(params, object{
+			"name":        p.name,
+			"in":          "query",
+			"required":    p.required,
+			"description": p.desc,
+			"schema":      object{"type": p.typ},
+		})
+	}
+
+	op := object{
+		"operationId": strings.ToLower(method) + strings.Replace(route.v1, "/", "_", -1),
+		"summary":     route.summary,
+		"responses": object{
+			"200": s.response(route),
+			"default": object{
+				"description": "Error",
+				"content": object{
+					"application/json": object{"schema": s.of(reflect.TypeOf(ErrorResponse{}))},
+				},
+			},
+		},
+	}
+
+	if route.scope == auth.ScopePublic {
+		op["security"] = []object{}
+	} else {
+		op["x-scope"] = route.scope
+		op["security"] = []object{{"bearer": []string{}}, {"basic": []string{}}}
+	}
+
+	if method == http.MethodPost {
+		params = append(params, object{
+			"name":        csrfHeader,
+			"in":          "header",
+			"description": "CSRF token from /csrf, not needed with a bearer token",
+			"schema":      object{"type": "string"},
+		})
+
+		if len(route.body) != 0 {
+			body := bodySchema(route.body)
+			op["requestBody"] = object{
+				"required": true,
+				"content": object{
+					"application/json":                  object{"schema": body},
+					"application/x-www-form-urlencoded": object{"schema": body},
+				},
+			}
+		}
+	}
+
+	if len(params) != 0 {
+		op["parameters"] = params
+	}
+
+	return op
+}
+
+// response returns the OK response of route
+func (s schemas) response(route apiRoute) object {
+	if route.contentType != "" {
+		return object{
+			"description": "OK",
+			"content":     object{route.contentType: object{}},
+		}
+	}
+
+	return object{
+		"description": "OK",
+		"content": object{
+			"application/json": object{"schema": s.of(reflect.TypeOf(route.response))},
+		},
+	}
+}
+
+// bodySchema returns the schema of a request body with fields
+func bodySchema(fields []apiParam) object {
+	props := object{}
+	var required []string
+	for _, f := range fields {
+		props[f.name] = object{"type": f.typ, "description": f.desc}
+		if f.required {
+			required = append(required, f.name)
+		}
+	}
+
+	body := object{"type": "object", "properties": props}
+	if len(required) != 0 {
+		body["required"] = required
+	}
+	return body
+}
+
+var (
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+// schemas are the schemas of the named types, by type name
+type schemas map[string]object
+
+// of returns the schema of the JSON encoding of values of type t.  Named
+// struct types are added to s and referred to.
+func (s schemas) of(t reflect.Type) object {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return s.of(t.Elem())
+	case reflect.Bool:
+		return object{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return object{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return object{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return object{"type": "number"}
+	case reflect.String:
+		return object{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return object{"type": "string", "format": "byte"}
+		}
+		return object{"type": "array", "items": s.of(t.Elem())}
+	case reflect.Map:
+		return object{"type": "object", "additionalProperties": s.of(t.Elem())}
+	case reflect.Struct:
+		switch {
+		case t == timeType:
+			return object{"type": "string", "format": "date-time"}
+		case t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType):
+			return object{}
+		case t.Name() == "":
+			return s.object(t)
+		}
+
+		name := t.String()
+		if _, ok := s[name]; !ok {
+			// added before its fields, which may refer to it
+			s[name] = object{}
+			s[name] = s.object(t)
+		}
+		return object{"$ref": "#/components/schemas/" + name}
+	default:
+		return object{}
+	}
+}
+
+// object returns the schema of the struct type t
+func (s schemas) object(t reflect.Type) object {
+	props := object{}
+	s.fields(t, props)
+	return object{"type": "object", "properties": props}
+}
+
+// fields adds the schemas of the fields of the struct type t to props
+func (s schemas) fields(t reflect.Type, props object) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		name := tag[0]
+		if name == "-" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		// the fields of embedded structs are promoted
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			s.fields(ft, props)
+			continue
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		props[name] = s.of(f.Type)
+		for _, opt := range tag[1:] {
+			if opt == "string" {
+				props[name] = object{"type": "string"}
+			}
+		}
+	}
+}
+
+// Returns the OpenAPI document of the /api/v1 routes
+// URI: /api/v1/openapi.json
+// Method: GET
+func openAPIHandler(gateway Gatewayer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
+		wh.SendOr404(w, openAPI(gateway.GetBuildInfo().Version))
+	}
+}
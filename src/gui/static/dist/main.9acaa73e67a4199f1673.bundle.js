@@ -1 +1 @@
-webpackJsonp([1],{"+h1B":function(n,l,e){"use strict";var t=e("/oeL"),u=e("aR8+"),i=e("wQAS"),r=e("v6Q/"),o=e("C2LC"),a=e("RMl1"),d=e("p6Q+"),c=e("gH5r"),s=e("omNI"),p=e("Qgkl"),f=e("9z2b"),m=e("io6L"),v=e("NED+"),b=e("aZaK"),h=e("l4UU"),g=e("nA3a"),y=e("aUD3"),_=e("vXTO"),x=e("sfWi"),k=e("xdkH"),w=e("sxGq"),R=e("q4dy"),C=e("qbdv"),S=e("fc+i"),T=e("f9zQ"),E=e("fL27"),D=e("CPp0"),q=e("UPmf"),I=e("JYHx"),P=e("vVgA"),O=e("Z04r"),j=e("z1Gz"),H=e("dGUy"),A=e("f3MN"),F=e("7i8B"),L=(e.n(F),e("EyWH")),M=e("bm2B"),U=e("BkNc"),B=e("1Zxj"),N=e("SJy7"),V=e("dSyq"),z=e("ScY5"),G=e("cP51"),Z=e("mSH7"),W=e("Q6ZI"),Q=(e.n(W),e("5WMG")),Y=e("nI5/"),J=e("iXFZ"),K=e("2yQV"),$=e("7Bws"),X=e("ONOE"),nn=e("KwZO"),ln=e("BZkh"),en=e("WZ1N"),tn=e("W/ps"),un=e("PRn9"),rn=e("KbYb"),on=e("HHiG");e.d(l,"a",function(){return an});var an=t["ɵcmf"](u.a,[i.a],function(n){return t["ɵmod"]([t["ɵmpd"](512,t.ComponentFactoryResolver,t["ɵCodegenComponentFactoryResolver"],[[8,[r.a,r.b,r.c,r.d,o.a,a.a,d.a,c.a,s.a,p.a,f.a,m.a,v.a,b.a,h.a,g.a,y.a,_.a,x.a,k.a,w.a,R.a]],[3,t.ComponentFactoryResolver],t.NgModuleRef]),t["ɵmpd"](5120,t.LOCALE_ID,t["ɵm"],[[3,t.LOCALE_ID]]),t["ɵmpd"](4608,C.NgLocalization,C.NgLocaleLocalization,[t.LOCALE_ID]),t["ɵmpd"](5120,t.APP_ID,t["ɵf"],[]),t["ɵmpd"](5120,t.IterableDiffers,t["ɵk"],[]),t["ɵmpd"](5120,t.KeyValueDiffers,t["ɵl"],[]),t["ɵmpd"](4608,S.DomSanitizer,S["ɵe"],[C.DOCUMENT]),t["ɵmpd"](6144,t.Sanitizer,null,[S.DomSanitizer]),t["ɵmpd"](4608,S.HAMMER_GESTURE_CONFIG,S.HammerGestureConfig,[]),t["ɵmpd"](5120,S.EVENT_MANAGER_PLUGINS,function(n,l,e,t){return[new S["ɵDomEventsPlugin"](n),new S["ɵKeyEventsPlugin"](l),new S["ɵHammerGesturesPlugin"](e,t)]},[C.DOCUMENT,C.DOCUMENT,C.DOCUMENT,S.HAMMER_GESTURE_CONFIG]),t["ɵmpd"](4608,S.EventManager,S.EventManager,[S.EVENT_MANAGER_PLUGINS,t.NgZone]),t["ɵmpd"](135680,S["ɵDomSharedStylesHost"],S["ɵDomSharedStylesHost"],[C.DOCUMENT]),t["ɵmpd"](4608,S["ɵDomRendererFactory2"],S["ɵDomRendererFactory2"],[S.EventManager,S["ɵDomSharedStylesHost"]]),t["ɵmpd"](4608,T.a,T.b,[]),t["ɵmpd"](5120,T.c,E.a,[]),t["ɵmpd"](4608,T.d,E.b,[T.a,T.c]),t["ɵmpd"](5120,t.RendererFactory2,E.c,[S["ɵDomRendererFactory2"],T.d,t.NgZone]),t["ɵmpd"](6144,S["ɵSharedStylesHost"],null,[S["ɵDomSharedStylesHost"]]),t["ɵmpd"](4608,t.Testability,t.Testability,[t.NgZone]),t["ɵmpd"](4608,S.Meta,S.Meta,[C.DOCUMENT]),t["ɵmpd"](4608,S.Title,S.Title,[C.DOCUMENT]),t["ɵmpd"](4608,D.a,D.a,[]),t["ɵmpd"](4608,D.b,D.c,[]),t["ɵmpd"](5120,D.d,D.e,[]),t["ɵmpd"](4608,D.f,D.f,[D.a,D.b,D.d]),t["ɵmpd"](4608,D.g,D.h,[]),t["ɵmpd"](5120,D.i,D.j,[D.f,D.g]),t["ɵmpd"](6144,q.a,null,[S.DOCUMENT]),t["ɵmpd"](4608,q.b,q.b,[[2,q.a]]),t["ɵmpd"](4608,I.a,I.a,[]),t["ɵmpd"](5120,P.a,P.b,[[3,P.a],t.NgZone,I.a]),t["ɵmpd"](5120,P.c,P.d,[[3,P.c],P.a]),t["ɵmpd"](5120,O.a,O.b,[[3,O.a],t.NgZone,I.a]),t["ɵmpd"](4608,j.a,j.a,[P.a,P.c]),t["ɵmpd"](5120,j.b,j.c,[[3,j.b]]),t["ɵmpd"](4608,j.d,j.d,[P.c]),t["ɵmpd"](4608,j.e,j.e,[j.a,j.b,t.ComponentFactoryResolver,j.d,t.ApplicationRef,t.Injector,t.NgZone]),t["ɵmpd"](5120,j.f,j.g,[j.e]),t["ɵmpd"](4608,H.a,H.a,[I.a]),t["ɵmpd"](4608,H.b,H.b,[H.a,I.a,t.NgZone]),t["ɵmpd"](5120,H.c,H.d,[[3,H.c],[2,H.e],I.a]),t["ɵmpd"](5120,O.c,O.d,[j.e]),t["ɵmpd"](4608,O.e,O.e,[j.e,t.Injector,O.c,[2,C.Location],[3,O.e]]),t["ɵmpd"](5120,O.f,O.g,[[3,O.f]]),t["ɵmpd"](5120,O.h,O.i,[[3,O.h],[2,D.i],S.DomSanitizer]),t["ɵmpd"](5120,O.j,O.k,[j.e]),t["ɵmpd"](5120,O.l,O.m,[j.e]),t["ɵmpd"](4608,O.n,O.n,[j.e,H.c,t.Injector,[3,O.n]]),t["ɵmpd"](4608,A.a,A.a,[]),t["ɵmpd"](5120,O.o,O.p,[j.e]),t["ɵmpd"](4608,F.ScrollbarHelper,F.ScrollbarHelper,[S.DOCUMENT]),t["ɵmpd"](4608,L.a,E.d,[t.RendererFactory2,S.DOCUMENT]),t["ɵmpd"](4608,M.a,M.a,[]),t["ɵmpd"](4608,M.b,M.b,[]),t["ɵmpd"](5120,U.a,U.b,[U.c]),t["ɵmpd"](4608,U.d,U.d,[]),t["ɵmpd"](6144,U.e,null,[U.d]),t["ɵmpd"](135680,U.f,U.f,[U.c,t.NgModuleFactoryLoader,t.Compiler,t.Injector,U.e]),t["ɵmpd"](4608,U.g,U.g,[]),t["ɵmpd"](5120,U.h,U.i,[U.j]),t["ɵmpd"](5120,t.APP_BOOTSTRAP_LISTENER,function(n){return[n]},[U.h]),t["ɵmpd"](4608,B.a,B.a,[D.i]),t["ɵmpd"](4608,N.a,N.a,[B.a]),t["ɵmpd"](4608,V.a,V.a,[B.a]),t["ɵmpd"](4608,z.a,z.a,[D.i]),t["ɵmpd"](4608,G.a,G.a,[B.a]),t["ɵmpd"](512,C.CommonModule,C.CommonModule,[]),t["ɵmpd"](1024,t.ErrorHandler,S["ɵa"],[]),t["ɵmpd"](1024,t.NgProbeToken,function(){return[U.k()]},[]),t["ɵmpd"](512,U.j,U.j,[t.Injector]),t["ɵmpd"](1024,t.APP_INITIALIZER,function(n,l,e){return[S["ɵc"](n,l),U.l(e)]},[[2,S.NgProbeToken],[2,t.NgProbeToken],U.j]),t["ɵmpd"](512,t.ApplicationInitStatus,t.ApplicationInitStatus,[[2,t.APP_INITIALIZER]]),t["ɵmpd"](131584,t["ɵe"],t["ɵe"],[t.NgZone,t["ɵConsole"],t.Injector,t.ErrorHandler,t.ComponentFactoryResolver,t.ApplicationInitStatus]),t["ɵmpd"](2048,t.ApplicationRef,null,[t["ɵe"]]),t["ɵmpd"](512,t.ApplicationModule,t.ApplicationModule,[t.ApplicationRef]),t["ɵmpd"](512,S.BrowserModule,S.BrowserModule,[[3,S.BrowserModule]]),t["ɵmpd"](512,D.k,D.k,[]),t["ɵmpd"](512,O.q,O.q,[]),t["ɵmpd"](512,q.c,q.c,[]),t["ɵmpd"](256,O.r,!0,[]),t["ɵmpd"](512,O.s,O.s,[[2,S.DOCUMENT],[2,O.r]]),t["ɵmpd"](512,I.b,I.b,[]),t["ɵmpd"](512,P.e,P.e,[]),t["ɵmpd"](512,O.t,O.t,[]),t["ɵmpd"](512,O.u,O.u,[]),t["ɵmpd"](512,O.v,O.v,[]),t["ɵmpd"](512,O.w,O.w,[]),t["ɵmpd"](512,Z.a,Z.a,[]),t["ɵmpd"](512,j.h,j.h,[]),t["ɵmpd"](512,H.f,H.f,[]),t["ɵmpd"](512,O.x,O.x,[]),t["ɵmpd"](512,O.y,O.y,[]),t["ɵmpd"](512,O.z,O.z,[]),t["ɵmpd"](512,O.A,O.A,[]),t["ɵmpd"](512,O.B,O.B,[]),t["ɵmpd"](512,O.C,O.C,[]),t["ɵmpd"](512,O.D,O.D,[]),t["ɵmpd"](512,O.E,O.E,[]),t["ɵmpd"](512,O.F,O.F,[]),t["ɵmpd"](512,O.G,O.G,[]),t["ɵmpd"](512,O.H,O.H,[]),t["ɵmpd"](512,O.I,O.I,[]),t["ɵmpd"](512,O.J,O.J,[]),t["ɵmpd"](512,O.K,O.K,[]),t["ɵmpd"](512,O.L,O.L,[]),t["ɵmpd"](512,A.b,A.b,[]),t["ɵmpd"](512,O.M,O.M,[]),t["ɵmpd"](512,O.N,O.N,[]),t["ɵmpd"](512,O.O,O.O,[]),t["ɵmpd"](512,W.NgxDatatableModule,W.NgxDatatableModule,[]),t["ɵmpd"](512,E.e,E.e,[]),t["ɵmpd"](512,M.c,M.c,[]),t["ɵmpd"](512,M.d,M.d,[]),t["ɵmpd"](1024,U.m,U.n,[[3,U.c]]),t["ɵmpd"](512,U.o,U.p,[]),t["ɵmpd"](512,U.q,U.q,[]),t["ɵmpd"](256,U.r,{useHash:!0},[]),t["ɵmpd"](1024,C.LocationStrategy,U.s,[C.PlatformLocation,[2,C.APP_BASE_HREF],U.r]),t["ɵmpd"](512,C.Location,C.Location,[C.LocationStrategy]),t["ɵmpd"](512,t.Compiler,t.Compiler,[]),t["ɵmpd"](512,t.NgModuleFactoryLoader,t.SystemJsNgModuleLoader,[t.Compiler,[2,t.SystemJsNgModuleLoaderConfig]]),t["ɵmpd"](1024,U.t,function(){return[[{path:"",redirectTo:"wallets",pathMatch:"full"},{path:"wallets",component:Q.a,data:{breadcrumb:"Wallets"}},{path:"send",component:Y.a,data:{breadcrumb:"Send Skycoin"}},{path:"history",children:[{path:"",component:J.a,data:{breadcrumb:"History"}},{path:":transaction",component:K.a,data:{breadcrumb:"Transaction"}}]},{path:"explorer",children:[{path:"",component:$.a,data:{breadcrumb:"Explorer"}},{path:"address/:address",component:X.a,data:{breadcrumb:"Address"}},{path:":block",component:nn.a,data:{breadcrumb:"Block"}},{path:"transaction/:transaction",component:K.a,data:{breadcrumb:"Transaction"}}]},{path:"buy",component:ln.a,data:{breadcrumb:"Buy Skycoin"}},{path:"settings",children:[{path:"backup",component:en.a,data:{breadcrumb:"Backup"}},{path:"blockchain",component:tn.a,data:{breadcrumb:"Blockchain"}},{path:"network",component:un.a,data:{breadcrumb:"Networking"}},{path:"outputs",component:rn.a,data:{breadcrumb:"Outputs"}},{path:"pending-transactions",component:on.a,data:{breadcrumb:"Pending transactions"}}]}]]},[]),t["ɵmpd"](1024,U.c,U.u,[t.ApplicationRef,U.o,U.q,C.Location,t.Injector,t.NgModuleFactoryLoader,t.Compiler,U.t,U.r,[2,U.v],[2,U.w]]),t["ɵmpd"](512,U.x,U.x,[[2,U.m],[2,U.c]]),t["ɵmpd"](512,u.a,u.a,[]),t["ɵmpd"](256,O.P,{overlapTrigger:!0,xPosition:"after",yPosition:"below"},[])])})},"/Tz7":function(n,l,e){"use strict";var t=e("qbdv");e.d(l,"a",function(){return u});var u=function(){function n(n){this.location=n}return n.prototype.onClick=function(){this.location.back()},n.ctorParameters=function(){return[{type:t.Location}]},n}()},1:function(n,l,e){n.exports=e("cDNt")},"1Zxj":function(n,l,e){"use strict";var t=e("CPp0"),u=e("bKpL"),i=(e.n(u),e("S7im")),r=(e.n(i),e("xpf9")),o=(e.n(r),e("5v8a"));e.n(o);e.d(l,"a",function(){return a});var a=function(){function n(n){this.http=n,this.url="http://127.0.0.1:7520/"}return n.prototype.get=function(n,l){return void 0===l&&(l=null),this.http.get(this.getUrl(n,l),this.getHeaders()).map(function(n){return n.json()}).catch(function(n){return u.Observable.throw(n||"Server error")})},n.prototype.post=function(n,l){return void 0===l&&(l={}),this.http.post(this.getUrl(n),this.getQueryString(l),this.returnRequestOptions()).map(function(n){return n.json()}).catch(function(n){return u.Observable.throw(n||"Server error")})},n.prototype.getHeaders=function(){var n=new t.l;return n.append("Content-Type","application/x-www-form-urlencoded"),n},n.prototype.returnRequestOptions=function(){var n=new t.g;return n.headers=this.getHeaders(),n},n.prototype.getQueryString=function(n){return void 0===n&&(n=null),n?Object.keys(n).reduce(function(l,e){return l.push(e+"="+encodeURIComponent(n[e])),l},[]).join("&"):""},n.prototype.getUrl=function(n,l){return void 0===l&&(l=null),this.url+n+"?"+this.getQueryString(l)},n.ctorParameters=function(){return[{type:t.i}]},n}()},"2yQV":function(n,l,e){"use strict";var t=e("cP51"),u=e("BkNc"),i=e("Pic8");e.n(i);e.d(l,"a",function(){return r});var r=function(){function n(n,l){this.route=n,this.walletService=l}return n.prototype.ngOnInit=function(){var n=this;this.route.params.switchMap(function(l){return n.walletService.transaction(l.transaction)}).subscribe(function(l){n.transaction=l,n.total=l.txn.outputs.reduce(function(n,l){return n+parseInt(l.coins)},0)})},n.ctorParameters=function(){return[{type:u.a},{type:t.a}]},n}()},"3VAl":function(n,l,e){"use strict";var t=e("/oeL"),u=e("cP51"),i=e("bm2B"),r=e("Z04r"),o=e("qwxJ");e.n(o);e.d(l,"a",function(){return a});var a=function(){function n(n,l,e,t){this.data=n,this.dialogRef=l,this.formBuilder=e,this.walletService=t}return n.prototype.ngOnInit=function(){this.initForm()},n.prototype.rename=function(){var n=this;this.walletService.renameWallet(this.data,this.form.value.label).subscribe(function(){return n.dialogRef.close(n.form.value.label)})},n.prototype.initForm=function(){this.form=this.formBuilder.group({label:[this.data.meta.label,i.k.required]})},n.ctorParameters=function(){return[{type:o.WalletModel,decorators:[{type:t.Inject,args:[r._103]}]},{type:r.R},{type:i.a},{type:u.a}]},n}()},"5ERs":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t={otcEnabled:!1}},"5WMG":function(n,l,e){"use strict";var t=e("cP51"),u=e("Z04r"),i=e("kRbD");e.d(l,"a",function(){return r});var r=function(){function n(n,l){this.walletService=n,this.dialog=l}return n.prototype.addWallet=function(){var n=new u.Q;n.width="500px",this.dialog.open(i.a,n).afterClosed().subscribe(function(n){})},n.ctorParameters=function(){return[{type:t.a},{type:u.e}]},n}()},"6e64":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.transform=function(n){switch(n){case"waiting_deposit":return"Waiting for Bitcoin deposit";case"waiting_send":return"Waiting to send Skycoin";case"waiting_confirm":return"Waiting for confirmation";case"done":return"Completed";default:return"Unknown"}},n}()},"79YC":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".input-field[_ngcontent-%COMP%]{display:block;margin-top:20px;width:100%}.send-skycoin-form[_ngcontent-%COMP%]{padding-top:40px}md-select[_ngcontent-%COMP%]{padding-bottom:1.29688em}md-card[_ngcontent-%COMP%]{margin-bottom:20px}"]},"7Bws":function(n,l,e){"use strict";var t=e("SJy7"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.blockchainService=n,this.router=l}return n.prototype.ngOnInit=function(){var n=this;this.blockchainService.blocks().subscribe(function(l){return n.blocks=l})},n.prototype.onActivate=function(n){n.row&&n.row.header&&this.router.navigate(["/explorer",n.row.header.seq])},n.ctorParameters=function(){return[{type:t.a},{type:u.c}]},n}()},"7zlW":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},"8Sw2":function(n,l,e){function t(n){return e(u(n))}function u(n){var l=i[n];if(!(l+1))throw new Error("Cannot find module '"+n+"'.");return l}var i={"./af":"FQor","./af.js":"FQor","./ar":"EcI0","./ar-dz":"cw72","./ar-dz.js":"cw72","./ar-kw":"fu+8","./ar-kw.js":"fu+8","./ar-ly":"7CVz","./ar-ly.js":"7CVz","./ar-ma":"1dFW","./ar-ma.js":"1dFW","./ar-sa":"UxV2","./ar-sa.js":"UxV2","./ar-tn":"nm31","./ar-tn.js":"nm31","./ar.js":"EcI0","./az":"EbpX","./az.js":"EbpX","./be":"SQlX","./be.js":"SQlX","./bg":"R3V+","./bg.js":"R3V+","./bn":"cAX3","./bn.js":"cAX3","./bo":"dHgA","./bo.js":"dHgA","./br":"W7ww","./br.js":"W7ww","./bs":"lZ6K","./bs.js":"lZ6K","./ca":"udbC","./ca.js":"udbC","./cs":"oSQb","./cs.js":"oSQb","./cv":"yPEm","./cv.js":"yPEm","./cy":"PpYB","./cy.js":"PpYB","./da":"oiZG","./da.js":"oiZG","./de":"LYe6","./de-at":"KOG5","./de-at.js":"KOG5","./de-ch":"o3JY","./de-ch.js":"o3JY","./de.js":"LYe6","./dv":"OkYT","./dv.js":"OkYT","./el":"QFnp","./el.js":"QFnp","./en-au":"dBGl","./en-au.js":"dBGl","./en-ca":"vv0C","./en-ca.js":"vv0C","./en-gb":"IoCy","./en-gb.js":"IoCy","./en-ie":"qVAg","./en-ie.js":"qVAg","./en-nz":"FbT0","./en-nz.js":"FbT0","./eo":"y/6j","./eo.js":"y/6j","./es":"ivpd","./es-do":"DBbP","./es-do.js":"DBbP","./es.js":"ivpd","./et":"FrUj","./et.js":"FrUj","./eu":"LAVD","./eu.js":"LAVD","./fa":"J2cK","./fa.js":"J2cK","./fi":"5FCP","./fi.js":"5FCP","./fo":"jIZb","./fo.js":"jIZb","./fr":"Lq8I","./fr-ca":"ICRA","./fr-ca.js":"ICRA","./fr-ch":"QWmo","./fr-ch.js":"QWmo","./fr.js":"Lq8I","./fy":"Fbnp","./fy.js":"Fbnp","./gd":"zhFu","./gd.js":"zhFu","./gl":"o10m","./gl.js":"o10m","./gom-latn":"UoXv","./gom-latn.js":"UoXv","./he":"gbvS","./he.js":"gbvS","./hi":"+UQz","./hi.js":"+UQz","./hr":"qGYH","./hr.js":"qGYH","./hu":"hGGh","./hu.js":"hGGh","./hy-am":"1AR5","./hy-am.js":"1AR5","./id":"Ie9h","./id.js":"Ie9h","./is":"piS+","./is.js":"piS+","./it":"5P9/","./it.js":"5P9/","./ja":"3cRe","./ja.js":"3cRe","./jv":"vwCJ","./jv.js":"vwCJ","./ka":"R7aq","./ka.js":"R7aq","./kk":"d8hV","./kk.js":"d8hV","./km":"nCdc","./km.js":"nCdc","./kn":"5K8n","./kn.js":"5K8n","./ko":"1jN3","./ko.js":"1jN3","./ky":"74/W","./ky.js":"74/W","./lb":"XAyT","./lb.js":"XAyT","./lo":"w/Mz","./lo.js":"w/Mz","./lt":"IetG","./lt.js":"IetG","./lv":"CRPd","./lv.js":"CRPd","./me":"1mee","./me.js":"1mee","./mi":"yRr3","./mi.js":"yRr3","./mk":"bfn1","./mk.js":"bfn1","./ml":"r1ky","./ml.js":"r1ky","./mr":"+K6p","./mr.js":"+K6p","./ms":"asMk","./ms-my":"IcWU","./ms-my.js":"IcWU","./ms.js":"asMk","./my":"Isng","./my.js":"Isng","./nb":"FjNp","./nb.js":"FjNp","./ne":"P9zQ","./ne.js":"P9zQ","./nl":"pejQ","./nl-be":"c3/i","./nl-be.js":"c3/i","./nl.js":"pejQ","./nn":"ALD8","./nn.js":"ALD8","./pa-in":"1YTd","./pa-in.js":"1YTd","./pl":"Gs99","./pl.js":"Gs99","./pt":"eunO","./pt-br":"uHKL","./pt-br.js":"uHKL","./pt.js":"eunO","./ro":"1FZw","./ro.js":"1FZw","./ru":"Cy48","./ru.js":"Cy48","./sd":"+28k","./sd.js":"+28k","./se":"EiHj","./se.js":"EiHj","./si":"77bI","./si.js":"77bI","./sk":"BgyH","./sk.js":"BgyH","./sl":"K7sG","./sl.js":"K7sG","./sq":"pwQJ","./sq.js":"pwQJ","./sr":"N0T2","./sr-cyrl":"9OoP","./sr-cyrl.js":"9OoP","./sr.js":"N0T2","./ss":"Mh9L","./ss.js":"Mh9L","./sv":"YZAt","./sv.js":"YZAt","./sw":"/7jV","./sw.js":"/7jV","./ta":"VKBt","./ta.js":"VKBt","./te":"TIcV","./te.js":"TIcV","./tet":"BlC1","./tet.js":"BlC1","./th":"Zi3L","./th.js":"Zi3L","./tl-ph":"D4Y9","./tl-ph.js":"D4Y9","./tlh":"muBP","./tlh.js":"muBP","./tr":"82s3","./tr.js":"82s3","./tzl":"vjXv","./tzl.js":"vjXv","./tzm":"Vhfe","./tzm-latn":"m0Pg","./tzm-latn.js":"m0Pg","./tzm.js":"Vhfe","./uk":"Y3Ex","./uk.js":"Y3Ex","./ur":"4DIg","./ur.js":"4DIg","./uz":"wDue","./uz-latn":"Li28","./uz-latn.js":"Li28","./uz.js":"wDue","./vi":"UbP6","./vi.js":"UbP6","./x-pseudo":"fQLM","./x-pseudo.js":"fQLM","./yo":"evMD","./yo.js":"evMD","./zh-cn":"DV5H","./zh-cn.js":"DV5H","./zh-hk":"iEKH","./zh-hk.js":"iEKH","./zh-tw":"X9uL","./zh-tw.js":"X9uL"};t.keys=function(){return Object.keys(i)},t.resolve=u,n.exports=t,t.id="8Sw2"},"9z2b":function(n,l,e){"use strict";function t(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,13,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),p["ɵppd"](1),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      ","\n    "])),p["ɵppd"](1)],null,function(n,l){var e=l.component;n(l,1,0,e.block&&e.block.header?e.block.header.seq:""),n(l,3,0,p["ɵunv"](l,3,0,n(l,4,0,p["ɵnov"](l.parent,0),e.block.header.timestamp))),n(l,6,0,e.block&&e.block.header?e.block.header.block_hash:""),n(l,8,0,e.block&&e.block.header?e.block.header.previous_block_hash:""),n(l,10,0,e.block&&e.block.body&&e.block.body.txns?e.block.body.txns.length:""),n(l,12,0,p["ɵunv"](l,12,0,n(l,13,0,p["ɵnov"](l.parent,1),e.block.body.txns)))})}function u(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==p["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==p["ɵnov"](n,2)._handleBlur()&&t}return t},f.y,f.z)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](1097728,null,2,m._71,[p.Renderer2,p.ElementRef,[2,m._70],[2,m._72]],null,null),p["ɵqud"](603979776,1,{_lines:1}),p["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),p["ɵted"](2,["\n          "])),(n()(),p["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[1,4]],0,m._111,[],null,null),(n()(),p["ɵted"](null,["",""])),(n()(),p["ɵted"](2,["\n        "]))],null,function(n,l){n(l,8,0,l.context.$implicit)})}function i(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==p["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==p["ɵnov"](n,2)._handleBlur()&&t}return t},f.y,f.z)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](1097728,null,2,m._71,[p.Renderer2,p.ElementRef,[2,m._70],[2,m._72]],null,null),p["ɵqud"](603979776,3,{_lines:1}),p["ɵqud"](335544320,4,{_hasAvatar:0}),(n()(),p["ɵted"](2,["\n          "])),(n()(),p["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[3,4]],0,m._111,[],null,null),(n()(),p["ɵted"](null,[""," ("," SC2)"])),(n()(),p["ɵted"](2,["\n        "]))],null,function(n,l){n(l,8,0,l.context.$implicit.dst,l.context.$implicit.coins)})}function r(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,23,"md-list",[["class","mat-list"],["role","list"]],null,null,null,f.C,f.D)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,m._70,[],null,null),p["ɵdid"](16384,null,0,m._113,[],null,null),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._112,[],null,null),(n()(),p["ɵted"](null,["Inputs"])),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵand"](16777216,null,0,1,null,u)),p["ɵdid"](802816,null,0,v.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵeld"](0,null,0,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,m._109,[],null,null),p["ɵdid"](16384,null,0,m._110,[],null,null),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._112,[],null,null),(n()(),p["ɵted"](null,["Outputs"])),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵand"](16777216,null,0,1,null,i)),p["ɵdid"](802816,null,0,v.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](0,["\n      "]))],function(n,l){n(l,10,0,l.parent.context.$implicit.inputs),n(l,22,0,l.parent.context.$implicit.outputs)},null)}function o(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,30,"md-expansion-panel",[["class","mat-expansion-panel"]],[[2,"mat-expanded",null],[2,"mat-expansion-panel-spacing",null]],null,null,f.E,f.F)),p["ɵprd"](6144,null,m._61,null,[m._62]),p["ɵdid"](704512,null,0,m._62,[[8,null],p.ChangeDetectorRef,m.f],null,null),(n()(),p["ɵted"](1,["\n      "])),(n()(),p["ɵeld"](0,null,0,11,"md-expansion-panel-header",[["class","mat-expansion-panel-header"],["role","button"]],[[1,"tabindex",0],[1,"aria-controls",0],[1,"aria-expanded",0],[1,"aria-disabled",0],[2,"mat-expanded",null],[40,"@expansionHeight",0]],[[null,"click"],[null,"keyup"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==p["ɵnov"](n,5)._toggle()&&t}if("keyup"===l){t=!1!==p["ɵnov"](n,5)._keyup(e)&&t}return t},f.G,f.H)),p["ɵdid"](180224,null,0,m._64,[m._62,p.Renderer2,p.ElementRef,m.a,p.ChangeDetectorRef],null,null),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,0,2,"md-panel-title",[["class","mat-expansion-panel-header-title"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._114,[],null,null),(n()(),p["ɵted"](null,["\n          ","\n        "])),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,1,3,"md-panel-description",[["class","mat-expansion-panel-header-description"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._115,[],null,null),(n()(),p["ɵted"](null,["\n          ","\n        "])),p["ɵppd"](1),(n()(),p["ɵted"](2,["\n      "])),(n()(),p["ɵted"](1,["\n      "])),(n()(),p["ɵand"](16777216,null,1,1,null,r)),p["ɵdid"](16384,null,0,v.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](1,["\n      "])),(n()(),p["ɵeld"](0,null,1,9,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n        "])),(n()(),p["ɵeld"](0,null,null,6,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0],[1,"target",0],[8,"href",4]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==p["ɵnov"](n,24)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==p["ɵnov"](n,26).onClick(e.button,e.ctrlKey,e.metaKey,e.shiftKey)&&t}return t},f.q,f.r)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](180224,null,0,m._38,[b.a,m.a,p.ElementRef,p.Renderer2],{color:[0,"color"]},null),p["ɵdid"](16384,null,0,m._107,[],null,null),p["ɵdid"](671744,null,0,h.B,[h.c,h.a,v.LocationStrategy],{routerLink:[0,"routerLink"]},null),p["ɵpad"](2),(n()(),p["ɵted"](0,["Details"])),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵted"](1,["\n    "]))],function(n,l){n(l,18,0,l.context.$implicit);n(l,24,0,"primary"),n(l,26,0,n(l,27,0,"/explorer/transaction/",l.context.$implicit.txid))},function(n,l){var e=l.component;n(l,0,0,p["ɵnov"](l,2).expanded,p["ɵnov"](l,2)._hasSpacing()),n(l,4,0,p["ɵnov"](l,5).panel.disabled?-1:0,p["ɵnov"](l,5)._getPanelId(),p["ɵnov"](l,5)._isExpanded(),p["ɵnov"](l,5).panel.disabled,p["ɵnov"](l,5)._isExpanded(),p["ɵnov"](l,5)._getExpandedState()),n(l,9,0,l.context.$implicit.txid),n(l,13,0,p["ɵunv"](l,13,0,n(l,14,0,p["ɵnov"](l.parent.parent,0),e.block.header.timestamp))),n(l,22,0,p["ɵnov"](l,24).disabled?-1:0,p["ɵnov"](l,24).disabled||null,p["ɵnov"](l,24).disabled.toString(),p["ɵnov"](l,26).target,p["ɵnov"](l,26).href)})}function a(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,4,"div",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n    "])),(n()(),p["ɵand"](16777216,null,null,1,null,o)),p["ɵdid"](802816,null,0,v.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](null,["\n  "]))],function(n,l){n(l,3,0,l.component.block.body.txns)},null)}function d(n){return p["ɵvid"](0,[p["ɵpid"](0,g.a,[]),p["ɵpid"](0,y.a,[]),(n()(),p["ɵeld"](0,null,null,39,"md-card",[["class","mat-card"]],null,null,null,f.w,f.x)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,m._42,[],null,null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,5,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,m._108,[],null,null),(n()(),p["ɵted"](null,["Block "," "])),(n()(),p["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,_.a,_.b)),p["ɵdid"](49152,null,0,x.a,[v.Location],null,null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,21,"md-card",[["class","skycoin-details mat-card"]],null,null,null,f.w,f.x)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,m._42,[],null,null),(n()(),p["ɵted"](0,["\n    "])),(n()(),p["ɵeld"](0,null,0,13,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      ID: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Timestamp: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Hash: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Parent hash: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Transactions: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Total value: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n    "])),(n()(),p["ɵted"](0,["\n    "])),(n()(),p["ɵand"](16777216,null,0,1,null,t)),p["ɵdid"](16384,null,0,v.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵted"](0,["\n\n  "])),(n()(),p["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["Transactions"])),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵand"](16777216,null,0,1,null,a)),p["ɵdid"](16384,null,0,v.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](0,["\n"])),(n()(),p["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,33,0,e.block),n(l,40,0,e.block&&e.block.body&&e.block.body.txns)},function(n,l){var e=l.component;n(l,9,0,e.block&&e.block.header?e.block.header.seq:"..")})}function c(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,1,"app-block",[],null,null,null,d,C)),p["ɵdid"](114688,null,0,k.a,[w.a,h.a],null,null)],function(n,l){n(l,1,0)},null)}var s=e("7zlW"),p=e("/oeL"),f=e("v6Q/"),m=e("Z04r"),v=e("qbdv"),b=e("JYHx"),h=e("BkNc"),g=e("lL01"),y=e("Y66J"),_=e("zVgQ"),x=e("/Tz7"),k=e("KwZO"),w=e("SJy7");e.d(l,"a",function(){return S});var R=[s.a],C=p["ɵcrt"]({encapsulation:0,styles:R,data:{}}),S=p["ɵccf"]("app-block",k.a,c,{},{},[])},A4rF:function(n,l,e){"use strict";var t=e("cP51"),u=e("Z04r"),i=e("3VAl"),r=e("Qm7a");e.d(l,"a",function(){return o});var o=function(){function n(n,l){this.walletService=n,this.dialog=l}return n.prototype.addAddress=function(){var n=this;this.walletService.addAddress(this.wallet).subscribe(function(l){return n.wallet.entries.push(l)})},n.prototype.renameWallet=function(){var n=this,l=new u.Q;l.width="500px",l.data=this.wallet,this.dialog.open(i.a,l).afterClosed().subscribe(function(l){l&&(n.wallet.meta.label=l)})},n.prototype.showQr=function(n){var l=new u.Q;l.data=n,this.dialog.open(r.a,l)},n.ctorParameters=function(){return[{type:t.a},{type:u.e}]},n}()},ApLd:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},"Aq/i":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-input-container[_ngcontent-%COMP%]{width:100%}"]},B4F0:function(n,l,e){"use strict";var t=e("bm2B"),u=e("cP51"),i=e("ScY5"),r=e("Z04r");e.d(l,"a",function(){return o});var o=function(){function n(n,l,e,t){this.walletService=n,this.dialogRef=l,this.formBuilder=e,this.purchaseService=t}return n.prototype.ngOnInit=function(){this.initForm()},n.prototype.generate=function(){var n=this;this.purchaseService.generate(this.form.value.address).subscribe(function(){return n.dialogRef.close()})},n.prototype.initForm=function(){this.form=this.formBuilder.group({address:["",t.k.required]})},n.ctorParameters=function(){return[{type:u.a},{type:r.R},{type:t.a},{type:i.a}]},n}()},BZkh:function(n,l,e){"use strict";var t=e("ScY5"),u=e("Z04r"),i=e("B4F0"),r=e("5ERs");e.d(l,"a",function(){return o});var o=function(){function n(n,l){this.purchaseService=n,this.dialog=l,this.orders=[],this.scanning=!1,this.otcEnabled=r.a.otcEnabled}return n.prototype.addDepositAddress=function(){var n=new u.Q;n.width="500px",this.dialog.open(i.a,n)},n.prototype.searchDepositAddress=function(n){var l=this;this.scanning=!0,this.purchaseService.scan(n).subscribe(function(){l.disableScanning()},function(n){l.disableScanning()})},n.prototype.disableScanning=function(){var n=this;setTimeout(function(){return n.scanning=!1},1e3)},n.ctorParameters=function(){return[{type:t.a},{type:u.e}]},n}()},BruE:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.transform=function(n){return null==n||n<0?"loading .. ":(n?n/1e6:0)+" SC2"},n}()},C2LC:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,25,"md-expansion-panel",[["class","mat-expansion-panel"]],[[2,"mat-expanded",null],[2,"mat-expansion-panel-spacing",null]],null,null,a.E,a.F)),o["ɵprd"](6144,null,d._61,null,[d._62]),o["ɵdid"](704512,null,0,d._62,[[8,null],o.ChangeDetectorRef,d.f],null,null),(n()(),o["ɵted"](1,["\n  "])),(n()(),o["ɵeld"](0,null,0,17,"md-expansion-panel-header",[["class","mat-expansion-panel-header"],["role","button"]],[[1,"tabindex",0],[1,"aria-controls",0],[1,"aria-expanded",0],[1,"aria-disabled",0],[2,"mat-expanded",null],[40,"@expansionHeight",0]],[[null,"click"],[null,"keyup"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==o["ɵnov"](n,5)._toggle()&&t}if("keyup"===l){t=!1!==o["ɵnov"](n,5)._keyup(e)&&t}return t},a.G,a.H)),o["ɵdid"](180224,null,0,d._64,[d._62,o.Renderer2,o.ElementRef,d.a,o.ChangeDetectorRef],null,null),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,0,2,"md-panel-title",[["class","mat-expansion-panel-header-title"]],null,null,null,null,null)),o["ɵdid"](16384,null,0,d._114,[],null,null),(n()(),o["ɵted"](null,["\n      ","\n    "])),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,1,9,"md-panel-description",[["class","mat-expansion-panel-header-description"]],null,null,null,null,null)),o["ɵdid"](16384,null,0,d._115,[],null,null),(n()(),o["ɵted"](null,["\n      "])),(n()(),o["ɵeld"](0,null,null,2,"span",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["",""])),o["ɵppd"](1),(n()(),o["ɵted"](null,[" "])),(n()(),o["ɵeld"](0,null,null,1,"span",[],null,null,null,null,null)),(n()(),o["ɵted"](null,[""," Coin Hours"])),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵted"](2,["\n  "])),(n()(),o["ɵted"](1,["\n  "])),(n()(),o["ɵeld"](0,null,1,1,"app-wallet-detail",[],null,null,null,c.a,c.b)),o["ɵdid"](49152,null,0,s.a,[p.a,d.e],{wallet:[0,"wallet"]},null),(n()(),o["ɵted"](1,["\n"]))],function(n,l){n(l,24,0,l.context.$implicit)},function(n,l){n(l,0,0,o["ɵnov"](l,2).expanded,o["ɵnov"](l,2)._hasSpacing()),n(l,4,0,o["ɵnov"](l,5).panel.disabled?-1:0,o["ɵnov"](l,5)._getPanelId(),o["ɵnov"](l,5)._isExpanded(),o["ɵnov"](l,5).panel.disabled,o["ɵnov"](l,5)._isExpanded(),o["ɵnov"](l,5)._getExpandedState()),n(l,9,0,l.context.$implicit.meta.label),n(l,15,0,o["ɵunv"](l,15,0,n(l,16,0,o["ɵnov"](l.parent,0),l.context.$implicit.balance))),n(l,19,0,l.context.$implicit.hours?l.context.$implicit.hours:0)})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,f.a,[]),(n()(),o["ɵand"](16777216,null,null,2,null,t)),o["ɵdid"](802816,null,0,m.NgForOf,[o.ViewContainerRef,o.TemplateRef,o.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),o["ɵpid"](131072,m.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,9)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.addWallet()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[b.a,d.a,o.ElementRef,o.Renderer2],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Add wallet"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,2,0,o["ɵunv"](l,2,0,o["ɵnov"](l,3).transform(e.walletService.all())));n(l,9,0,"primary")},function(n,l){n(l,7,0,o["ɵnov"](l,9).disabled?-1:0,o["ɵnov"](l,9).disabled||null,o["ɵnov"](l,9).disabled.toString())})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-wallets",[],null,null,null,u,g)),o["ɵdid"](49152,null,0,v.a,[p.a,d.e],null,null)],null,null)}var r=e("k9Ay"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("Mjq+"),s=e("A4rF"),p=e("cP51"),f=e("BruE"),m=e("qbdv"),v=e("5WMG"),b=e("JYHx");e.d(l,"a",function(){return y});var h=[r.a],g=o["ɵcrt"]({encapsulation:0,styles:h,data:{}}),y=o["ɵccf"]("app-wallets",v.a,i,{},{},[])},Caxp:function(n,l,e){"use strict";var t=e("ge1L");e.n(t);e.d(l,"a",function(){return u});var u=function(){function n(){}return n.prototype.transform=function(n){return t.unix(n).fromNow()},n}()},CpEn:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-icon[_ngcontent-%COMP%]{margin-left:10px;opacity:.3}md-spinner[_ngcontent-%COMP%]{display:inline-block;height:24px!important;width:24px!important;margin-left:10px}"]},DtrN:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},HHiG:function(n,l,e){"use strict";var t=e("cP51"),u=e("ge1L"),i=(e.n(u),e("BkNc"));e.d(l,"a",function(){return r});var r=function(){function n(n,l){this.walletService=n,this.router=l}return n.prototype.ngOnInit=function(){var n=this;this.walletService.pendingTransactions().subscribe(function(l){n.transactions=n.mapTransactions(l)})},n.prototype.onActivate=function(n){n.row&&n.row.txid&&this.router.navigate(["/history",n.row.txid])},n.prototype.mapTransactions=function(n){return n.map(function(n){return n.transaction.timestamp=u(n.received).unix(),n.transaction}).map(function(n){return n.amount=n.outputs.map(function(n){return n.coins>=0?n.coins:0}).reduce(function(n,l){return n+parseInt(l)},0),n})},n.ctorParameters=function(){return[{type:t.a},{type:i.c}]},n}()},IFVI:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-card[_ngcontent-%COMP%], ngx-datatable[_ngcontent-%COMP%]{margin-bottom:20px}md-card[_ngcontent-%COMP%]   h3[_ngcontent-%COMP%]   button[_ngcontent-%COMP%]{display:inline;float:right;margin-top:-8px}"]},KbYb:function(n,l,e){"use strict";var t=e("cP51");e.d(l,"a",function(){return u});var u=function(){function n(n){this.walletService=n}return n.prototype.ngOnInit=function(){var n=this;this.walletService.outputs().subscribe(function(l){return n.outputs=l.head_outputs})},n.ctorParameters=function(){return[{type:t.a}]},n}()},KwZO:function(n,l,e){"use strict";var t=e("SJy7"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.blockchainService=n,this.route=l}return n.prototype.ngOnInit=function(){var n=this;this.route.params.switchMap(function(l){return n.blockchainService.block(l.block)}).subscribe(function(l){n.block=l})},n.ctorParameters=function(){return[{type:t.a},{type:u.a}]},n}()},Lx3X:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-icon[_ngcontent-%COMP%]{cursor:pointer}"]},"Mjq+":function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,13,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==o["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==o["ɵnov"](n,2)._handleBlur()&&t}return t},a.y,a.z)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](1097728,null,2,d._71,[o.Renderer2,o.ElementRef,[2,d._70],[2,d._72]],null,null),o["ɵqud"](603979776,1,{_lines:1}),o["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,0,1,"i",[["class","fa fa-qrcode mat-list-icon"],["md-list-icon",""]],null,[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.showQr(n.context.$implicit)&&t}return t},null,null)),o["ɵdid"](16384,null,0,d._116,[],null,null),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,1,3,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],0,d._111,[],null,null),(n()(),o["ɵted"](null,[""," - "," ("," Coin Hours)"])),o["ɵppd"](1),(n()(),o["ɵted"](2,["\n  "]))],null,function(n,l){n(l,11,0,l.context.$implicit.address,o["ɵunv"](l,11,1,n(l,12,0,o["ɵnov"](l.parent,0),l.context.$implicit.balance)),l.context.$implicit.hours?l.context.$implicit.hours:0)})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,c.a,[]),(n()(),o["ɵeld"](0,null,null,26,"md-list",[["class","mat-list"],["role","list"]],null,null,null,a.C,a.D)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,d._70,[],null,null),o["ɵdid"](16384,null,0,d._113,[],null,null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),o["ɵdid"](16384,null,0,d._112,[],null,null),(n()(),o["ɵted"](null,["Addresses"])),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵand"](16777216,null,0,1,null,t)),o["ɵdid"](802816,null,0,s.NgForOf,[o.ViewContainerRef,o.TemplateRef,o.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,13,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,17)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.renameWallet()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[f.a,d.a,o.ElementRef,o.Renderer2],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Rename wallet"])),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,23)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.addAddress()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[f.a,d.a,o.ElementRef,o.Renderer2],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Add address"])),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](0,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){n(l,11,0,l.component.wallet.entries);n(l,17,0,"primary");n(l,23,0,"primary")},function(n,l){n(l,15,0,o["ɵnov"](l,17).disabled?-1:0,o["ɵnov"](l,17).disabled||null,o["ɵnov"](l,17).disabled.toString()),n(l,21,0,o["ɵnov"](l,23).disabled?-1:0,o["ɵnov"](l,23).disabled||null,o["ɵnov"](l,23).disabled.toString())})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-wallet-detail",[],null,null,null,u,b)),o["ɵdid"](49152,null,0,p.a,[m.a,d.e],null,null)],null,null)}var r=e("Lx3X"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("BruE"),s=e("qbdv"),p=e("A4rF"),f=e("JYHx"),m=e("cP51");e.d(l,"b",function(){return b}),l.a=u;var v=[r.a],b=o["ɵcrt"]({encapsulation:0,styles:v,data:{}});o["ɵccf"]("app-wallet-detail",p.a,i,{wallet:"wallet"},{},[])},"NED+":function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵted"](null,["\n      "])),(n()(),o["ɵeld"](0,null,null,4,"button",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.download(n.context.row)&&t}return t},a.e,a.f)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d.V,[o.Renderer2,o.ElementRef,c.a,d.a],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Download"])),(n()(),o["ɵted"](null,["\n    "]))],function(n,l){n(l,3,0,"primary")},function(n,l){n(l,1,0,o["ɵnov"](l,3).disabled||null)})}function u(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,9,"md-card",[["class","mat-card"]],null,null,null,a.w,a.x)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,d._42,[],null,null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,1,"p",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["Wallet Directory: ",""])),(n()(),o["ɵted"](0,["\n\n  "])),(n()(),o["ɵeld"](0,null,0,1,"p",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["BACKUP YOUR SEED. ON PAPER. IN A SAFE PLACE. As long as you have your seed, you can recover your coins."])),(n()(),o["ɵted"](0,["\n"])),(n()(),o["ɵted"](null,["\n\n"])),(n()(),o["ɵeld"](0,null,null,25,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==o["ɵnov"](n,12).onWindowResize()&&t}return t},s.a,s.b)),o["ɵdid"](4571136,[["table",4]],3,p.DatatableComponent,[f.ScrollbarHelper,o.ElementRef,o.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},null),o["ɵqud"](603979776,1,{columnTemplates:1}),o["ɵqud"](335544320,2,{rowDetail:0}),o["ɵqud"](335544320,3,{footer:0}),o["ɵpid"](131072,m.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Wallet label"],["prop","meta.label"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,v.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,4,{cellTemplate:0}),o["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","File name"],["prop","meta.filename"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,v.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,6,{cellTemplate:0}),o["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Download"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,v.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),o["ɵqud"](335544320,8,{cellTemplate:0}),o["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵand"](0,[[8,2]],null,1,null,t)),o["ɵdid"](16384,null,0,b.DataTableColumnCellDirective,[o.TemplateRef],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,12,0,o["ɵunv"](l,12,0,o["ɵnov"](l,16).transform(e.walletService.all())),!0,50,"flex",50,50,10);n(l,19,0,"Wallet label","meta.label",1);n(l,24,0,"File name","meta.filename",1);n(l,29,0,"Download",1)},function(n,l){n(l,5,0,l.component.folder),n(l,11,0,o["ɵnov"](l,12).isFixedHeader,o["ɵnov"](l,12).isFixedRow,o["ɵnov"](l,12).isVertScroll,o["ɵnov"](l,12).isHorScroll,o["ɵnov"](l,12).isSelectable,o["ɵnov"](l,12).isCheckboxSelection,o["ɵnov"](l,12).isCellSelection,o["ɵnov"](l,12).isSingleSelection,o["ɵnov"](l,12).isMultiSelection,o["ɵnov"](l,12).isMultiClickSelection)})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-backup",[],null,null,null,u,_)),o["ɵdid"](245760,null,0,h.a,[g.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("a9x3"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("LwgZ"),p=e("f2O0"),f=(e.n(p),e("7i8B")),m=(e.n(f),e("qbdv")),v=e("47oE"),b=(e.n(v),e("GHh8")),h=(e.n(b),e("WZ1N")),g=e("cP51");e.d(l,"a",function(){return x});var y=[r.a],_=o["ɵcrt"]({encapsulation:0,styles:y,data:{}}),x=o["ɵccf"]("app-backup",h.a,i,{},{},[])},ONOE:function(n,l,e){"use strict";var t=e("SJy7"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.blockchainService=n,this.route=l}return n.prototype.ngOnInit=function(){var n=this;this.route.params.switchMap(function(l){return n.id=l.address,n.blockchainService.addressTransactions(l.address)}).subscribe(function(l){n.transactions=l,console.log(l)}),this.route.params.switchMap(function(l){return n.blockchainService.addressBalance(l.address)}).subscribe(function(l){n.balance=l,console.log(l)})},n.ctorParameters=function(){return[{type:t.a},{type:u.a}]},n}()},PRn9:function(n,l,e){"use strict";var t=e("dSyq");e.d(l,"a",function(){return u});var u=function(){function n(n){this.networkService=n}return n.prototype.ngOnInit=function(){var n=this;this.networkService.automatic().subscribe(function(n){return console.log(n)}),this.networkService.retrieveDefaultConnections().first().subscribe(function(l){return n.defaultConnections=l})},n.ctorParameters=function(){return[{type:t.a}]},n}()},Pdzc:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-input-container[_ngcontent-%COMP%]{width:100%}"]},QAaR:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}"]},Qgkl:function(n,l,e){"use strict";function t(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,6,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      "," "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      "," "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      ","\n    "])),s["ɵppd"](1)],null,function(n,l){var e=l.component;n(l,1,0,e.id),n(l,3,0,e.transactions.length),n(l,5,0,s["ɵunv"](l,5,0,n(l,6,0,s["ɵnov"](l.parent,0),e.transactions)))})}function u(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==s["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==s["ɵnov"](n,2)._handleBlur()&&t}return t},p.y,p.z)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](1097728,null,2,f._71,[s.Renderer2,s.ElementRef,[2,f._70],[2,f._72]],null,null),s["ɵqud"](603979776,1,{_lines:1}),s["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),s["ɵted"](2,["\n        "])),(n()(),s["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),s["ɵdid"](16384,[[1,4]],0,f._111,[],null,null),(n()(),s["ɵted"](null,["",""])),(n()(),s["ɵted"](2,["\n      "]))],null,function(n,l){n(l,8,0,l.context.$implicit.owner)})}function i(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==s["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==s["ɵnov"](n,2)._handleBlur()&&t}return t},p.y,p.z)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](1097728,null,2,f._71,[s.Renderer2,s.ElementRef,[2,f._70],[2,f._72]],null,null),s["ɵqud"](603979776,3,{_lines:1}),s["ɵqud"](335544320,4,{_hasAvatar:0}),(n()(),s["ɵted"](2,["\n        "])),(n()(),s["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),s["ɵdid"](16384,[[3,4]],0,f._111,[],null,null),(n()(),s["ɵted"](null,[""," ("," SC2)"])),(n()(),s["ɵted"](2,["\n      "]))],null,function(n,l){n(l,8,0,l.context.$implicit.dst,l.context.$implicit.coins)})}function r(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,23,"md-list",[["class","mat-list"],["role","list"]],null,null,null,p.C,p.D)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](49152,null,0,f._70,[],null,null),s["ɵdid"](16384,null,0,f._113,[],null,null),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._112,[],null,null),(n()(),s["ɵted"](null,["Inputs"])),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵand"](16777216,null,0,1,null,u)),s["ɵdid"](802816,null,0,m.NgForOf,[s.ViewContainerRef,s.TemplateRef,s.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵeld"](0,null,0,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](16384,null,0,f._109,[],null,null),s["ɵdid"](16384,null,0,f._110,[],null,null),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._112,[],null,null),(n()(),s["ɵted"](null,["Outputs"])),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵand"](16777216,null,0,1,null,i)),s["ɵdid"](802816,null,0,m.NgForOf,[s.ViewContainerRef,s.TemplateRef,s.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),s["ɵted"](0,["\n    "]))],function(n,l){n(l,10,0,l.parent.context.$implicit.inputs),n(l,22,0,l.parent.context.$implicit.outputs)},null)}function o(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,30,"md-expansion-panel",[["class","mat-expansion-panel"]],[[2,"mat-expanded",null],[2,"mat-expansion-panel-spacing",null]],null,null,p.E,p.F)),s["ɵprd"](6144,null,f._61,null,[f._62]),s["ɵdid"](704512,null,0,f._62,[[8,null],s.ChangeDetectorRef,f.f],null,null),(n()(),s["ɵted"](1,["\n    "])),(n()(),s["ɵeld"](0,null,0,11,"md-expansion-panel-header",[["class","mat-expansion-panel-header"],["role","button"]],[[1,"tabindex",0],[1,"aria-controls",0],[1,"aria-expanded",0],[1,"aria-disabled",0],[2,"mat-expanded",null],[40,"@expansionHeight",0]],[[null,"click"],[null,"keyup"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==s["ɵnov"](n,5)._toggle()&&t}if("keyup"===l){t=!1!==s["ɵnov"](n,5)._keyup(e)&&t}return t},p.G,p.H)),s["ɵdid"](180224,null,0,f._64,[f._62,s.Renderer2,s.ElementRef,f.a,s.ChangeDetectorRef],null,null),(n()(),s["ɵted"](2,["\n      "])),(n()(),s["ɵeld"](0,null,0,2,"md-panel-title",[["class","mat-expansion-panel-header-title"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._114,[],null,null),(n()(),s["ɵted"](null,["\n        ","\n      "])),(n()(),s["ɵted"](2,["\n      "])),(n()(),s["ɵeld"](0,null,1,3,"md-panel-description",[["class","mat-expansion-panel-header-description"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._115,[],null,null),(n()(),s["ɵted"](null,["\n        "])),(n()(),s["ɵted"](null,["\n      "])),(n()(),s["ɵted"](2,["\n    "])),(n()(),s["ɵted"](1,["\n    "])),(n()(),s["ɵand"](16777216,null,1,1,null,r)),s["ɵdid"](16384,null,0,m.NgIf,[s.ViewContainerRef,s.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),s["ɵted"](1,["\n    "])),(n()(),s["ɵeld"](0,null,1,9,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      "])),(n()(),s["ɵeld"](0,null,null,6,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0],[1,"target",0],[8,"href",4]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==s["ɵnov"](n,24)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==s["ɵnov"](n,26).onClick(e.button,e.ctrlKey,e.metaKey,e.shiftKey)&&t}return t},p.q,p.r)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](180224,null,0,f._38,[v.a,f.a,s.ElementRef,s.Renderer2],{color:[0,"color"]},null),s["ɵdid"](16384,null,0,f._107,[],null,null),s["ɵdid"](671744,null,0,b.B,[b.c,b.a,m.LocationStrategy],{routerLink:[0,"routerLink"]},null),s["ɵpad"](2),(n()(),s["ɵted"](0,["Details"])),(n()(),s["ɵted"](null,["\n    "])),(n()(),s["ɵted"](1,["\n  "]))],function(n,l){n(l,18,0,l.context.$implicit);n(l,24,0,"primary"),n(l,26,0,n(l,27,0,"/explorer/transaction/",l.context.$implicit.txid))},function(n,l){n(l,0,0,s["ɵnov"](l,2).expanded,s["ɵnov"](l,2)._hasSpacing()),n(l,4,0,s["ɵnov"](l,5).panel.disabled?-1:0,s["ɵnov"](l,5)._getPanelId(),s["ɵnov"](l,5)._isExpanded(),s["ɵnov"](l,5).panel.disabled,s["ɵnov"](l,5)._isExpanded(),s["ɵnov"](l,5)._getExpandedState()),n(l,9,0,l.context.$implicit.txid),n(l,22,0,s["ɵnov"](l,24).disabled?-1:0,s["ɵnov"](l,24).disabled||null,s["ɵnov"](l,24).disabled.toString(),s["ɵnov"](l,26).target,s["ɵnov"](l,26).href)})}function a(n){return s["ɵvid"](0,[s["ɵpid"](0,h.a,[]),(n()(),s["ɵeld"](0,null,null,33,"md-card",[["class","mat-card"]],null,null,null,p.w,p.x)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵeld"](0,null,0,5,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](16384,null,0,f._108,[],null,null),(n()(),s["ɵted"](null,["Address "])),(n()(),s["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,g.a,g.b)),s["ɵdid"](49152,null,0,y.a,[m.Location],null,null),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵeld"](0,null,0,15,"md-card",[["class","skycoin-details mat-card"]],null,null,null,p.w,p.x)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),s["ɵted"](0,["\n    "])),(n()(),s["ɵeld"](0,null,0,7,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      ID: "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      Transactions: "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      Balance: "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n    "])),(n()(),s["ɵted"](0,["\n    "])),(n()(),s["ɵand"](16777216,null,0,1,null,t)),s["ɵdid"](16384,null,0,m.NgIf,[s.ViewContainerRef,s.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵted"](0,["\n\n  "])),(n()(),s["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["Transactions"])),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵand"](16777216,null,0,1,null,o)),s["ɵdid"](802816,null,0,m.NgForOf,[s.ViewContainerRef,s.TemplateRef,s.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),s["ɵted"](0,["\n"])),(n()(),s["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,26,0,e.transactions),n(l,33,0,e.transactions)},null)}function d(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,1,"app-address",[],null,null,null,a,w)),s["ɵdid"](114688,null,0,_.a,[x.a,b.a],null,null)],function(n,l){n(l,1,0)},null)}var c=e("DtrN"),s=e("/oeL"),p=e("v6Q/"),f=e("Z04r"),m=e("qbdv"),v=e("JYHx"),b=e("BkNc"),h=e("Y66J"),g=e("zVgQ"),y=e("/Tz7"),_=e("ONOE"),x=e("SJy7");e.d(l,"a",function(){return R});var k=[c.a],w=s["ɵcrt"]({encapsulation:0,styles:k,data:{}}),R=s["ɵccf"]("app-address",_.a,d,{},{},[])},Qm7a:function(n,l,e){"use strict";var t=e("/oeL"),u=e("Z04r");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.data=n,this.el=l,this.size=300,this.level="M",this.colordark="#000000",this.colorlight="#ffffff",this.usesvg=!1}return n.prototype.ngOnInit=function(){new QRCode(this.qr.nativeElement,{text:this.data.address,width:this.size,height:this.size,colorDark:this.colordark,colorLight:this.colorlight,useSVG:this.usesvg,correctLevel:QRCode.CorrectLevel[this.level.toString()]})},n.ctorParameters=function(){return[{type:void 0,decorators:[{type:t.Inject,args:[u._103]}]},{type:t.ElementRef}]},n}()},Qoso:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,3,"md-icon",[["class","mat-icon"],["role","img"]],null,null,null,c.A,c.B)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](638976,null,0,s._48,[d.Renderer2,d.ElementRef,s.h,[8,null]],null,null),(n()(),d["ɵted"](0,["done"]))],function(n,l){n(l,2,0)},null)}function u(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,3,"md-icon",[["class","mat-icon"],["role","img"]],null,null,null,c.A,c.B)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](638976,null,0,s._48,[d.Renderer2,d.ElementRef,s.h,[8,null]],null,null),(n()(),d["ɵted"](0,["error"]))],function(n,l){n(l,2,0)},null)}function i(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,2,"md-spinner",[["class","in-button mat-spinner mat-progress-spinner"],["mode","indeterminate"],["role","progressbar"]],null,null,null,c.I,c.J)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s._80,[d.ElementRef,d.NgZone,d.Renderer2],null,null)],null,null)}function r(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](16777216,null,null,14,"button",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""],["type","submit"]],[[8,"disabled",0]],[[null,"longpress"],[null,"touchend"]],function(n,l,e){var t=!0;if("longpress"===l){t=!1!==d["ɵnov"](n,4).show()&&t}if("touchend"===l){t=!1!==d["ɵnov"](n,4).hide(1500)&&t}return t},c.e,c.f)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s.V,[d.Renderer2,d.ElementRef,p.a,s.a],{disabled:[0,"disabled"],color:[1,"color"]},null),d["ɵdid"](16384,null,0,s._107,[],null,null),d["ɵdid"](147456,null,0,s._77,[f.e,d.ElementRef,m.a,d.ViewContainerRef,d.NgZone,d.Renderer2,p.a,s.o,[2,v.b]],{message:[0,"message"]},null),(n()(),d["ɵted"](0,["\n  ","\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,t)),d["ɵdid"](16384,null,0,b.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,u)),d["ɵdid"](16384,null,0,b.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,i)),d["ɵdid"](16384,null,0,b.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,2,0,e.disabled(),"primary"),n(l,4,0,e.error?e.error:null),n(l,7,0,1===e.state),n(l,10,0,2===e.state),n(l,13,0,0===e.state)},function(n,l){var e=l.component;n(l,0,0,d["ɵnov"](l,2).disabled||null),n(l,5,0,e.placeholder)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-button",[],null,null,null,r,y)),d["ɵdid"](49152,null,0,h.a,[],null,null)],null,null)}var a=e("CpEn"),d=e("/oeL"),c=e("v6Q/"),s=e("Z04r"),p=e("JYHx"),f=e("z1Gz"),m=e("vVgA"),v=e("UPmf"),b=e("qbdv"),h=e("boXM");e.d(l,"b",function(){return y}),l.a=r;var g=[a.a],y=d["ɵcrt"]({encapsulation:0,styles:g,data:{}});d["ɵccf"]("app-button",h.a,o,{form:"form",placeholder:"placeholder"},{},[])},RMl1:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,4,"md-option",[["class","mat-option"],["role","option"]],[[1,"tabindex",0],[2,"mat-selected",null],[2,"mat-option-multiple",null],[2,"mat-active",null],[8,"id",0],[1,"aria-selected",0],[1,"aria-disabled",0],[2,"mat-option-disabled",null]],[[null,"click"],[null,"keydown"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,2)._selectViaInteraction()&&t}if("keydown"===l){t=!1!==d["ɵnov"](n,2)._handleKeydown(e)&&t}return t},c.s,c.t)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,[[2,4]],0,s._35,[d.ElementRef,d.ChangeDetectorRef,[2,s._36],[2,s.U]],{value:[0,"value"],disabled:[1,"disabled"]},null),(n()(),d["ɵted"](0,["\n      "," (",")\n    "])),d["ɵppd"](1)],function(n,l){n(l,2,0,l.context.$implicit,l.context.$implicit.balance<=0)},function(n,l){n(l,0,0,d["ɵnov"](l,2)._getTabIndex(),d["ɵnov"](l,2).selected,d["ɵnov"](l,2).multiple,d["ɵnov"](l,2).active,d["ɵnov"](l,2).id,d["ɵnov"](l,2).selected.toString(),d["ɵnov"](l,2).disabled.toString(),d["ɵnov"](l,2).disabled),n(l,3,0,l.context.$implicit.meta.label,d["ɵunv"](l,3,1,n(l,4,0,d["ɵnov"](l.parent,0),l.context.$implicit.balance)))})}function u(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n        "])),(n()(),d["ɵeld"](0,null,null,2,"strong",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["",""])),d["ɵppd"](1),(n()(),d["ɵted"](null,["\n      "]))],null,function(n,l){n(l,2,0,d["ɵunv"](l,2,0,n(l,3,0,d["ɵnov"](l.parent,1),l.context.value)))})}function i(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n        "])),(n()(),d["ɵeld"](0,null,null,1,"strong",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["",""])),(n()(),d["ɵted"](null,["\n      "]))],null,function(n,l){n(l,2,0,l.context.value.confirmed?"Confirmed":"Unconfirmed")})}function r(n){return d["ɵvid"](0,[d["ɵpid"](0,p.a,[]),d["ɵpid"](0,f.a,[]),d["ɵqud"](402653184,1,{button:0}),(n()(),d["ɵeld"](0,null,null,70,"md-card",[["class","send-skycoin-form mat-card"]],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==d["ɵnov"](n,6).onSubmit(e)&&t}if("reset"===l){t=!1!==d["ɵnov"](n,6).onReset()&&t}return t},c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),d["ɵdid"](540672,null,0,m.i,[[8,null],[8,null]],{form:[0,"form"]},null),d["ɵprd"](2048,null,m.l,null,[m.i]),d["ɵdid"](16384,null,0,m.m,[m.l],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,13,"md-select",[["class","input-field mat-select"],["formControlName","wallet"],["placeholder","Select Wallet"],["role","listbox"]],[[1,"tabindex",0],[1,"aria-label",0],[1,"aria-labelledby",0],[1,"aria-required",0],[1,"aria-disabled",0],[1,"aria-invalid",0],[1,"aria-owns",0],[1,"aria-multiselectable",0],[2,"mat-select-disabled",null],[2,"mat-select-invalid",null],[2,"mat-select-required",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"keydown"],[null,"blur"]],function(n,l,e){var t=!0;if("keydown"===l){t=!1!==d["ɵnov"](n,14)._handleClosedKeydown(e)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,14)._onBlur()&&t}return t},c.u,c.v)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](671744,null,0,m.p,[[3,m.l],[8,null],[8,null],[8,null]],{name:[0,"name"]},null),d["ɵprd"](2048,null,m.j,null,[m.p]),d["ɵdid"](1294336,null,3,s._75,[v.c,d.ChangeDetectorRef,b.e,h.a,d.Renderer2,d.ElementRef,[2,g.b],[2,m.h],[2,m.i],[2,m.j],[8,null],[2,s._66],s.l],{placeholder:[0,"placeholder"]},null),d["ɵqud"](603979776,2,{options:1}),d["ɵqud"](603979776,3,{optionGroups:1}),d["ɵqud"](335544320,4,{customTrigger:0}),d["ɵdid"](16384,null,0,m.q,[m.j],null,null),(n()(),d["ɵted"](1,["\n    "])),(n()(),d["ɵand"](16777216,null,1,2,null,t)),d["ɵdid"](802816,null,0,y.NgForOf,[d.ViewContainerRef,d.TemplateRef,d.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),d["ɵpid"](131072,y.AsyncPipe,[d.ChangeDetectorRef]),(n()(),d["ɵted"](1,["\n  "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,19,"md-input-container",[["class","input-field mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,27)._control.focus()&&t}return t},c.o,c.p)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](7389184,null,6,s._65,[d.ElementRef,d.ChangeDetectorRef,[2,s._66]],null,null),d["ɵqud"](335544320,5,{_control:0}),d["ɵqud"](335544320,6,{_placeholderChild:0}),d["ɵqud"](603979776,7,{_errorChildren:1}),d["ɵqud"](603979776,8,{_hintChildren:1}),d["ɵqud"](603979776,9,{_prefixChildren:1}),d["ɵqud"](603979776,10,{_suffixChildren:1}),(n()(),d["ɵted"](1,["\n    "])),(n()(),d["ɵeld"](0,null,1,8,"input",[["class","mat-input-element"],["formControlName","address"],["mdInput",""],["placeholder","Recipient address"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==d["ɵnov"](n,37)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,37).onTouched()&&t}if("compositionstart"===l){t=!1!==d["ɵnov"](n,37)._compositionStart()&&t}if("compositionend"===l){t=!1!==d["ɵnov"](n,37)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,41)._focusChanged(!1)&&t}if("focus"===l){t=!1!==d["ɵnov"](n,41)._focusChanged(!0)&&t}if("input"===l){t=!1!==d["ɵnov"](n,41)._onInput()&&t}return t},null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,m.n,[d.Renderer2,d.ElementRef,[2,m.o]],null,null),d["ɵprd"](1024,null,m.e,function(n){return[n]},[m.n]),d["ɵdid"](671744,null,0,m.p,[[3,m.l],[8,null],[8,null],[2,m.e]],{name:[0,"name"]},null),d["ɵprd"](2048,null,m.j,null,[m.p]),d["ɵdid"](933888,null,0,s._104,[d.ElementRef,d.Renderer2,h.a,[2,m.j],[2,m.h],[2,m.i],[2,s._105]],{placeholder:[0,"placeholder"]},null),d["ɵdid"](16384,null,0,m.q,[m.j],null,null),d["ɵprd"](2048,[[5,4]],s._106,null,[s._104]),(n()(),d["ɵted"](1,["\n  "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,20,"md-input-container",[["class","input-field mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,48)._control.focus()&&t}return t},c.o,c.p)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](7389184,null,6,s._65,[d.ElementRef,d.ChangeDetectorRef,[2,s._66]],null,null),d["ɵqud"](335544320,11,{_control:0}),d["ɵqud"](335544320,12,{_placeholderChild:0}),d["ɵqud"](603979776,13,{_errorChildren:1}),d["ɵqud"](603979776,14,{_hintChildren:1}),d["ɵqud"](603979776,15,{_prefixChildren:1}),d["ɵqud"](603979776,16,{_suffixChildren:1}),(n()(),d["ɵted"](1,["\n    "])),(n()(),d["ɵeld"](0,null,1,9,"input",[["class","mat-input-element"],["formControlName","amount"],["mdInput",""],["placeholder","Amount"],["type","number"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"change"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==d["ɵnov"](n,58)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,58).onTouched()&&t}if("compositionstart"===l){t=!1!==d["ɵnov"](n,58)._compositionStart()&&t}if("compositionend"===l){t=!1!==d["ɵnov"](n,58)._compositionEnd(e.target.value)&&t}if("change"===l){t=!1!==d["ɵnov"](n,59).onChange(e.target.value)&&t}if("input"===l){t=!1!==d["ɵnov"](n,59).onChange(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,59).onTouched()&&t}if("blur"===l){t=!1!==d["ɵnov"](n,63)._focusChanged(!1)&&t}if("focus"===l){t=!1!==d["ɵnov"](n,63)._focusChanged(!0)&&t}if("input"===l){t=!1!==d["ɵnov"](n,63)._onInput()&&t}return t},null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,m.n,[d.Renderer2,d.ElementRef,[2,m.o]],null,null),d["ɵdid"](16384,null,0,m.r,[d.Renderer2,d.ElementRef],null,null),d["ɵprd"](1024,null,m.e,function(n,l){return[n,l]},[m.n,m.r]),d["ɵdid"](671744,null,0,m.p,[[3,m.l],[8,null],[8,null],[2,m.e]],{name:[0,"name"]},null),d["ɵprd"](2048,null,m.j,null,[m.p]),d["ɵdid"](933888,null,0,s._104,[d.ElementRef,d.Renderer2,h.a,[2,m.j],[2,m.h],[2,m.i],[2,s._105]],{placeholder:[0,"placeholder"],type:[1,"type"]},null),d["ɵdid"](16384,null,0,m.q,[m.j],null,null),d["ɵprd"](2048,[[11,4]],s._106,null,[s._104]),(n()(),d["ɵted"](1,["\n  "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,4,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,1,"app-button",[["placeholder","Send"]],null,[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.send()&&t}return t},x.a,x.b)),d["ɵdid"](49152,[[1,4],["button",4]],0,k.a,[],{form:[0,"form"],placeholder:[1,"placeholder"]},null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n"])),(n()(),d["ɵted"](null,["\n"])),(n()(),d["ɵeld"](0,null,null,41,"md-card",[["class","mat-card"]],null,null,null,c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["Recent transactions"])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,33,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==d["ɵnov"](n,84).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},w.a,w.b)),d["ɵdid"](4571136,[["table",4]],3,R.DatatableComponent,[C.ScrollbarHelper,d.ElementRef,d.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),d["ɵqud"](603979776,17,{columnTemplates:1}),d["ɵqud"](335544320,18,{rowDetail:0}),d["ɵqud"](335544320,19,{footer:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","txn.timestamp"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,20,{cellTemplate:0}),d["ɵqud"](335544320,21,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n      "])),(n()(),d["ɵand"](0,[[20,2]],null,1,null,u)),d["ɵdid"](16384,null,0,T.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Status"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),d["ɵqud"](335544320,22,{cellTemplate:0}),d["ɵqud"](335544320,23,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n      "])),(n()(),d["ɵand"](0,[[22,2]],null,1,null,i)),d["ɵdid"](16384,null,0,T.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),d["ɵqud"](335544320,24,{cellTemplate:0}),d["ɵqud"](335544320,25,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Amount"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),d["ɵqud"](335544320,26,{cellTemplate:0}),d["ɵqud"](335544320,27,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,6,0,e.form);n(l,12,0,"wallet");n(l,14,0,"Select Wallet"),n(l,21,0,d["ɵunv"](l,21,0,d["ɵnov"](l,22).transform(e.walletService.all())));n(l,39,0,"address");n(l,41,0,"Recipient address");n(l,61,0,"amount");n(l,63,0,"Amount","number");n(l,71,0,e.form,"Send");n(l,84,0,e.records,!0,50,"flex",50,50,10);n(l,90,0,"Timestamp","txn.timestamp",2);n(l,99,0,"Status",2);n(l,108,0,"Address",4);n(l,113,0,"Amount",1)},function(n,l){n(l,3,0,d["ɵnov"](l,8).ngClassUntouched,d["ɵnov"](l,8).ngClassTouched,d["ɵnov"](l,8).ngClassPristine,d["ɵnov"](l,8).ngClassDirty,d["ɵnov"](l,8).ngClassValid,d["ɵnov"](l,8).ngClassInvalid,d["ɵnov"](l,8).ngClassPending),n(l,10,1,[d["ɵnov"](l,14).tabIndex,d["ɵnov"](l,14)._ariaLabel,d["ɵnov"](l,14).ariaLabelledby,d["ɵnov"](l,14).required.toString(),d["ɵnov"](l,14).disabled.toString(),d["ɵnov"](l,14)._isErrorState(),d["ɵnov"](l,14)._optionIds,d["ɵnov"](l,14).multiple,d["ɵnov"](l,14).disabled,d["ɵnov"](l,14)._isErrorState(),d["ɵnov"](l,14).required,d["ɵnov"](l,18).ngClassUntouched,d["ɵnov"](l,18).ngClassTouched,d["ɵnov"](l,18).ngClassPristine,d["ɵnov"](l,18).ngClassDirty,d["ɵnov"](l,18).ngClassValid,d["ɵnov"](l,18).ngClassInvalid,d["ɵnov"](l,18).ngClassPending]),n(l,25,0,d["ɵnov"](l,27)._control.errorState,d["ɵnov"](l,27)._control.errorState,d["ɵnov"](l,27)._control.focused,d["ɵnov"](l,27)._shouldForward("untouched"),d["ɵnov"](l,27)._shouldForward("touched"),d["ɵnov"](l,27)._shouldForward("pristine"),d["ɵnov"](l,27)._shouldForward("dirty"),d["ɵnov"](l,27)._shouldForward("valid"),d["ɵnov"](l,27)._shouldForward("invalid"),d["ɵnov"](l,27)._shouldForward("pending")),n(l,35,1,[d["ɵnov"](l,41).id,d["ɵnov"](l,41).placeholder,d["ɵnov"](l,41).disabled,d["ɵnov"](l,41).required,d["ɵnov"](l,41)._ariaDescribedby||null,d["ɵnov"](l,41).errorState,d["ɵnov"](l,42).ngClassUntouched,d["ɵnov"](l,42).ngClassTouched,d["ɵnov"](l,42).ngClassPristine,d["ɵnov"](l,42).ngClassDirty,d["ɵnov"](l,42).ngClassValid,d["ɵnov"](l,42).ngClassInvalid,d["ɵnov"](l,42).ngClassPending]),n(l,46,0,d["ɵnov"](l,48)._control.errorState,d["ɵnov"](l,48)._control.errorState,d["ɵnov"](l,48)._control.focused,d["ɵnov"](l,48)._shouldForward("untouched"),d["ɵnov"](l,48)._shouldForward("touched"),d["ɵnov"](l,48)._shouldForward("pristine"),d["ɵnov"](l,48)._shouldForward("dirty"),d["ɵnov"](l,48)._shouldForward("valid"),d["ɵnov"](l,48)._shouldForward("invalid"),d["ɵnov"](l,48)._shouldForward("pending")),n(l,56,1,[d["ɵnov"](l,63).id,d["ɵnov"](l,63).placeholder,d["ɵnov"](l,63).disabled,d["ɵnov"](l,63).required,d["ɵnov"](l,63)._ariaDescribedby||null,d["ɵnov"](l,63).errorState,d["ɵnov"](l,64).ngClassUntouched,d["ɵnov"](l,64).ngClassTouched,d["ɵnov"](l,64).ngClassPristine,d["ɵnov"](l,64).ngClassDirty,d["ɵnov"](l,64).ngClassValid,d["ɵnov"](l,64).ngClassInvalid,d["ɵnov"](l,64).ngClassPending]),n(l,83,0,d["ɵnov"](l,84).isFixedHeader,d["ɵnov"](l,84).isFixedRow,d["ɵnov"](l,84).isVertScroll,d["ɵnov"](l,84).isHorScroll,d["ɵnov"](l,84).isSelectable,d["ɵnov"](l,84).isCheckboxSelection,d["ɵnov"](l,84).isCellSelection,d["ɵnov"](l,84).isSingleSelection,d["ɵnov"](l,84).isMultiSelection,d["ɵnov"](l,84).isMultiClickSelection)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-send-skycoin",[],null,null,null,r,I)),d["ɵdid"](114688,null,0,_.a,[m.a,E.a,D.c,s.n],null,null)],function(n,l){n(l,1,0)},null)}var a=e("79YC"),d=e("/oeL"),c=e("v6Q/"),s=e("Z04r"),p=e("BruE"),f=e("lL01"),m=e("bm2B"),v=e("vVgA"),b=e("z1Gz"),h=e("JYHx"),g=e("UPmf"),y=e("qbdv"),_=e("nI5/"),x=e("Qoso"),k=e("boXM"),w=e("LwgZ"),R=e("f2O0"),C=(e.n(R),e("7i8B")),S=(e.n(C),e("47oE")),T=(e.n(S),e("GHh8")),E=(e.n(T),e("cP51")),D=e("BkNc");e.d(l,"a",function(){return P});var q=[a.a],I=d["ɵcrt"]({encapsulation:0,styles:q,data:{}}),P=d["ɵccf"]("app-send-skycoin",_.a,o,{},{},[])},SJy7:function(n,l,e){"use strict";var t=e("1Zxj"),u=e("bKpL");e.n(u);e.d(l,"a",function(){return i});var i=function(){function n(n){this.apiService=n}return n.prototype.addressTransactions=function(n){return this.apiService.get("explorer/address",{address:n})},n.prototype.addressBalance=function(n){return this.apiService.get("outputs",{addrs:n})},n.prototype.block=function(n){var l=this;return this.apiService.get("blocks",{start:n,end:n}).map(function(n){return n.blocks[0]}).flatMap(function(n){return u.Observable.forkJoin(n.body.txns.map(function(n){return n.inputs&&!n.inputs.length?u.Observable.of(n):u.Observable.forkJoin(n.inputs.map(function(n){return l.retrieveInputAddress(n).map(function(n){return n.owner_address})})).map(function(l){return n.inputs=l,n})})).map(function(l){return n.body.txns=l,n})})},n.prototype.blocks=function(n){return void 0===n&&(n=5100),this.apiService.get("last_blocks",{num:n}).map(function(n){return n.blocks.reverse()})},n.prototype.lastBlock=function(){return this.blocks(1).map(function(n){return n[0]})},n.prototype.progress=function(){return this.apiService.get("blockchain/progress")},n.prototype.retrieveInputAddress=function(n){return this.apiService.get("uxout",{uxid:n})},n.ctorParameters=function(){return[{type:t.a}]},n}()},ScY5:function(n,l,e){"use strict";var t=e("gvep"),u=(e.n(t),e("CPp0"));e.d(l,"a",function(){return i});var i=function(){function n(n){this.http=n,this.purchaseOrders=new t.BehaviorSubject([]),this.purchaseUrl="http://127.0.01:7071/api/",this.retrievePurchaseOrders()}return n.prototype.all=function(){return this.purchaseOrders.asObservable()},n.prototype.generate=function(n){var l=this;return this.post("bind",{skyaddr:n}).do(function(e){l.purchaseOrders.first().subscribe(function(t){var u=t.findIndex(function(l){return l.address===n});-1===u&&(t.push({address:n,addresses:[]}),u=t.length-1);var i=Math.floor(Date.now()/1e3);t[u].addresses.unshift({btc:e.btc_address,status:"waiting_deposit",created:i,updated:i}),l.updatePurchaseOrders(t)})})},n.prototype.scan=function(n){var l=this;return this.get("status?skyaddr="+n).do(function(e){l.purchaseOrders.first().subscribe(function(t){var u=t.findIndex(function(l){return l.address===n});t[u].addresses.sort(function(n,l){return l.created-n.created});for(var i=0,r=t[u].addresses;i<r.length;i++){var o=r[i],a=e.statuses.splice(-1,1)[0];o.status=a.status,o.updated=a.update_at}l.updatePurchaseOrders(t)})})},n.prototype.get=function(n){return this.http.get(this.purchaseUrl+n).map(function(n){return n.json()})},n.prototype.post=function(n,l){return void 0===l&&(l={}),this.http.post(this.purchaseUrl+n,l).map(function(n){return n.json()})},n.prototype.retrievePurchaseOrders=function(){var n=JSON.parse(window.localStorage.getItem("purchaseOrders"));n&&this.purchaseOrders.next(n)},n.prototype.updatePurchaseOrders=function(n){this.purchaseOrders.next(n),window.localStorage.setItem("purchaseOrders",JSON.stringify(n))},n.ctorParameters=function(){return[{type:u.i}]},n}()},TvuP:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},"W/ps":function(n,l,e){"use strict";var t=e("SJy7");e.d(l,"a",function(){return u});var u=function(){function n(n){this.blockchainService=n}return n.prototype.ngOnInit=function(){var n=this;this.blockchainService.lastBlock().subscribe(function(l){return n.block=l})},n.ctorParameters=function(){return[{type:t.a}]},n}()},WZ1N:function(n,l,e){"use strict";var t=e("cP51");e.d(l,"a",function(){return u});var u=function(){function n(n){this.walletService=n}return n.prototype.ngOnInit=function(){var n=this;this.walletService.folder().subscribe(function(l){return n.folder=l})},n.prototype.ngOnDestroy=function(){this.walletService.all().subscribe(function(n){return n.forEach(function(n){return n.visible=!1})})},n.prototype.download=function(n){var l=new Blob([JSON.stringify({seed:n.meta.seed})],{type:"application/json"}),e=document.createElement("a");e.href=window.URL.createObjectURL(l),e.download=n.meta.filename+".json",e.click()},n.ctorParameters=function(){return[{type:t.a}]},n}()},Y66J:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.transform=function(n){return n.reduce(function(n,l){return n+l.outputs.reduce(function(n,l){return n+parseInt(l.coins)},0)},0)},n}()},Ymo7:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["ngx-datatable[_ngcontent-%COMP%]{margin-top:20px}md-icon[_ngcontent-%COMP%]{cursor:pointer}"]},a9x3:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-card[_ngcontent-%COMP%]{margin-bottom:20px}"]},"aR8+":function(n,l,e){"use strict";var t=e("5WMG"),u=e("nI5/"),i=e("iXFZ"),r=e("2yQV"),o=e("7Bws"),a=e("KwZO"),d=e("ONOE"),c=e("HHiG"),s=e("KbYb"),p=e("W/ps"),f=e("WZ1N"),m=e("PRn9"),v=e("BZkh");e.d(l,"a",function(){return b});var b=(t.a,u.a,i.a,r.a,o.a,d.a,a.a,r.a,v.a,f.a,p.a,m.a,s.a,c.a,function(){function n(){}return n}())},aUD3:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵted"](null,["\n      "])),(n()(),o["ɵeld"](0,null,null,2,"strong",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["",""])),o["ɵppd"](1),(n()(),o["ɵted"](null,["\n    "]))],null,function(n,l){n(l,2,0,o["ɵunv"](l,2,0,n(l,3,0,o["ɵnov"](l.parent,0),l.context.value)))})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,a.a,[]),(n()(),o["ɵeld"](0,null,null,24,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==o["ɵnov"](n,2).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},c.a,c.b)),o["ɵdid"](4571136,[["table",4]],3,s.DatatableComponent,[p.ScrollbarHelper,o.ElementRef,o.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),o["ɵqud"](603979776,1,{columnTemplates:1}),o["ɵqud"](335544320,2,{rowDetail:0}),o["ɵqud"](335544320,3,{footer:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","timestamp"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,4,{cellTemplate:0}),o["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵand"](0,[[4,2]],null,1,null,t)),o["ɵdid"](16384,null,0,m.DataTableColumnCellDirective,[o.TemplateRef],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Amount"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),o["ɵqud"](335544320,6,{cellTemplate:0}),o["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Transaction ID"],["prop","txid"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,8,{cellTemplate:0}),o["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){n(l,2,0,l.component.transactions,!0,50,"flex",50,50,10);n(l,8,0,"Timestamp","timestamp",2);n(l,17,0,"Amount",1);n(l,22,0,"Transaction ID","txid",8)},function(n,l){n(l,1,0,o["ɵnov"](l,2).isFixedHeader,o["ɵnov"](l,2).isFixedRow,o["ɵnov"](l,2).isVertScroll,o["ɵnov"](l,2).isHorScroll,o["ɵnov"](l,2).isSelectable,o["ɵnov"](l,2).isCheckboxSelection,o["ɵnov"](l,2).isCellSelection,o["ɵnov"](l,2).isSingleSelection,o["ɵnov"](l,2).isMultiSelection,o["ɵnov"](l,2).isMultiClickSelection)})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-pending-transactions",[],null,null,null,u,g)),o["ɵdid"](114688,null,0,d.a,[v.a,b.c],null,null)],function(n,l){n(l,1,0)},null)}var r=e("gm5P"),o=e("/oeL"),a=e("lL01"),d=e("HHiG"),c=e("LwgZ"),s=e("f2O0"),p=(e.n(s),e("7i8B")),f=(e.n(p),e("47oE")),m=(e.n(f),e("GHh8")),v=(e.n(m),e("cP51")),b=e("BkNc");e.d(l,"a",function(){return y});var h=[r.a],g=o["ɵcrt"]({encapsulation:0,styles:h,data:{}}),y=o["ɵccf"]("app-pending-transactions",d.a,i,{},{},[])},aZaK:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,6,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      "," "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      "," "])),o["ɵppd"](1),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      ","\n    "]))],null,function(n,l){var e=l.component;n(l,1,0,e.block.header.seq),n(l,3,0,o["ɵunv"](l,3,0,n(l,4,0,o["ɵnov"](l.parent,0),e.block.header.timestamp))),n(l,6,0,e.block.header.block_hash)})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,a.a,[]),(n()(),o["ɵeld"](0,null,null,25,"md-card",[["class","mat-card"]],null,null,null,d.w,d.x)),o["ɵdid"](16384,null,0,c.T,[[2,c.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,c._42,[],null,null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,3,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),o["ɵdid"](16384,null,0,c.T,[[2,c.U],o.ElementRef],null,null),o["ɵdid"](16384,null,0,c._108,[],null,null),(n()(),o["ɵted"](null,["Blockchain"])),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,15,"md-card",[["class","skycoin-details mat-card"]],null,null,null,d.w,d.x)),o["ɵdid"](16384,null,0,c.T,[[2,c.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,c._42,[],null,null),(n()(),o["ɵted"](0,["\n    "])),(n()(),o["ɵeld"](0,null,0,7,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      Number of blocks: "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      Time since last block: "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      Hash of last block: "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵted"](0,["\n    "])),(n()(),o["ɵand"](16777216,null,0,1,null,t)),o["ɵdid"](16384,null,0,s.NgIf,[o.ViewContainerRef,o.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵted"](0,["\n"])),(n()(),o["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,24,0,e.block&&e.block.header)},null)}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-blockchain",[],null,null,null,u,v)),o["ɵdid"](114688,null,0,p.a,[f.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("ApLd"),o=e("/oeL"),a=e("Caxp"),d=e("v6Q/"),c=e("Z04r"),s=e("qbdv"),p=e("W/ps"),f=e("SJy7");e.d(l,"a",function(){return b});var m=[r.a],v=o["ɵcrt"]({encapsulation:0,styles:m,data:{}}),b=o["ɵccf"]("app-blockchain",p.a,i,{},{},[])},boXM:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.setLoading=function(){this.state=0},n.prototype.setSuccess=function(){var n=this;this.state=1,setTimeout(function(){return n.state=null},3e3)},n.prototype.setError=function(n){this.error=n._body,this.state=2},n.prototype.disabled=function(){return!(0!==this.state&&(void 0===this.form||this.form&&this.form.valid))},n}()},cDNt:function(n,l,e){"use strict";Object.defineProperty(l,"__esModule",{value:!0});var t=e("/oeL"),u=e("p5Ee"),i=e("+h1B"),r=e("fc+i");u.a.production&&e.i(t.enableProdMode)(),e.i(r.platformBrowser)().bootstrapModuleFactory(i.a)},cP51:function(n,l,e){"use strict";var t=e("1Zxj"),u=e("gvep"),i=(e.n(u),e("bKpL")),r=(e.n(i),e("q+5A")),o=(e.n(r),e("GPwi")),a=(e.n(o),e("/zHi")),d=(e.n(a),e("eqpX")),c=(e.n(d),e("gbhw")),s=(e.n(c),e("ZQKz")),p=(e.n(s),e("RpuY"));e.n(p);e.d(l,"a",function(){return f});var f=function(){function n(n){var l=this;this.apiService=n,this.recentTransactions=new u.BehaviorSubject([]),this.transactions=new u.BehaviorSubject([]),this.wallets=new u.BehaviorSubject([]),this.loadData(),r.IntervalObservable.create(3e4).subscribe(function(){return l.refreshBalances()})}return n.prototype.addressesAsString=function(){return this.all().map(function(n){return n.map(function(n){return n.entries.reduce(function(n,l){return n.push(l.address),n},[]).join(",")}).join(",")})},n.prototype.addAddress=function(n){return this.apiService.post("wallet/newAddress",{id:n.meta.filename}).map(function(n){return{address:n.addresses[0],balance:0}})},n.prototype.all=function(){return this.wallets.asObservable()},n.prototype.allAddresses=function(){return this.all().map(function(n){return n.reduce(function(n,l){return n.concat(l.entries)},[])})},n.prototype.create=function(n,l){var e=this;return this.apiService.post("wallet/create",{label:n||"undefined",seed:l}).do(function(n){e.wallets.first().subscribe(function(l){l.push(n),e.wallets.next(l),e.refreshBalances()})})},n.prototype.folder=function(){return this.apiService.get("wallets/folderName").map(function(n){return n.address})},n.prototype.generateSeed=function(){return this.apiService.get("wallet/newSeed").map(function(n){return n.seed})},n.prototype.history=function(){return this.transactions.asObservable()},n.prototype.outputs=function(){var n=this;return this.addressesAsString().filter(function(n){return!!n}).flatMap(function(l){return n.apiService.get("outputs",{addrs:l})})},n.prototype.pendingTransactions=function(){return this.apiService.get("pendingTxs")},n.prototype.recent=function(){return this.recentTransactions.asObservable()},n.prototype.refreshBalances=function(){var n=this;this.wallets.first().subscribe(function(l){i.Observable.forkJoin(l.map(function(l){return n.retrieveWalletBalance(l).map(function(n){return l.entries=n,l.balance=n.map(function(n){return n.balance>=0?n.balance:0}).reduce(function(n,l){return n+l},0),l.hours=n.map(function(n){return n.hours>=0?n.hours:0}).reduce(function(n,l){return n+l},0),l})})).subscribe(function(l){return n.wallets.next(l)})})},n.prototype.renameWallet=function(n,l){return this.apiService.post("wallet/update",{id:n.meta.filename,label:l})},n.prototype.retrieveUpdatedTransactions=function(n){var l=this;return i.Observable.forkJoin(n.map(function(n){return l.apiService.get("transaction",{txid:n.id}).map(function(l){return l.amount=n.amount,l.address=n.address,l})}))},n.prototype.sendSkycoin=function(n,l,e){var t=this;return this.apiService.post("wallet/spend",{id:n,dst:l,coins:e}).do(function(n){return t.recentTransactions.first().subscribe(function(u){var i={id:n.txn.txid,address:l,amount:e/1e6};u.push(i),t.recentTransactions.next(u)})})},n.prototype.sum=function(){return this.all().map(function(n){return n.map(function(n){return n.balance>=0?n.balance:0}).reduce(function(n,l){return n+l},0)})},n.prototype.transaction=function(n){var l=this;return this.apiService.get("transaction",{txid:n}).flatMap(function(n){return n.txn.inputs&&!n.txn.inputs.length?i.Observable.of(n):i.Observable.forkJoin(n.txn.inputs.map(function(n){return l.retrieveInputAddress(n).map(function(n){return n.owner_address})})).map(function(l){return n.txn.inputs=l,n})})},n.prototype.loadData=function(){var n=this;this.retrieveWallets().first().subscribe(function(l){n.wallets.next(l),n.refreshBalances(),n.retrieveTransactions()})},n.prototype.retrieveAddressBalance=function(n){var l=Array.isArray(n)?n.map(function(n){return n.address}).join(","):n.address;return this.apiService.get("balance",{addrs:l})},n.prototype.retrieveAddressTransactions=function(n){return this.apiService.get("explorer/address",{address:n.address})},n.prototype.retrieveInputAddress=function(n){return this.apiService.get("uxout",{uxid:n})},n.prototype.retrieveTransactions=function(){var n=this;return this.wallets.first().subscribe(function(l){i.Observable.forkJoin(l.map(function(l){return n.retrieveWalletTransactions(l)})).map(function(n){return[].concat.apply([],n).sort(function(n,l){return l.timestamp-n.timestamp})}).map(function(n){return n.reduce(function(n,l){return n.find(function(n){return n.txid===l.txid})||n.push(l),n},[])}).subscribe(function(l){return n.transactions.next(l)})})},n.prototype.retrieveWalletBalance=function(n){var l=this;return i.Observable.forkJoin(n.entries.map(function(n){return l.retrieveAddressBalance(n).map(function(l){return n.balance=l.confirmed.coins,n.hours=l.confirmed.hours,n})}))},n.prototype.retrieveWalletTransactions=function(n){var l=this;return i.Observable.forkJoin(n.entries.map(function(n){return l.retrieveAddressTransactions(n)})).map(function(n){return[].concat.apply([],n)})},n.prototype.retrieveWalletUnconfirmedTransactions=function(n){return this.apiService.get("wallet/transactions",{id:n.meta.filename})},n.prototype.retrieveWallets=function(){return this.apiService.get("wallets")},n.ctorParameters=function(){return[{type:t.a}]},n}()},dSyq:function(n,l,e){"use strict";var t=e("1Zxj"),u=e("gvep"),i=(e.n(u),e("q+5A")),r=(e.n(i),e("RpuY"));e.n(r);e.d(l,"a",function(){return o});var o=function(){function n(n){this.apiService=n,this.automaticPeers=new u.BehaviorSubject([]),this.loadData()}return n.prototype.automatic=function(){return this.automaticPeers.asObservable()},n.prototype.retrieveDefaultConnections=function(){return this.apiService.post("network/defaultConnections").map(function(n){return n.map(function(n,l){return{id:l+1,address:n,listen_port:7100}})})},n.prototype.loadData=function(){var n=this;this.retrieveConnections().subscribe(function(l){return n.automaticPeers.next(l)}),i.IntervalObservable.create(5e3).flatMap(function(){return n.retrieveConnections()}).subscribe(function(l){return n.automaticPeers.next(l)})},n.prototype.retrieveConnections=function(){return this.apiService.post("network/connections").map(function(n){return n.connections.sort(function(n,l){return n.id-l.id})})},n.ctorParameters=function(){return[{type:t.a}]},n}()},gH5r:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,15,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==d["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==d["ɵnov"](n,2)._handleBlur()&&t}return t},c.y,c.z)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](1097728,null,2,s._71,[d.Renderer2,d.ElementRef,[2,s._70],[2,s._72]],null,null),d["ɵqud"](603979776,1,{_lines:1}),d["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),d["ɵted"](2,["\n      "])),(n()(),d["ɵeld"](0,null,1,8,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],0,s._111,[],null,null),(n()(),d["ɵeld"](0,null,null,6,"button",[["class","mat-button"],["md-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,12).onClick()&&t}return t},c.e,c.f)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s.V,[d.Renderer2,d.ElementRef,p.a,s.a],null,null),d["ɵdid"](16384,null,0,s.W,[],null,null),d["ɵdid"](16384,null,0,f.y,[f.c,f.a,[8,null],d.Renderer2,d.ElementRef],{routerLink:[0,"routerLink"]},null),d["ɵpad"](2),(n()(),d["ɵted"](0,["",""])),(n()(),d["ɵted"](2,["\n    "]))],function(n,l){n(l,12,0,n(l,13,0,"/explorer/address/",l.context.$implicit))},function(n,l){n(l,8,0,d["ɵnov"](l,10).disabled||null),n(l,14,0,l.context.$implicit)})}function u(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,15,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==d["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==d["ɵnov"](n,2)._handleBlur()&&t}return t},c.y,c.z)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](1097728,null,2,s._71,[d.Renderer2,d.ElementRef,[2,s._70],[2,s._72]],null,null),d["ɵqud"](603979776,3,{_lines:1}),d["ɵqud"](335544320,4,{_hasAvatar:0}),(n()(),d["ɵted"](2,["\n      "])),(n()(),d["ɵeld"](0,null,1,8,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),d["ɵdid"](16384,[[3,4]],0,s._111,[],null,null),(n()(),d["ɵeld"](0,null,null,6,"button",[["class","mat-button"],["md-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,12).onClick()&&t}return t},c.e,c.f)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s.V,[d.Renderer2,d.ElementRef,p.a,s.a],null,null),d["ɵdid"](16384,null,0,s.W,[],null,null),d["ɵdid"](16384,null,0,f.y,[f.c,f.a,[8,null],d.Renderer2,d.ElementRef],{routerLink:[0,"routerLink"]},null),d["ɵpad"](2),(n()(),d["ɵted"](0,[""," ("," SC2)"])),(n()(),d["ɵted"](2,["\n    "]))],function(n,l){n(l,12,0,n(l,13,0,"/explorer/address/",l.context.$implicit.dst))},function(n,l){n(l,8,0,d["ɵnov"](l,10).disabled||null),n(l,14,0,l.context.$implicit.dst,l.context.$implicit.coins)})}function i(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,23,"md-list",[["class","mat-list"],["role","list"]],null,null,null,c.C,c.D)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._70,[],null,null),d["ɵdid"](16384,null,0,s._113,[],null,null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s._112,[],null,null),(n()(),d["ɵted"](null,["Inputs"])),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵand"](16777216,null,0,1,null,t)),d["ɵdid"](802816,null,0,m.NgForOf,[d.ViewContainerRef,d.TemplateRef,d.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,s._109,[],null,null),d["ɵdid"](16384,null,0,s._110,[],null,null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s._112,[],null,null),(n()(),d["ɵted"](null,["Outputs"])),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵand"](16777216,null,0,1,null,u)),d["ɵdid"](802816,null,0,m.NgForOf,[d.ViewContainerRef,d.TemplateRef,d.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),d["ɵted"](0,["\n  "]))],function(n,l){var e=l.component;n(l,10,0,e.transaction.txn.inputs),n(l,22,0,e.transaction.txn.outputs)},null)}function r(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,42,"md-card",[["class","mat-card"]],null,null,null,c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,5,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,s._108,[],null,null),(n()(),d["ɵted"](null,["Transaction "])),(n()(),d["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,v.a,v.b)),d["ɵdid"](49152,null,0,b.a,[m.Location],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,27,"md-card",[["class","skycoin-details mat-card"]],null,null,null,c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,11,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      ID: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Timestamp: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Block: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Confirmed: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Total value: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,9,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      "," "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      "," "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      ",""])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      "," "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      ","\n    "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵted"](0,["\n\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,i)),d["ɵdid"](16384,null,0,m.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,41,0,e.transaction&&e.transaction.txn)},function(n,l){var e=l.component;n(l,29,0,e.transaction&&e.transaction.txn?e.transaction.txn.txid:""),n(l,31,0,e.transaction&&e.transaction.txn?e.transaction.txn.timestamp:""),n(l,33,0,e.transaction&&e.transaction.txn?e.transaction.status.block_seq:""),n(l,35,0,e.transaction&&e.transaction.status?e.transaction.status.confirmed?"True":"False":""),n(l,37,0,e.total)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-transaction",[],null,null,null,r,_)),d["ɵdid"](114688,null,0,h.a,[f.a,g.a],null,null)],function(n,l){n(l,1,0)},null)}var a=e("TvuP"),d=e("/oeL"),c=e("v6Q/"),s=e("Z04r"),p=e("JYHx"),f=e("BkNc"),m=e("qbdv"),v=e("zVgQ"),b=e("/Tz7"),h=e("2yQV"),g=e("cP51");e.d(l,"a",function(){return x});var y=[a.a],_=d["ɵcrt"]({encapsulation:0,styles:y,data:{}}),x=d["ɵccf"]("app-transaction",h.a,o,{},{},[])},gm5P:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},iXFZ:function(n,l,e){"use strict";var t=e("cP51"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.router=n,this.walletService=l}return n.prototype.ngOnInit=function(){var n=this;this.walletService.history().subscribe(function(l){return n.transactions=n.mapTransactions(l)})},n.prototype.onActivate=function(n){n.row&&n.row.txid&&this.router.navigate(["/history",n.row.txid])},n.prototype.mapTransactions=function(n){return n.map(function(n){return n.amount=n.outputs.map(function(n){return n.coins>=0?n.coins:0}).reduce(function(n,l){return n+parseInt(l)},0),n})},n.ctorParameters=function(){return[{type:u.c},{type:t.a}]},n}()},io6L:function(n,l,e){"use strict";function t(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,1,"p",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["Sorry, otc has currently been disabled!"]))],null,null)}function u(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,f._109,[],null,null),p["ɵdid"](16384,null,0,f._110,[],null,null)],null,null)}function i(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,26,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==p["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==p["ɵnov"](n,2)._handleBlur()&&t}return t},m.y,m.z)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](1097728,null,2,f._71,[p.Renderer2,p.ElementRef,[2,f._70],[2,f._72]],null,null),p["ɵqud"](603979776,1,{_lines:1}),p["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[1,4]],0,f._111,[],null,null),(n()(),p["ɵted"](null,[" Bitcoin address: ",""])),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,1,4,"p",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[1,4]],0,f._111,[],null,null),(n()(),p["ɵted"](null,[" Status: "," (updated at: ",") "])),p["ɵppd"](1),p["ɵppd"](1),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,2,9,"button",[["class","mat-icon-button"],["md-icon-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.searchDepositAddress(n.parent.context.$implicit.address)&&t}return t},m.e,m.f)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](180224,null,0,f.V,[p.Renderer2,p.ElementRef,v.a,f.a],{disabled:[0,"disabled"]},null),p["ɵdid"](16384,null,0,f._49,[],null,null),(n()(),p["ɵted"](0,["\n          "])),(n()(),p["ɵeld"](0,null,0,3,"md-icon",[["class","mat-icon"],["role","img"]],null,null,null,m.A,m.B)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](638976,null,0,f._48,[p.Renderer2,p.ElementRef,f.h,[8,null]],null,null),(n()(),p["ɵted"](0,["refresh"])),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵted"](2,["\n      "]))],function(n,l){n(l,18,0,l.component.scanning),n(l,23,0)},function(n,l){n(l,8,0,l.context.$implicit.btc),n(l,12,0,p["ɵunv"](l,12,0,n(l,13,0,p["ɵnov"](l.parent.parent.parent,0),l.context.$implicit.status)),p["ɵunv"](l,12,1,n(l,14,0,p["ɵnov"](l.parent.parent.parent,1),l.context.$implicit.updated))),n(l,16,0,p["ɵnov"](l,18).disabled||null)})}function r(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,11,null,null,null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵand"](16777216,null,null,1,null,u)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵeld"](0,null,null,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),p["ɵdid"](16384,null,0,f._112,[],null,null),(n()(),p["ɵted"](null,["Sc2 address: ",""])),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵand"](16777216,null,null,1,null,i)),p["ɵdid"](802816,null,0,b.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](null,["\n    "]))],function(n,l){n(l,3,0,l.context.index),n(l,10,0,l.context.$implicit.addresses)},function(n,l){n(l,7,0,l.context.$implicit.address)})}function o(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,23,"md-card",[["class","mat-card"]],null,null,null,m.w,m.x)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,3,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,f._108,[],null,null),(n()(),p["ɵted"](null,["Purchase Skycoin"])),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,3,"md-card",[["class","skycoin-details mat-card"]],null,null,null,m.w,m.x)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),p["ɵted"](0,["\n    You can buy Shellcoins directly from your wallet using our Shellcoin Teller service. The current rate is 0.0002 BTC per\n    SC2. To buy SC2, request a BTC deposit address. Once you have a BTC deposit address, any BTC deposits will\n    automatically be added to your selected address.\n  "])),(n()(),p["ɵted"](0,["\n\n  "])),(n()(),p["ɵeld"](0,null,0,8,"md-list",[["class","mat-list"],["role","list"]],null,null,null,m.C,m.D)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,f._70,[],null,null),p["ɵdid"](16384,null,0,f._113,[],null,null),(n()(),p["ɵted"](0,["\n    "])),(n()(),p["ɵand"](16777216,null,0,2,null,r)),p["ɵdid"](802816,null,0,b.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),p["ɵpid"](131072,b.AsyncPipe,[p.ChangeDetectorRef]),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵted"](0,["\n"]))],function(n,l){var e=l.component;n(l,20,0,p["ɵunv"](l,20,0,p["ɵnov"](l,21).transform(e.purchaseService.all())))},null)}function a(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n  "])),(n()(),p["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==p["ɵnov"](n,4)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.addDepositAddress()&&t}return t},m.q,m.r)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](180224,null,0,f._38,[v.a,f.a,p.ElementRef,p.Renderer2],{color:[0,"color"]},null),p["ɵdid"](16384,null,0,f._107,[],null,null),(n()(),p["ɵted"](0,["Add deposit address"])),(n()(),p["ɵted"](null,["\n"]))],function(n,l){n(l,4,0,"primary")},function(n,l){n(l,2,0,p["ɵnov"](l,4).disabled?-1:0,p["ɵnov"](l,4).disabled||null,p["ɵnov"](l,4).disabled.toString())})}function d(n){return p["ɵvid"](0,[p["ɵpid"](0,h.a,[]),p["ɵpid"](0,g.a,[]),(n()(),p["ɵand"](16777216,null,null,1,null,t)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n"])),(n()(),p["ɵand"](16777216,null,null,1,null,o)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n"])),(n()(),p["ɵand"](16777216,null,null,1,null,a)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,3,0,!e.otcEnabled),n(l,6,0,e.otcEnabled),n(l,9,0,e.otcEnabled)},null)}function c(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,1,"app-buy",[],null,null,null,d,k)),p["ɵdid"](49152,null,0,y.a,[_.a,f.e],null,null)],null,null)}var s=e("QAaR"),p=e("/oeL"),f=e("Z04r"),m=e("v6Q/"),v=e("JYHx"),b=e("qbdv"),h=e("6e64"),g=e("lL01"),y=e("BZkh"),_=e("ScY5");e.d(l,"a",function(){return w});var x=[s.a],k=p["ɵcrt"]({encapsulation:0,styles:x,data:{}}),w=p["ɵccf"]("app-buy",y.a,c,{},{},[])},k7ea:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-card[_ngcontent-%COMP%]{max-width:1000px;margin-top:80px;margin-right:auto;margin-left:auto}.logo[_ngcontent-%COMP%]{max-height:100%}.fill-remaining-space[_ngcontent-%COMP%]{-webkit-box-flex:1;-ms-flex:1 1 auto;flex:1 1 auto}.sky-container[_ngcontent-%COMP%]{max-width:1000px;margin-top:20px;margin-right:auto;margin-left:auto}md-toolbar[_ngcontent-%COMP%]   span[_ngcontent-%COMP%]{margin:0 20px}.search-field[_ngcontent-%COMP%]{border-radius:8px;border:none;background-color:#fff;padding:8px}.syncing[_ngcontent-%COMP%]{font-size:14px}.main-menu[_ngcontent-%COMP%]   button[_ngcontent-%COMP%]{margin-right:20px}#top-menu[_ngcontent-%COMP%]   span[_ngcontent-%COMP%]{color:#fbfbfb}#top-menu[_ngcontent-%COMP%]   span#version[_ngcontent-%COMP%]{padding-top:12px}"]},k9Ay:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["span[_ngcontent-%COMP%]{display:inline-block;width:50%}"]},kRbD:function(n,l,e){"use strict";var t=e("bm2B"),u=e("cP51"),i=e("Z04r");e.d(l,"a",function(){return r});var r=function(){function n(n,l,e){this.dialogRef=n,this.formBuilder=l,this.walletService=e}return n.prototype.ngOnInit=function(){this.initForm()},n.prototype.createWallet=function(){var n=this;this.walletService.create(this.form.value.label,this.form.value.seed).subscribe(function(){return n.dialogRef.close()})},n.prototype.generateSeed=function(){var n=this;this.walletService.generateSeed().subscribe(function(l){return n.form.controls.seed.setValue(l)})},n.prototype.initForm=function(){this.form=this.formBuilder.group({label:["",t.k.required],seed:["",t.k.required]}),this.generateSeed()},n.ctorParameters=function(){return[{type:i.R},{type:t.a},{type:u.a}]},n}()},l4UU:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,29,"md-card",[["class","mat-card"]],null,null,null,o.w,o.x)),r["ɵdid"](16384,null,0,a.T,[[2,a.U],r.ElementRef],null,null),r["ɵdid"](49152,null,0,a._42,[],null,null),(n()(),r["ɵted"](0,["\n  "])),(n()(),r["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),r["ɵted"](null,["Automatic peers"])),(n()(),r["ɵted"](0,["\n\n  "])),(n()(),r["ɵeld"](0,null,0,21,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==r["ɵnov"](n,8).onWindowResize()&&t}return t},d.a,d.b)),r["ɵdid"](4571136,null,3,c.DatatableComponent,[s.ScrollbarHelper,r.ElementRef,r.KeyValueDiffers],{rows:[0,"rows"],rowHeight:[1,"rowHeight"],columnMode:[2,"columnMode"],headerHeight:[3,"headerHeight"],footerHeight:[4,"footerHeight"],limit:[5,"limit"]},null),r["ɵqud"](603979776,1,{columnTemplates:1}),r["ɵqud"](335544320,2,{rowDetail:0}),r["ɵqud"](335544320,3,{footer:0}),r["ɵpid"](131072,p.AsyncPipe,[r.ChangeDetectorRef]),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","ID"],["prop","id"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,4,{cellTemplate:0}),r["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"],["prop","address"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,6,{cellTemplate:0}),r["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Port"],["prop","listen_port"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,8,{cellTemplate:0}),r["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵted"](0,["\n"])),(n()(),r["ɵted"](null,["\n\n"])),(n()(),r["ɵeld"](0,null,null,28,"md-card",[["class","mat-card"]],null,null,null,o.w,o.x)),r["ɵdid"](16384,null,0,a.T,[[2,a.U],r.ElementRef],null,null),r["ɵdid"](49152,null,0,a._42,[],null,null),(n()(),r["ɵted"](0,["\n  "])),(n()(),r["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),r["ɵted"](null,["Default peers"])),(n()(),r["ɵted"](0,["\n\n  "])),(n()(),r["ɵeld"](0,null,0,20,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==r["ɵnov"](n,39).onWindowResize()&&t}return t},d.a,d.b)),r["ɵdid"](4571136,null,3,c.DatatableComponent,[s.ScrollbarHelper,r.ElementRef,r.KeyValueDiffers],{rows:[0,"rows"],rowHeight:[1,"rowHeight"],columnMode:[2,"columnMode"],headerHeight:[3,"headerHeight"]},null),r["ɵqud"](603979776,10,{columnTemplates:1}),r["ɵqud"](335544320,11,{rowDetail:0}),r["ɵqud"](335544320,12,{footer:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","ID"],["prop","id"]],null,null,null,null,null)),r["ɵdid"](16384,[[10,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,13,{cellTemplate:0}),r["ɵqud"](335544320,14,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"],["prop","address"]],null,null,null,null,null)),r["ɵdid"](16384,[[10,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,15,{cellTemplate:0}),r["ɵqud"](335544320,16,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Port"],["prop","listen_port"]],null,null,null,null,null)),r["ɵdid"](16384,[[10,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,17,{cellTemplate:0}),r["ɵqud"](335544320,18,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵted"](0,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,8,0,r["ɵunv"](l,8,0,r["ɵnov"](l,12).transform(e.networkService.automatic())),40,"flex",40,40,5);n(l,15,0,"ID","id",1);n(l,20,0,"Address","address",1);n(l,25,0,"Port","listen_port",1);n(l,39,0,e.defaultConnections,50,"flex",40);n(l,45,0,"ID","id",1);n(l,50,0,"Address","address",1);n(l,55,0,"Port","listen_port",1)},function(n,l){n(l,7,0,r["ɵnov"](l,8).isFixedHeader,r["ɵnov"](l,8).isFixedRow,r["ɵnov"](l,8).isVertScroll,r["ɵnov"](l,8).isHorScroll,r["ɵnov"](l,8).isSelectable,r["ɵnov"](l,8).isCheckboxSelection,r["ɵnov"](l,8).isCellSelection,r["ɵnov"](l,8).isSingleSelection,r["ɵnov"](l,8).isMultiSelection,r["ɵnov"](l,8).isMultiClickSelection),n(l,38,0,r["ɵnov"](l,39).isFixedHeader,r["ɵnov"](l,39).isFixedRow,r["ɵnov"](l,39).isVertScroll,r["ɵnov"](l,39).isHorScroll,r["ɵnov"](l,39).isSelectable,r["ɵnov"](l,39).isCheckboxSelection,r["ɵnov"](l,39).isCellSelection,r["ɵnov"](l,39).isSingleSelection,r["ɵnov"](l,39).isMultiSelection,r["ɵnov"](l,39).isMultiClickSelection)})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-network",[],null,null,null,t,h)),r["ɵdid"](114688,null,0,m.a,[v.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("IFVI"),r=e("/oeL"),o=e("v6Q/"),a=e("Z04r"),d=e("LwgZ"),c=e("f2O0"),s=(e.n(c),e("7i8B")),p=(e.n(s),e("qbdv")),f=e("47oE"),m=(e.n(f),e("PRn9")),v=e("dSyq");e.d(l,"a",function(){return g});var b=[i.a],h=r["ɵcrt"]({encapsulation:0,styles:b,data:{}}),g=r["ɵccf"]("app-network",m.a,u,{},{},[])},lL01:function(n,l,e){"use strict";var t=e("ge1L");e.n(t);e.d(l,"a",function(){return u});var u=function(){function n(){}return n.prototype.transform=function(n){return t.unix(n).format("YYYY-MM-DD HH:mm")},n}()},mZbS:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},nA3a:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,20,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==r["ɵnov"](n,1).onWindowResize()&&t}return t},o.a,o.b)),r["ɵdid"](4571136,[["table",4]],3,a.DatatableComponent,[d.ScrollbarHelper,r.ElementRef,r.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},null),r["ɵqud"](603979776,1,{columnTemplates:1}),r["ɵqud"](335544320,2,{rowDetail:0}),r["ɵqud"](335544320,3,{footer:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"],["prop","address"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,c.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,4,{cellTemplate:0}),r["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Coins"],["prop","coins"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,c.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,6,{cellTemplate:0}),r["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Coin hours"],["prop","hours"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,c.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,8,{cellTemplate:0}),r["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,1,0,l.component.outputs,!0,50,"flex",50,50,10);n(l,7,0,"Address","address",2);n(l,12,0,"Coins","coins",1);n(l,17,0,"Coin hours","hours",1)},function(n,l){n(l,0,0,r["ɵnov"](l,1).isFixedHeader,r["ɵnov"](l,1).isFixedRow,r["ɵnov"](l,1).isVertScroll,r["ɵnov"](l,1).isHorScroll,r["ɵnov"](l,1).isSelectable,r["ɵnov"](l,1).isCheckboxSelection,r["ɵnov"](l,1).isCellSelection,r["ɵnov"](l,1).isSingleSelection,r["ɵnov"](l,1).isMultiSelection,r["ɵnov"](l,1).isMultiClickSelection)})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-outputs",[],null,null,null,t,m)),r["ɵdid"](114688,null,0,s.a,[p.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("o5tI"),r=e("/oeL"),o=e("LwgZ"),a=e("f2O0"),d=(e.n(a),e("7i8B")),c=(e.n(d),e("47oE")),s=(e.n(c),e("KbYb")),p=e("cP51");e.d(l,"a",function(){return v});var f=[i.a],m=r["ɵcrt"]({encapsulation:0,styles:f,data:{}}),v=r["ɵccf"]("app-outputs",s.a,u,{},{},[])},"nI5/":function(n,l,e){"use strict";var t=e("cP51"),u=e("bm2B"),i=e("q+5A"),r=(e.n(i),e("BkNc")),o=e("Z04r"),a=e("l3Q+"),d=(e.n(a),e("gbhw"));e.n(d);e.d(l,"a",function(){return c});var c=function(){function n(n,l,e,t){this.formBuilder=n,this.walletService=l,this.router=e,this.snackbar=t,this.records=[],this.transactions=[]}return n.prototype.ngOnInit=function(){var n=this;this.initForm(),i.IntervalObservable.create(2500).filter(function(){return!!n.transactions.length}).flatMap(function(){return n.walletService.retrieveUpdatedTransactions(n.transactions)}).subscribe(function(l){return n.records=l}),this.walletService.recent().subscribe(function(l){return n.transactions=l})},n.prototype.onActivate=function(n){n.row&&n.row.txid&&this.router.navigate(["/history",n.row.txid])},n.prototype.send=function(){var n=this;this.button.setLoading();var l=this.form.value.wallet.meta.filename;this.walletService.sendSkycoin(l,this.form.value.address,1e6*this.form.value.amount).delay(1e3).subscribe(function(l){n.resetForm(),n.button.setSuccess()},function(l){var e=new o.S;e.duration=3e5,n.snackbar.open(l._body,null,e),n.button.setError(l)})},n.prototype.initForm=function(){var n=this;this.form=this.formBuilder.group({wallet:["",u.k.required],address:["",u.k.required],amount:["",[u.k.required,u.k.min(0),u.k.max(0)]]}),this.form.controls.wallet.valueChanges.subscribe(function(l){var e=l&&l.balance?l.balance/1e6:0;n.form.controls.amount.setValidators([u.k.required,u.k.min(0),u.k.max(e)]),n.form.controls.amount.updateValueAndValidity()})},n.prototype.resetForm=function(){this.form.controls.wallet.reset(void 0),this.form.controls.address.reset(void 0),this.form.controls.amount.reset(void 0)},n.ctorParameters=function(){return[{type:u.a},{type:t.a},{type:r.c},{type:o.n}]},n}()},o5tI:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},omNI:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n      ","\n    "])),d["ɵppd"](1)],null,function(n,l){n(l,0,0,d["ɵunv"](l,0,0,n(l,1,0,d["ɵnov"](l.parent,0),l.context.value)))})}function u(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n      ","\n    "]))],null,function(n,l){n(l,0,0,l.context.value?l.context.value.length:0)})}function i(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n      ","\n    "])),d["ɵppd"](1)],null,function(n,l){n(l,0,0,d["ɵunv"](l,0,0,n(l,1,0,d["ɵnov"](l.parent,1),l.context.value)))})}function r(n){return d["ɵvid"](0,[d["ɵpid"](0,c.a,[]),d["ɵpid"](0,s.a,[]),(n()(),d["ɵeld"](0,null,null,37,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==d["ɵnov"](n,3).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},f.a,f.b)),d["ɵdid"](4571136,[["table",4]],3,m.DatatableComponent,[v.ScrollbarHelper,d.ElementRef,d.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),d["ɵqud"](603979776,1,{columnTemplates:1}),d["ɵqud"](335544320,2,{rowDetail:0}),d["ɵqud"](335544320,3,{footer:0}),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","header.timestamp"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,4,{cellTemplate:0}),d["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵand"](0,[[4,2]],null,1,null,t)),d["ɵdid"](16384,null,0,h.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Block height"],["prop","header.seq"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,6,{cellTemplate:0}),d["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Transactions"],["prop","body.txns"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,8,{cellTemplate:0}),d["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵand"](0,[[8,2]],null,1,null,u)),d["ɵdid"](16384,null,0,h.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Amount"],["prop","body.txns"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,10,{cellTemplate:0}),d["ɵqud"](335544320,11,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵand"](0,[[10,2]],null,1,null,i)),d["ɵdid"](16384,null,0,h.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](null,["\n"])),(n()(),d["ɵted"](null,["\n"]))],function(n,l){n(l,3,0,l.component.blocks,!0,50,"flex",50,50,10);n(l,9,0,"Timestamp","header.timestamp",1);n(l,18,0,"Block height","header.seq",1);n(l,23,0,"Transactions","body.txns",1);n(l,32,0,"Amount","body.txns",1)},function(n,l){n(l,2,0,d["ɵnov"](l,3).isFixedHeader,d["ɵnov"](l,3).isFixedRow,d["ɵnov"](l,3).isVertScroll,d["ɵnov"](l,3).isHorScroll,d["ɵnov"](l,3).isSelectable,d["ɵnov"](l,3).isCheckboxSelection,d["ɵnov"](l,3).isCellSelection,d["ɵnov"](l,3).isSingleSelection,d["ɵnov"](l,3).isMultiSelection,d["ɵnov"](l,3).isMultiClickSelection)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-explorer",[],null,null,null,r,x)),d["ɵdid"](114688,null,0,p.a,[g.a,y.c],null,null)],function(n,l){n(l,1,0)},null)}var a=e("xrX6"),d=e("/oeL"),c=e("lL01"),s=e("Y66J"),p=e("7Bws"),f=e("LwgZ"),m=e("f2O0"),v=(e.n(m),e("7i8B")),b=(e.n(v),e("47oE")),h=(e.n(b),e("GHh8")),g=(e.n(h),e("SJy7")),y=e("BkNc");e.d(l,"a",function(){return k});var _=[a.a],x=d["ɵcrt"]({encapsulation:0,styles:_,data:{}}),k=d["ɵccf"]("app-explorer",p.a,o,{},{},[])},p5Ee:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t={production:!0}},"p6Q+":function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵted"](null,["\n      ","\n    "])),o["ɵppd"](1)],null,function(n,l){n(l,0,0,o["ɵunv"](l,0,0,n(l,1,0,o["ɵnov"](l.parent,0),l.context.value)))})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,a.a,[]),o["ɵqud"](402653184,1,{table:0}),(n()(),o["ɵeld"](0,null,null,25,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==o["ɵnov"](n,3).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},c.a,c.b)),o["ɵdid"](4571136,[[1,4],["table",4]],3,s.DatatableComponent,[p.ScrollbarHelper,o.ElementRef,o.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),o["ɵqud"](603979776,2,{columnTemplates:1}),o["ɵqud"](335544320,3,{rowDetail:0}),o["ɵqud"](335544320,4,{footer:0}),o["ɵpid"](131072,f.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","timestamp"]],null,null,null,null,null)),o["ɵdid"](16384,[[2,4]],2,m.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,5,{cellTemplate:0}),o["ɵqud"](335544320,6,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵand"](0,[[5,2]],null,1,null,t)),o["ɵdid"](16384,null,0,v.DataTableColumnCellDirective,[o.TemplateRef],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Amount"]],null,null,null,null,null)),o["ɵdid"](16384,[[2,4]],2,m.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),o["ɵqud"](335544320,7,{cellTemplate:0}),o["ɵqud"](335544320,8,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Transaction ID"],["prop","txid"]],null,null,null,null,null)),o["ɵdid"](16384,[[2,4]],2,m.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,9,{cellTemplate:0}),o["ɵqud"](335544320,10,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,3,0,o["ɵunv"](l,3,0,o["ɵnov"](l,7).transform(e.walletService.history())),!0,50,"flex",50,50,10);n(l,10,0,"Timestamp","timestamp",2);n(l,19,0,"Amount",1);n(l,24,0,"Transaction ID","txid",5)},function(n,l){n(l,2,0,o["ɵnov"](l,3).isFixedHeader,o["ɵnov"](l,3).isFixedRow,o["ɵnov"](l,3).isVertScroll,o["ɵnov"](l,3).isHorScroll,o["ɵnov"](l,3).isSelectable,o["ɵnov"](l,3).isCheckboxSelection,o["ɵnov"](l,3).isCellSelection,o["ɵnov"](l,3).isSingleSelection,o["ɵnov"](l,3).isMultiSelection,o["ɵnov"](l,3).isMultiClickSelection)})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-history",[],null,null,null,u,y)),o["ɵdid"](114688,null,0,d.a,[b.c,h.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("Ymo7"),o=e("/oeL"),a=e("Caxp"),d=e("iXFZ"),c=e("LwgZ"),s=e("f2O0"),p=(e.n(s),e("7i8B")),f=(e.n(p),e("qbdv")),m=e("47oE"),v=(e.n(m),e("GHh8")),b=(e.n(v),e("BkNc")),h=e("cP51");e.d(l,"a",function(){return _});var g=[r.a],y=o["ɵcrt"]({encapsulation:0,styles:g,data:{}}),_=o["ɵccf"]("app-history",d.a,i,{},{},[])},pCa7:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-select[_ngcontent-%COMP%]{width:100%;padding:40px 0 20px}"]},q4dy:function(n,l,e){"use strict";function t(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,1,"span",[["class","syncing"]],null,null,null,null,null)),(n()(),c["ɵted"](null,["\n    Syncing blocks ","\n  "]))],null,function(n,l){var e=l.component;n(l,1,0,e.current&&e.highest?"("+e.current+"/"+e.highest+")":"..")})}function u(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,3,"span",[],null,null,null,null,null)),(n()(),c["ɵted"](null,["",""])),c["ɵpid"](131072,s.AsyncPipe,[c.ChangeDetectorRef]),c["ɵppd"](1)],null,function(n,l){var e=l.component;n(l,1,0,c["ɵunv"](l,1,0,n(l,3,0,c["ɵnov"](l.parent,0),c["ɵunv"](l,1,0,c["ɵnov"](l,2).transform(e.walletService.sum())))))})}function i(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,4).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[8,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,8,{links:1}),c["ɵqud"](603979776,9,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Buy"]))],function(n,l){n(l,4,0,n(l,5,0,"/buy"));n(l,6,0,"active")},function(n,l){n(l,0,0,c["ɵnov"](l,2).disabled||null)})}function r(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,2,"md-progress-bar",[["aria-valuemax","100"],["aria-valuemin","0"],["class","example-margin mat-progress-bar"],["color","primary"],["mode","determinate"],["role","progressbar"]],[[1,"aria-valuenow",0],[1,"mode",0],[2,"mat-primary",null],[2,"mat-accent",null],[2,"mat-warn",null]],null,null,p.g,p.h)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](49152,null,0,f.X,[],{color:[0,"color"],value:[1,"value"],mode:[2,"mode"]},null)],function(n,l){n(l,2,0,"primary",l.component.percentage,"determinate")},function(n,l){n(l,0,0,c["ɵnov"](l,2).value,c["ɵnov"](l,2).mode,"primary"==c["ɵnov"](l,2).color,"accent"==c["ɵnov"](l,2).color,"warn"==c["ɵnov"](l,2).color)})}function o(n){return c["ɵvid"](0,[c["ɵpid"](0,b.a,[]),(n()(),c["ɵeld"](0,null,null,62,"md-toolbar",[["class","mat-toolbar"],["color","primary"],["id","top-menu"],["role","toolbar"]],null,null,null,p.i,p.j)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](49152,null,0,f.Y,[c.Renderer2,c.ElementRef],{color:[0,"color"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,0,"img",[["class","logo"],["src","/assets/logo-white.png"]],null,null,null,null,null)),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,0,"span",[["class","fill-remaining-space"]],null,null,null,null,null)),(n()(),c["ɵted"](0,["\n\n  "])),(n()(),c["ɵand"](16777216,null,0,1,null,t)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵand"](16777216,null,0,1,null,u)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,38,"md-menu",[],null,null,null,p.k,p.l)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](1228800,[["settingsMenu",4]],1,f.Z,[c.ElementRef,f.P],null,null),c["ɵqud"](603979776,1,{items:1}),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,2,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,23)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,23)._emitHoverEvent()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],{disabled:[0,"disabled"]},null),(n()(),c["ɵted"](0,[" Version "," "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,27)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,27)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,28).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Networking "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,33)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,33)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,34).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Blockchain "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,39)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,39)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,40).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Outputs "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,45)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,45)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,46).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Pending Transactions "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,51)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,51)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,52).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Back-up wallet "])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵted"](0,["\n\n  "])),(n()(),c["ɵeld"](16777216,null,0,5,"button",[["aria-haspopup","true"],["class","mat-button"],["md-button",""]],[[8,"disabled",0]],[[null,"mousedown"],[null,"keydown"],[null,"click"]],function(n,l,e){var t=!0;if("mousedown"===l){t=!1!==c["ɵnov"](n,61)._handleMousedown(e)&&t}if("keydown"===l){t=!1!==c["ɵnov"](n,61)._handleKeydown(e)&&t}if("click"===l){t=!1!==c["ɵnov"](n,61)._handleClick(e)&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](4341760,null,0,f._1,[h.e,c.ElementRef,c.ViewContainerRef,f.j,[2,f.Z],[8,null],[2,g.b]],{menu:[0,"menu"]},null),(n()(),c["ɵted"](0,["Settings"])),(n()(),c["ɵted"](0,["\n"])),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵeld"](0,null,null,52,"md-toolbar",[["class","main-menu mat-toolbar"],["role","toolbar"]],null,null,null,p.i,p.j)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](49152,null,0,f.Y,[c.Renderer2,c.ElementRef],null,null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,73).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[2,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,2,{links:1}),c["ɵqud"](603979776,3,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Wallets"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,84).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[4,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,4,{links:1}),c["ɵqud"](603979776,5,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Send"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,95).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[6,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,6,{links:1}),c["ɵqud"](603979776,7,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["History"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵand"](16777216,null,0,1,null,i)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,109).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[10,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,10,{links:1}),c["ɵqud"](603979776,11,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Explorer"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,0,"span",[["class","fill-remaining-space"]],null,null,null,null,null)),(n()(),c["ɵted"](0,["\n\n"])),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵand"](16777216,null,null,1,null,r)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵeld"](0,null,null,4,"div",[["class","sky-container"]],null,null,null,null,null)),(n()(),c["ɵted"](null,["\n  "])),(n()(),c["ɵeld"](16777216,null,null,1,"router-outlet",[],null,null,null,null,null)),c["ɵdid"](212992,null,0,v.A,[v.q,c.ViewContainerRef,c.ComponentFactoryResolver,[8,null],c.ChangeDetectorRef],null,null),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,3,0,"primary"),n(l,12,0,e.loading()),n(l,15,0,!e.loading());n(l,23,0,!0),n(l,28,0,n(l,29,0,"/settings/network")),n(l,34,0,n(l,35,0,"/settings/blockchain")),n(l,40,0,n(l,41,0,"/settings/outputs")),n(l,46,0,n(l,47,0,"/settings/pending-transactions")),n(l,52,0,n(l,53,0,"/settings/backup")),n(l,61,0,c["ɵnov"](l,19)),n(l,73,0,n(l,74,0,"/wallets"));n(l,75,0,"active"),n(l,84,0,n(l,85,0,"/send"));n(l,86,0,"active"),n(l,95,0,n(l,96,0,"/history"));n(l,97,0,"active"),n(l,103,0,e.otcEnabled),n(l,109,0,n(l,110,0,"/explorer"));n(l,111,0,"active"),n(l,120,0,e.loading()),n(l,125,0)},function(n,l){var e=l.component;n(l,22,0,c["ɵnov"](l,23)._highlighted,c["ɵnov"](l,23)._triggersSubmenu,c["ɵnov"](l,23)._getTabIndex(),c["ɵnov"](l,23).disabled.toString(),c["ɵnov"](l,23).disabled||null),n(l,24,0,e.version),n(l,26,0,c["ɵnov"](l,27)._highlighted,c["ɵnov"](l,27)._triggersSubmenu,c["ɵnov"](l,27)._getTabIndex(),c["ɵnov"](l,27).disabled.toString(),c["ɵnov"](l,27).disabled||null),n(l,32,0,c["ɵnov"](l,33)._highlighted,c["ɵnov"](l,33)._triggersSubmenu,c["ɵnov"](l,33)._getTabIndex(),c["ɵnov"](l,33).disabled.toString(),c["ɵnov"](l,33).disabled||null),n(l,38,0,c["ɵnov"](l,39)._highlighted,c["ɵnov"](l,39)._triggersSubmenu,c["ɵnov"](l,39)._getTabIndex(),c["ɵnov"](l,39).disabled.toString(),c["ɵnov"](l,39).disabled||null),n(l,44,0,c["ɵnov"](l,45)._highlighted,c["ɵnov"](l,45)._triggersSubmenu,c["ɵnov"](l,45)._getTabIndex(),c["ɵnov"](l,45).disabled.toString(),c["ɵnov"](l,45).disabled||null),n(l,50,0,c["ɵnov"](l,51)._highlighted,c["ɵnov"](l,51)._triggersSubmenu,c["ɵnov"](l,51)._getTabIndex(),c["ɵnov"](l,51).disabled.toString(),c["ɵnov"](l,51).disabled||null),n(l,57,0,c["ɵnov"](l,59).disabled||null),n(l,69,0,c["ɵnov"](l,71).disabled||null),n(l,80,0,c["ɵnov"](l,82).disabled||null),n(l,91,0,c["ɵnov"](l,93).disabled||null),n(l,105,0,c["ɵnov"](l,107).disabled||null)})}function a(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,1,"app-root",[],null,null,null,o,R)),c["ɵdid"](114688,null,0,y.a,[_.a,x.a,k.a],null,null)],function(n,l){n(l,1,0)},null)}var d=e("k7ea"),c=e("/oeL"),s=e("qbdv"),p=e("v6Q/"),f=e("Z04r"),m=e("JYHx"),v=e("BkNc"),b=e("BruE"),h=e("z1Gz"),g=e("UPmf"),y=e("wQAS"),_=e("cP51"),x=e("1Zxj"),k=e("SJy7");e.d(l,"a",function(){return C});var w=[d.a],R=c["ɵcrt"]({encapsulation:0,styles:w,data:{}}),C=c["ɵccf"]("app-root",y.a,a,{},{},[])},qtrl:function(n,l){function e(n){throw new Error("Cannot find module '"+n+"'.")}e.keys=function(){return[]},e.resolve=e,n.exports=e,e.id="qtrl"},qwxJ:function(n,l){},sfWi:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,46,"div",[],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==r["ɵnov"](n,1).onSubmit(e)&&t}if("reset"===l){t=!1!==r["ɵnov"](n,1).onReset()&&t}return t},null,null)),r["ɵdid"](540672,null,0,o.i,[[8,null],[8,null]],{form:[0,"form"]},null),r["ɵprd"](2048,null,o.l,null,[o.i]),r["ɵdid"](16384,null,0,o.m,[o.l],null,null),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,19,"md-input-container",[["class","mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==r["ɵnov"](n,7)._control.focus()&&t}return t},a.o,a.p)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](7389184,null,6,d._65,[r.ElementRef,r.ChangeDetectorRef,[2,d._66]],null,null),r["ɵqud"](335544320,1,{_control:0}),r["ɵqud"](335544320,2,{_placeholderChild:0}),r["ɵqud"](603979776,3,{_errorChildren:1}),r["ɵqud"](603979776,4,{_hintChildren:1}),r["ɵqud"](603979776,5,{_prefixChildren:1}),r["ɵqud"](603979776,6,{_suffixChildren:1}),(n()(),r["ɵted"](1,["\n    "])),(n()(),r["ɵeld"](0,null,1,8,"input",[["class","mat-input-element"],["formControlName","label"],["mdInput",""],["placeholder","Label"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==r["ɵnov"](n,17)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,17).onTouched()&&t}if("compositionstart"===l){t=!1!==r["ɵnov"](n,17)._compositionStart()&&t}if("compositionend"===l){t=!1!==r["ɵnov"](n,17)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!1)&&t}if("focus"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!0)&&t}if("input"===l){t=!1!==r["ɵnov"](n,21)._onInput()&&t}return t},null,null)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](16384,null,0,o.n,[r.Renderer2,r.ElementRef,[2,o.o]],null,null),r["ɵprd"](1024,null,o.e,function(n){return[n]},[o.n]),r["ɵdid"](671744,null,0,o.p,[[3,o.l],[8,null],[8,null],[2,o.e]],{name:[0,"name"]},null),r["ɵprd"](2048,null,o.j,null,[o.p]),r["ɵdid"](933888,null,0,d._104,[r.ElementRef,r.Renderer2,c.a,[2,o.j],[2,o.h],[2,o.i],[2,d._105]],{placeholder:[0,"placeholder"]},null),r["ɵdid"](16384,null,0,o.q,[o.j],null,null),r["ɵprd"](2048,[[1,4]],d._106,null,[d._104]),(n()(),r["ɵted"](1,["\n  "])),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,19,"md-input-container",[["class","mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==r["ɵnov"](n,28)._control.focus()&&t}return t},a.o,a.p)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](7389184,null,6,d._65,[r.ElementRef,r.ChangeDetectorRef,[2,d._66]],null,null),r["ɵqud"](335544320,7,{_control:0}),r["ɵqud"](335544320,8,{_placeholderChild:0}),r["ɵqud"](603979776,9,{_errorChildren:1}),r["ɵqud"](603979776,10,{_hintChildren:1}),r["ɵqud"](603979776,11,{_prefixChildren:1}),r["ɵqud"](603979776,12,{_suffixChildren:1}),(n()(),r["ɵted"](1,["\n    "])),(n()(),r["ɵeld"](0,null,1,8,"textarea",[["class","mat-input-element"],["formControlName","seed"],["mdInput",""],["placeholder","Seed"],["row","5"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==r["ɵnov"](n,38)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,38).onTouched()&&t}if("compositionstart"===l){t=!1!==r["ɵnov"](n,38)._compositionStart()&&t}if("compositionend"===l){t=!1!==r["ɵnov"](n,38)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,42)._focusChanged(!1)&&t}if("focus"===l){t=!1!==r["ɵnov"](n,42)._focusChanged(!0)&&t}if("input"===l){t=!1!==r["ɵnov"](n,42)._onInput()&&t}return t},null,null)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](16384,null,0,o.n,[r.Renderer2,r.ElementRef,[2,o.o]],null,null),r["ɵprd"](1024,null,o.e,function(n){return[n]},[o.n]),r["ɵdid"](671744,null,0,o.p,[[3,o.l],[8,null],[8,null],[2,o.e]],{name:[0,"name"]},null),r["ɵprd"](2048,null,o.j,null,[o.p]),r["ɵdid"](933888,null,0,d._104,[r.ElementRef,r.Renderer2,c.a,[2,o.j],[2,o.h],[2,o.i],[2,d._105]],{placeholder:[0,"placeholder"]},null),r["ɵdid"](16384,null,0,o.q,[o.j],null,null),r["ɵprd"](2048,[[7,4]],d._106,null,[d._104]),(n()(),r["ɵted"](1,["\n  "])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵeld"](0,null,null,13,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,52)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.generateSeed()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],null,null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["New Seed"])),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,58)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.createWallet()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],{color:[0,"color"]},null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["Create"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,1,0,l.component.form);n(l,19,0,"label");n(l,21,0,"Label");n(l,40,0,"seed");n(l,42,0,"Seed");n(l,58,0,"primary")},function(n,l){n(l,0,0,r["ɵnov"](l,3).ngClassUntouched,r["ɵnov"](l,3).ngClassTouched,r["ɵnov"](l,3).ngClassPristine,r["ɵnov"](l,3).ngClassDirty,r["ɵnov"](l,3).ngClassValid,r["ɵnov"](l,3).ngClassInvalid,r["ɵnov"](l,3).ngClassPending),n(l,5,0,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.focused,r["ɵnov"](l,7)._shouldForward("untouched"),r["ɵnov"](l,7)._shouldForward("touched"),r["ɵnov"](l,7)._shouldForward("pristine"),r["ɵnov"](l,7)._shouldForward("dirty"),r["ɵnov"](l,7)._shouldForward("valid"),r["ɵnov"](l,7)._shouldForward("invalid"),r["ɵnov"](l,7)._shouldForward("pending")),n(l,15,1,[r["ɵnov"](l,21).id,r["ɵnov"](l,21).placeholder,r["ɵnov"](l,21).disabled,r["ɵnov"](l,21).required,r["ɵnov"](l,21)._ariaDescribedby||null,r["ɵnov"](l,21).errorState,r["ɵnov"](l,22).ngClassUntouched,r["ɵnov"](l,22).ngClassTouched,r["ɵnov"](l,22).ngClassPristine,r["ɵnov"](l,22).ngClassDirty,r["ɵnov"](l,22).ngClassValid,r["ɵnov"](l,22).ngClassInvalid,r["ɵnov"](l,22).ngClassPending]),n(l,26,0,r["ɵnov"](l,28)._control.errorState,r["ɵnov"](l,28)._control.errorState,r["ɵnov"](l,28)._control.focused,r["ɵnov"](l,28)._shouldForward("untouched"),r["ɵnov"](l,28)._shouldForward("touched"),r["ɵnov"](l,28)._shouldForward("pristine"),r["ɵnov"](l,28)._shouldForward("dirty"),r["ɵnov"](l,28)._shouldForward("valid"),r["ɵnov"](l,28)._shouldForward("invalid"),r["ɵnov"](l,28)._shouldForward("pending")),n(l,36,1,[r["ɵnov"](l,42).id,r["ɵnov"](l,42).placeholder,r["ɵnov"](l,42).disabled,r["ɵnov"](l,42).required,r["ɵnov"](l,42)._ariaDescribedby||null,r["ɵnov"](l,42).errorState,r["ɵnov"](l,43).ngClassUntouched,r["ɵnov"](l,43).ngClassTouched,r["ɵnov"](l,43).ngClassPristine,r["ɵnov"](l,43).ngClassDirty,r["ɵnov"](l,43).ngClassValid,r["ɵnov"](l,43).ngClassInvalid,r["ɵnov"](l,43).ngClassPending]),n(l,50,0,r["ɵnov"](l,52).disabled?-1:0,r["ɵnov"](l,52).disabled||null,r["ɵnov"](l,52).disabled.toString()),n(l,56,0,r["ɵnov"](l,58).disabled?-1:0,r["ɵnov"](l,58).disabled||null,r["ɵnov"](l,58).disabled.toString())})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-create-wallet",[],null,null,null,t,m)),r["ɵdid"](114688,null,0,s.a,[d.R,o.a,p.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("Aq/i"),r=e("/oeL"),o=e("bm2B"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("kRbD"),p=e("cP51");e.d(l,"a",function(){return v});var f=[i.a],m=r["ɵcrt"]({encapsulation:0,styles:f,data:{}}),v=r["ɵccf"]("app-create-wallet",s.a,u,{},{},[])},sxGq:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[r["ɵqud"](402653184,1,{qr:0}),(n()(),r["ɵeld"](0,[[1,0],["qr",1]],null,0,"div",[],null,null,null,null,null)),(n()(),r["ɵted"](null,["\n\n"]))],null,null)}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-qr-code",[],null,null,null,t,c)),r["ɵdid"](114688,null,0,o.a,[a._103,r.ElementRef],null,null)],function(n,l){n(l,1,0)},null)}var i=e("mZbS"),r=e("/oeL"),o=e("Qm7a"),a=e("Z04r");e.d(l,"a",function(){return s});var d=[i.a],c=r["ɵcrt"]({encapsulation:0,styles:d,data:{}}),s=r["ɵccf"]("app-qr-code",o.a,u,{},{},[])},"uv+1":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["a[_ngcontent-%COMP%]{float:right}"]},vXTO:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,3,"md-option",[["class","mat-option"],["role","option"]],[[1,"tabindex",0],[2,"mat-selected",null],[2,"mat-option-multiple",null],[2,"mat-active",null],[8,"id",0],[1,"aria-selected",0],[1,"aria-disabled",0],[2,"mat-option-disabled",null]],[[null,"click"],[null,"keydown"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==o["ɵnov"](n,2)._selectViaInteraction()&&t}if("keydown"===l){t=!1!==o["ɵnov"](n,2)._handleKeydown(e)&&t}return t},a.s,a.t)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](49152,[[1,4]],0,d._35,[o.ElementRef,o.ChangeDetectorRef,[2,d._36],[2,d.U]],{value:[0,"value"]},null),(n()(),o["ɵted"](0,["\n      ","\n    "]))],function(n,l){n(l,2,0,l.context.$implicit.address)},function(n,l){n(l,0,0,o["ɵnov"](l,2)._getTabIndex(),o["ɵnov"](l,2).selected,o["ɵnov"](l,2).multiple,o["ɵnov"](l,2).active,o["ɵnov"](l,2).id,o["ɵnov"](l,2).selected.toString(),o["ɵnov"](l,2).disabled.toString(),o["ɵnov"](l,2).disabled),n(l,3,0,l.context.$implicit.address)})}function u(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"p",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["Choose an address to generate a BTC deposit link for:"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵeld"](0,null,null,19,"div",[],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==o["ɵnov"](n,4).onSubmit(e)&&t}if("reset"===l){t=!1!==o["ɵnov"](n,4).onReset()&&t}return t},null,null)),o["ɵdid"](540672,null,0,c.i,[[8,null],[8,null]],{form:[0,"form"]},null),o["ɵprd"](2048,null,c.l,null,[c.i]),o["ɵdid"](16384,null,0,c.m,[c.l],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,13,"md-select",[["class","input-field mat-select"],["formControlName","address"],["placeholder","Select Address"],["role","listbox"]],[[1,"tabindex",0],[1,"aria-label",0],[1,"aria-labelledby",0],[1,"aria-required",0],[1,"aria-disabled",0],[1,"aria-invalid",0],[1,"aria-owns",0],[1,"aria-multiselectable",0],[2,"mat-select-disabled",null],[2,"mat-select-invalid",null],[2,"mat-select-required",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"keydown"],[null,"blur"]],function(n,l,e){var t=!0;if("keydown"===l){t=!1!==o["ɵnov"](n,12)._handleClosedKeydown(e)&&t}if("blur"===l){t=!1!==o["ɵnov"](n,12)._onBlur()&&t}return t},a.u,a.v)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](671744,null,0,c.p,[[3,c.l],[8,null],[8,null],[8,null]],{name:[0,"name"]},null),o["ɵprd"](2048,null,c.j,null,[c.p]),o["ɵdid"](1294336,null,3,d._75,[s.c,o.ChangeDetectorRef,p.e,f.a,o.Renderer2,o.ElementRef,[2,m.b],[2,c.h],[2,c.i],[2,c.j],[8,null],[2,d._66],d.l],{placeholder:[0,"placeholder"]},null),o["ɵqud"](603979776,1,{options:1}),o["ɵqud"](603979776,2,{optionGroups:1}),o["ɵqud"](335544320,3,{customTrigger:0}),o["ɵdid"](16384,null,0,c.q,[c.j],null,null),(n()(),o["ɵted"](1,["\n    "])),(n()(),o["ɵand"](16777216,null,1,2,null,t)),o["ɵdid"](802816,null,0,v.NgForOf,[o.ViewContainerRef,o.TemplateRef,o.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),o["ɵpid"](131072,v.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](1,["\n  "])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,28)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.generate()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[f.a,d.a,o.ElementRef,o.Renderer2],null,null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Generate"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,4,0,e.form);n(l,10,0,"address");n(l,12,0,"Select Address"),n(l,19,0,o["ɵunv"](l,19,0,o["ɵnov"](l,20).transform(e.walletService.allAddresses())))},function(n,l){n(l,3,0,o["ɵnov"](l,6).ngClassUntouched,o["ɵnov"](l,6).ngClassTouched,o["ɵnov"](l,6).ngClassPristine,o["ɵnov"](l,6).ngClassDirty,o["ɵnov"](l,6).ngClassValid,o["ɵnov"](l,6).ngClassInvalid,o["ɵnov"](l,6).ngClassPending),n(l,8,1,[o["ɵnov"](l,12).tabIndex,o["ɵnov"](l,12)._ariaLabel,o["ɵnov"](l,12).ariaLabelledby,o["ɵnov"](l,12).required.toString(),o["ɵnov"](l,12).disabled.toString(),o["ɵnov"](l,12)._isErrorState(),o["ɵnov"](l,12)._optionIds,o["ɵnov"](l,12).multiple,o["ɵnov"](l,12).disabled,o["ɵnov"](l,12)._isErrorState(),o["ɵnov"](l,12).required,o["ɵnov"](l,16).ngClassUntouched,o["ɵnov"](l,16).ngClassTouched,o["ɵnov"](l,16).ngClassPristine,o["ɵnov"](l,16).ngClassDirty,o["ɵnov"](l,16).ngClassValid,o["ɵnov"](l,16).ngClassInvalid,o["ɵnov"](l,16).ngClassPending]),n(l,26,0,o["ɵnov"](l,28).disabled?-1:0,o["ɵnov"](l,28).disabled||null,o["ɵnov"](l,28).disabled.toString())})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-add-deposit-address",[],null,null,null,u,_)),o["ɵdid"](114688,null,0,b.a,[h.a,d.R,c.a,g.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("pCa7"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("bm2B"),s=e("vVgA"),p=e("z1Gz"),f=e("JYHx"),m=e("UPmf"),v=e("qbdv"),b=e("B4F0"),h=e("cP51"),g=e("ScY5");e.d(l,"a",function(){return x});var y=[r.a],_=o["ɵcrt"]({encapsulation:0,styles:y,data:{}}),x=o["ɵccf"]("app-add-deposit-address",b.a,i,{},{},[])},wQAS:function(n,l,e){"use strict";var t=e("cP51"),u=e("SJy7"),i=e("q+5A"),r=(e.n(i),e("86mp")),o=(e.n(r),e("1Zxj")),a=e("5ERs");e.d(l,"a",function(){return d});var d=function(){function n(n,l,e){this.walletService=n,this.apiService=l,this.blockchainService=e,this.otcEnabled=a.a.otcEnabled}return n.prototype.ngOnInit=function(){var n=this;this.setVersion(),i.IntervalObservable.create(3e3).flatMap(function(){return n.blockchainService.progress()}).takeWhile(function(n){return!n.current||n.current!==n.highest}).subscribe(function(l){n.highest=l.highest,n.current=l.current,n.percentage=n.current&&n.highest?n.current/n.highest*100:0,console.log(l)},function(n){return console.log(n)},function(){return n.completeLoading()})},n.prototype.loading=function(){return!this.current||!this.highest||this.current!=this.highest},n.prototype.completeLoading=function(){this.current=999999999999,this.highest=999999999999,this.walletService.refreshBalances()},n.prototype.setVersion=function(){var n=this;return this.apiService.get("version").subscribe(function(l){return n.version=l.version})},n.ctorParameters=function(){return[{type:t.a},{type:o.a},{type:u.a}]},n}()},xdkH:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,25,"div",[],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==r["ɵnov"](n,1).onSubmit(e)&&t}if("reset"===l){t=!1!==r["ɵnov"](n,1).onReset()&&t}return t},null,null)),r["ɵdid"](540672,null,0,o.i,[[8,null],[8,null]],{form:[0,"form"]},null),r["ɵprd"](2048,null,o.l,null,[o.i]),r["ɵdid"](16384,null,0,o.m,[o.l],null,null),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,19,"md-input-container",[["class","mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==r["ɵnov"](n,7)._control.focus()&&t}return t},a.o,a.p)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](7389184,null,6,d._65,[r.ElementRef,r.ChangeDetectorRef,[2,d._66]],null,null),r["ɵqud"](335544320,1,{_control:0}),r["ɵqud"](335544320,2,{_placeholderChild:0}),r["ɵqud"](603979776,3,{_errorChildren:1}),r["ɵqud"](603979776,4,{_hintChildren:1}),r["ɵqud"](603979776,5,{_prefixChildren:1}),r["ɵqud"](603979776,6,{_suffixChildren:1}),(n()(),r["ɵted"](1,["\n    "])),(n()(),r["ɵeld"](0,null,1,8,"input",[["class","mat-input-element"],["formControlName","label"],["mdInput",""],["placeholder","Label"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==r["ɵnov"](n,17)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,17).onTouched()&&t}if("compositionstart"===l){t=!1!==r["ɵnov"](n,17)._compositionStart()&&t}if("compositionend"===l){t=!1!==r["ɵnov"](n,17)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!1)&&t}if("focus"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!0)&&t}if("input"===l){t=!1!==r["ɵnov"](n,21)._onInput()&&t}return t},null,null)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](16384,null,0,o.n,[r.Renderer2,r.ElementRef,[2,o.o]],null,null),r["ɵprd"](1024,null,o.e,function(n){return[n]},[o.n]),r["ɵdid"](671744,null,0,o.p,[[3,o.l],[8,null],[8,null],[2,o.e]],{name:[0,"name"]},null),r["ɵprd"](2048,null,o.j,null,[o.p]),r["ɵdid"](933888,null,0,d._104,[r.ElementRef,r.Renderer2,c.a,[2,o.j],[2,o.h],[2,o.i],[2,d._105]],{placeholder:[0,"placeholder"]},null),r["ɵdid"](16384,null,0,o.q,[o.j],null,null),r["ɵprd"](2048,[[1,4]],d._106,null,[d._104]),(n()(),r["ɵted"](1,["\n  "])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,31)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.rename()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],null,null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["Rename"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,1,0,l.component.form);n(l,19,0,"label");n(l,21,0,"Label")},function(n,l){n(l,0,0,r["ɵnov"](l,3).ngClassUntouched,r["ɵnov"](l,3).ngClassTouched,r["ɵnov"](l,3).ngClassPristine,r["ɵnov"](l,3).ngClassDirty,r["ɵnov"](l,3).ngClassValid,r["ɵnov"](l,3).ngClassInvalid,r["ɵnov"](l,3).ngClassPending),n(l,5,0,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.focused,r["ɵnov"](l,7)._shouldForward("untouched"),r["ɵnov"](l,7)._shouldForward("touched"),r["ɵnov"](l,7)._shouldForward("pristine"),r["ɵnov"](l,7)._shouldForward("dirty"),r["ɵnov"](l,7)._shouldForward("valid"),r["ɵnov"](l,7)._shouldForward("invalid"),r["ɵnov"](l,7)._shouldForward("pending")),n(l,15,1,[r["ɵnov"](l,21).id,r["ɵnov"](l,21).placeholder,r["ɵnov"](l,21).disabled,r["ɵnov"](l,21).required,r["ɵnov"](l,21)._ariaDescribedby||null,r["ɵnov"](l,21).errorState,r["ɵnov"](l,22).ngClassUntouched,r["ɵnov"](l,22).ngClassTouched,r["ɵnov"](l,22).ngClassPristine,r["ɵnov"](l,22).ngClassDirty,r["ɵnov"](l,22).ngClassValid,r["ɵnov"](l,22).ngClassInvalid,r["ɵnov"](l,22).ngClassPending]),n(l,29,0,r["ɵnov"](l,31).disabled?-1:0,r["ɵnov"](l,31).disabled||null,r["ɵnov"](l,31).disabled.toString())})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-change-name",[],null,null,null,t,m)),r["ɵdid"](114688,null,0,s.a,[d._103,d.R,o.a,p.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("Pdzc"),r=e("/oeL"),o=e("bm2B"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("3VAl"),p=e("cP51");e.d(l,"a",function(){return v});var f=[i.a],m=r["ɵcrt"]({encapsulation:0,styles:f,data:{}}),v=r["ɵccf"]("app-change-name",s.a,u,{},{},[])},xrX6:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},zVgQ:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,2)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.onClick()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],{color:[0,"color"]},null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["Back"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,2,0,"primary")},function(n,l){n(l,0,0,r["ɵnov"](l,2).disabled?-1:0,r["ɵnov"](l,2).disabled||null,r["ɵnov"](l,2).disabled.toString())})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,t,f)),r["ɵdid"](49152,null,0,o.a,[s.Location],null,null)],null,null)}var i=e("uv+1"),r=e("/oeL"),o=e("/Tz7"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("qbdv");e.d(l,"b",function(){return f}),l.a=t;var p=[i.a],f=r["ɵcrt"]({encapsulation:0,styles:p,data:{}});r["ɵccf"]("app-back-button",o.a,u,{},{},[])}},[1]);
\ No newline at end of file
+webpackJsonp([1],{"+h1B":function(n,l,e){"use strict";var t=e("/oeL"),u=e("aR8+"),i=e("wQAS"),r=e("v6Q/"),o=e("C2LC"),a=e("RMl1"),d=e("p6Q+"),c=e("gH5r"),s=e("omNI"),p=e("Qgkl"),f=e("9z2b"),m=e("io6L"),v=e("NED+"),b=e("aZaK"),h=e("l4UU"),g=e("nA3a"),y=e("aUD3"),_=e("vXTO"),x=e("sfWi"),k=e("xdkH"),w=e("sxGq"),R=e("q4dy"),C=e("qbdv"),S=e("fc+i"),T=e("f9zQ"),E=e("fL27"),D=e("CPp0"),q=e("UPmf"),I=e("JYHx"),P=e("vVgA"),O=e("Z04r"),j=e("z1Gz"),H=e("dGUy"),A=e("f3MN"),F=e("7i8B"),L=(e.n(F),e("EyWH")),M=e("bm2B"),U=e("BkNc"),B=e("1Zxj"),N=e("SJy7"),V=e("dSyq"),z=e("ScY5"),G=e("cP51"),Z=e("mSH7"),W=e("Q6ZI"),Q=(e.n(W),e("5WMG")),Y=e("nI5/"),J=e("iXFZ"),K=e("2yQV"),$=e("7Bws"),X=e("ONOE"),nn=e("KwZO"),ln=e("BZkh"),en=e("WZ1N"),tn=e("W/ps"),un=e("PRn9"),rn=e("KbYb"),on=e("HHiG");e.d(l,"a",function(){return an});var an=t["ɵcmf"](u.a,[i.a],function(n){return t["ɵmod"]([t["ɵmpd"](512,t.ComponentFactoryResolver,t["ɵCodegenComponentFactoryResolver"],[[8,[r.a,r.b,r.c,r.d,o.a,a.a,d.a,c.a,s.a,p.a,f.a,m.a,v.a,b.a,h.a,g.a,y.a,_.a,x.a,k.a,w.a,R.a]],[3,t.ComponentFactoryResolver],t.NgModuleRef]),t["ɵmpd"](5120,t.LOCALE_ID,t["ɵm"],[[3,t.LOCALE_ID]]),t["ɵmpd"](4608,C.NgLocalization,C.NgLocaleLocalization,[t.LOCALE_ID]),t["ɵmpd"](5120,t.APP_ID,t["ɵf"],[]),t["ɵmpd"](5120,t.IterableDiffers,t["ɵk"],[]),t["ɵmpd"](5120,t.KeyValueDiffers,t["ɵl"],[]),t["ɵmpd"](4608,S.DomSanitizer,S["ɵe"],[C.DOCUMENT]),t["ɵmpd"](6144,t.Sanitizer,null,[S.DomSanitizer]),t["ɵmpd"](4608,S.HAMMER_GESTURE_CONFIG,S.HammerGestureConfig,[]),t["ɵmpd"](5120,S.EVENT_MANAGER_PLUGINS,function(n,l,e,t){return[new S["ɵDomEventsPlugin"](n),new S["ɵKeyEventsPlugin"](l),new S["ɵHammerGesturesPlugin"](e,t)]},[C.DOCUMENT,C.DOCUMENT,C.DOCUMENT,S.HAMMER_GESTURE_CONFIG]),t["ɵmpd"](4608,S.EventManager,S.EventManager,[S.EVENT_MANAGER_PLUGINS,t.NgZone]),t["ɵmpd"](135680,S["ɵDomSharedStylesHost"],S["ɵDomSharedStylesHost"],[C.DOCUMENT]),t["ɵmpd"](4608,S["ɵDomRendererFactory2"],S["ɵDomRendererFactory2"],[S.EventManager,S["ɵDomSharedStylesHost"]]),t["ɵmpd"](4608,T.a,T.b,[]),t["ɵmpd"](5120,T.c,E.a,[]),t["ɵmpd"](4608,T.d,E.b,[T.a,T.c]),t["ɵmpd"](5120,t.RendererFactory2,E.c,[S["ɵDomRendererFactory2"],T.d,t.NgZone]),t["ɵmpd"](6144,S["ɵSharedStylesHost"],null,[S["ɵDomSharedStylesHost"]]),t["ɵmpd"](4608,t.Testability,t.Testability,[t.NgZone]),t["ɵmpd"](4608,S.Meta,S.Meta,[C.DOCUMENT]),t["ɵmpd"](4608,S.Title,S.Title,[C.DOCUMENT]),t["ɵmpd"](4608,D.a,D.a,[]),t["ɵmpd"](4608,D.b,D.c,[]),t["ɵmpd"](5120,D.d,D.e,[]),t["ɵmpd"](4608,D.f,D.f,[D.a,D.b,D.d]),t["ɵmpd"](4608,D.g,D.h,[]),t["ɵmpd"](5120,D.i,D.j,[D.f,D.g]),t["ɵmpd"](6144,q.a,null,[S.DOCUMENT]),t["ɵmpd"](4608,q.b,q.b,[[2,q.a]]),t["ɵmpd"](4608,I.a,I.a,[]),t["ɵmpd"](5120,P.a,P.b,[[3,P.a],t.NgZone,I.a]),t["ɵmpd"](5120,P.c,P.d,[[3,P.c],P.a]),t["ɵmpd"](5120,O.a,O.b,[[3,O.a],t.NgZone,I.a]),t["ɵmpd"](4608,j.a,j.a,[P.a,P.c]),t["ɵmpd"](5120,j.b,j.c,[[3,j.b]]),t["ɵmpd"](4608,j.d,j.d,[P.c]),t["ɵmpd"](4608,j.e,j.e,[j.a,j.b,t.ComponentFactoryResolver,j.d,t.ApplicationRef,t.Injector,t.NgZone]),t["ɵmpd"](5120,j.f,j.g,[j.e]),t["ɵmpd"](4608,H.a,H.a,[I.a]),t["ɵmpd"](4608,H.b,H.b,[H.a,I.a,t.NgZone]),t["ɵmpd"](5120,H.c,H.d,[[3,H.c],[2,H.e],I.a]),t["ɵmpd"](5120,O.c,O.d,[j.e]),t["ɵmpd"](4608,O.e,O.e,[j.e,t.Injector,O.c,[2,C.Location],[3,O.e]]),t["ɵmpd"](5120,O.f,O.g,[[3,O.f]]),t["ɵmpd"](5120,O.h,O.i,[[3,O.h],[2,D.i],S.DomSanitizer]),t["ɵmpd"](5120,O.j,O.k,[j.e]),t["ɵmpd"](5120,O.l,O.m,[j.e]),t["ɵmpd"](4608,O.n,O.n,[j.e,H.c,t.Injector,[3,O.n]]),t["ɵmpd"](4608,A.a,A.a,[]),t["ɵmpd"](5120,O.o,O.p,[j.e]),t["ɵmpd"](4608,F.ScrollbarHelper,F.ScrollbarHelper,[S.DOCUMENT]),t["ɵmpd"](4608,L.a,E.d,[t.RendererFactory2,S.DOCUMENT]),t["ɵmpd"](4608,M.a,M.a,[]),t["ɵmpd"](4608,M.b,M.b,[]),t["ɵmpd"](5120,U.a,U.b,[U.c]),t["ɵmpd"](4608,U.d,U.d,[]),t["ɵmpd"](6144,U.e,null,[U.d]),t["ɵmpd"](135680,U.f,U.f,[U.c,t.NgModuleFactoryLoader,t.Compiler,t.Injector,U.e]),t["ɵmpd"](4608,U.g,U.g,[]),t["ɵmpd"](5120,U.h,U.i,[U.j]),t["ɵmpd"](5120,t.APP_BOOTSTRAP_LISTENER,function(n){return[n]},[U.h]),t["ɵmpd"](4608,B.a,B.a,[D.i]),t["ɵmpd"](4608,N.a,N.a,[B.a]),t["ɵmpd"](4608,V.a,V.a,[B.a]),t["ɵmpd"](4608,z.a,z.a,[D.i]),t["ɵmpd"](4608,G.a,G.a,[B.a]),t["ɵmpd"](512,C.CommonModule,C.CommonModule,[]),t["ɵmpd"](1024,t.ErrorHandler,S["ɵa"],[]),t["ɵmpd"](1024,t.NgProbeToken,function(){return[U.k()]},[]),t["ɵmpd"](512,U.j,U.j,[t.Injector]),t["ɵmpd"](1024,t.APP_INITIALIZER,function(n,l,e){return[S["ɵc"](n,l),U.l(e)]},[[2,S.NgProbeToken],[2,t.NgProbeToken],U.j]),t["ɵmpd"](512,t.ApplicationInitStatus,t.ApplicationInitStatus,[[2,t.APP_INITIALIZER]]),t["ɵmpd"](131584,t["ɵe"],t["ɵe"],[t.NgZone,t["ɵConsole"],t.Injector,t.ErrorHandler,t.ComponentFactoryResolver,t.ApplicationInitStatus]),t["ɵmpd"](2048,t.ApplicationRef,null,[t["ɵe"]]),t["ɵmpd"](512,t.ApplicationModule,t.ApplicationModule,[t.ApplicationRef]),t["ɵmpd"](512,S.BrowserModule,S.BrowserModule,[[3,S.BrowserModule]]),t["ɵmpd"](512,D.k,D.k,[]),t["ɵmpd"](512,O.q,O.q,[]),t["ɵmpd"](512,q.c,q.c,[]),t["ɵmpd"](256,O.r,!0,[]),t["ɵmpd"](512,O.s,O.s,[[2,S.DOCUMENT],[2,O.r]]),t["ɵmpd"](512,I.b,I.b,[]),t["ɵmpd"](512,P.e,P.e,[]),t["ɵmpd"](512,O.t,O.t,[]),t["ɵmpd"](512,O.u,O.u,[]),t["ɵmpd"](512,O.v,O.v,[]),t["ɵmpd"](512,O.w,O.w,[]),t["ɵmpd"](512,Z.a,Z.a,[]),t["ɵmpd"](512,j.h,j.h,[]),t["ɵmpd"](512,H.f,H.f,[]),t["ɵmpd"](512,O.x,O.x,[]),t["ɵmpd"](512,O.y,O.y,[]),t["ɵmpd"](512,O.z,O.z,[]),t["ɵmpd"](512,O.A,O.A,[]),t["ɵmpd"](512,O.B,O.B,[]),t["ɵmpd"](512,O.C,O.C,[]),t["ɵmpd"](512,O.D,O.D,[]),t["ɵmpd"](512,O.E,O.E,[]),t["ɵmpd"](512,O.F,O.F,[]),t["ɵmpd"](512,O.G,O.G,[]),t["ɵmpd"](512,O.H,O.H,[]),t["ɵmpd"](512,O.I,O.I,[]),t["ɵmpd"](512,O.J,O.J,[]),t["ɵmpd"](512,O.K,O.K,[]),t["ɵmpd"](512,O.L,O.L,[]),t["ɵmpd"](512,A.b,A.b,[]),t["ɵmpd"](512,O.M,O.M,[]),t["ɵmpd"](512,O.N,O.N,[]),t["ɵmpd"](512,O.O,O.O,[]),t["ɵmpd"](512,W.NgxDatatableModule,W.NgxDatatableModule,[]),t["ɵmpd"](512,E.e,E.e,[]),t["ɵmpd"](512,M.c,M.c,[]),t["ɵmpd"](512,M.d,M.d,[]),t["ɵmpd"](1024,U.m,U.n,[[3,U.c]]),t["ɵmpd"](512,U.o,U.p,[]),t["ɵmpd"](512,U.q,U.q,[]),t["ɵmpd"](256,U.r,{useHash:!0},[]),t["ɵmpd"](1024,C.LocationStrategy,U.s,[C.PlatformLocation,[2,C.APP_BASE_HREF],U.r]),t["ɵmpd"](512,C.Location,C.Location,[C.LocationStrategy]),t["ɵmpd"](512,t.Compiler,t.Compiler,[]),t["ɵmpd"](512,t.NgModuleFactoryLoader,t.SystemJsNgModuleLoader,[t.Compiler,[2,t.SystemJsNgModuleLoaderConfig]]),t["ɵmpd"](1024,U.t,function(){return[[{path:"",redirectTo:"wallets",pathMatch:"full"},{path:"wallets",component:Q.a,data:{breadcrumb:"Wallets"}},{path:"send",component:Y.a,data:{breadcrumb:"Send Skycoin"}},{path:"history",children:[{path:"",component:J.a,data:{breadcrumb:"History"}},{path:":transaction",component:K.a,data:{breadcrumb:"Transaction"}}]},{path:"explorer",children:[{path:"",component:$.a,data:{breadcrumb:"Explorer"}},{path:"address/:address",component:X.a,data:{breadcrumb:"Address"}},{path:":block",component:nn.a,data:{breadcrumb:"Block"}},{path:"transaction/:transaction",component:K.a,data:{breadcrumb:"Transaction"}}]},{path:"buy",component:ln.a,data:{breadcrumb:"Buy Skycoin"}},{path:"settings",children:[{path:"backup",component:en.a,data:{breadcrumb:"Backup"}},{path:"blockchain",component:tn.a,data:{breadcrumb:"Blockchain"}},{path:"network",component:un.a,data:{breadcrumb:"Networking"}},{path:"outputs",component:rn.a,data:{breadcrumb:"Outputs"}},{path:"pending-transactions",component:on.a,data:{breadcrumb:"Pending transactions"}}]}]]},[]),t["ɵmpd"](1024,U.c,U.u,[t.ApplicationRef,U.o,U.q,C.Location,t.Injector,t.NgModuleFactoryLoader,t.Compiler,U.t,U.r,[2,U.v],[2,U.w]]),t["ɵmpd"](512,U.x,U.x,[[2,U.m],[2,U.c]]),t["ɵmpd"](512,u.a,u.a,[]),t["ɵmpd"](256,O.P,{overlapTrigger:!0,xPosition:"after",yPosition:"below"},[])])})},"/Tz7":function(n,l,e){"use strict";var t=e("qbdv");e.d(l,"a",function(){return u});var u=function(){function n(n){this.location=n}return n.prototype.onClick=function(){this.location.back()},n.ctorParameters=function(){return[{type:t.Location}]},n}()},1:function(n,l,e){n.exports=e("cDNt")},"1Zxj":function(n,l,e){"use strict";var t=e("CPp0"),u=e("bKpL"),i=(e.n(u),e("S7im")),r=(e.n(i),e("xpf9")),o=(e.n(r),e("5v8a"));e.n(o);e.d(l,"a",function(){return a});var a=function(){function n(n){this.http=n,this.url="http://127.0.0.1:7520/"}return n.prototype.get=function(n,l){return void 0===l&&(l=null),this.http.get(this.getUrl(n,l),this.getHeaders()).map(function(n){return n.json()}).catch(function(n){return u.Observable.throw(n||"Server error")})},n.prototype.post=function(n,l){var e=this;return void 0===l&&(l={}),this.getCsrfToken().flatMap(function(t){var c=e.returnRequestOptions();return c.headers.append("X-CSRF-Token",t),e.http.post(e.getUrl(n),e.getQueryString(l),c)}).map(function(n){return n.json()}).catch(function(n){return u.Observable.throw(n||"Server error")})},n.prototype.getCsrfToken=function(){return this.get("csrf").map(function(n){return n.csrf_token})},n.prototype.getHeaders=function(){var n=new t.l;return n.append("Content-Type","application/x-www-form-urlencoded"),n},n.prototype.returnRequestOptions=function(){var n=new t.g;return n.headers=this.getHeaders(),n},n.prototype.getQueryString=function(n){return void 0===n&&(n=null),n?Object.keys(n).reduce(function(l,e){return l.push(e+"="+encodeURIComponent(n[e])),l},[]).join("&"):""},n.prototype.getUrl=function(n,l){return void 0===l&&(l=null),this.url+n+"?"+this.getQueryString(l)},n.ctorParameters=function(){return[{type:t.i}]},n}()},"2yQV":function(n,l,e){"use strict";var t=e("cP51"),u=e("BkNc"),i=e("Pic8");e.n(i);e.d(l,"a",function(){return r});var r=function(){function n(n,l){this.route=n,this.walletService=l}return n.prototype.ngOnInit=function(){var n=this;this.route.params.switchMap(function(l){return n.walletService.transaction(l.transaction)}).subscribe(function(l){n.transaction=l,n.total=l.txn.outputs.reduce(function(n,l){return n+parseInt(l.coins)},0)})},n.ctorParameters=function(){return[{type:u.a},{type:t.a}]},n}()},"3VAl":function(n,l,e){"use strict";var t=e("/oeL"),u=e("cP51"),i=e("bm2B"),r=e("Z04r"),o=e("qwxJ");e.n(o);e.d(l,"a",function(){return a});var a=function(){function n(n,l,e,t){this.data=n,this.dialogRef=l,this.formBuilder=e,this.walletService=t}return n.prototype.ngOnInit=function(){this.initForm()},n.prototype.rename=function(){var n=this;this.walletService.renameWallet(this.data,this.form.value.label).subscribe(function(){return n.dialogRef.close(n.form.value.label)})},n.prototype.initForm=function(){this.form=this.formBuilder.group({label:[this.data.meta.label,i.k.required]})},n.ctorParameters=function(){return[{type:o.WalletModel,decorators:[{type:t.Inject,args:[r._103]}]},{type:r.R},{type:i.a},{type:u.a}]},n}()},"5ERs":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t={otcEnabled:!1}},"5WMG":function(n,l,e){"use strict";var t=e("cP51"),u=e("Z04r"),i=e("kRbD");e.d(l,"a",function(){return r});var r=function(){function n(n,l){this.walletService=n,this.dialog=l}return n.prototype.addWallet=function(){var n=new u.Q;n.width="500px",this.dialog.open(i.a,n).afterClosed().subscribe(function(n){})},n.ctorParameters=function(){return[{type:t.a},{type:u.e}]},n}()},"6e64":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.transform=function(n){switch(n){case"waiting_deposit":return"Waiting for Bitcoin deposit";case"waiting_send":return"Waiting to send Skycoin";case"waiting_confirm":return"Waiting for confirmation";case"done":return"Completed";default:return"Unknown"}},n}()},"79YC":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".input-field[_ngcontent-%COMP%]{display:block;margin-top:20px;width:100%}.send-skycoin-form[_ngcontent-%COMP%]{padding-top:40px}md-select[_ngcontent-%COMP%]{padding-bottom:1.29688em}md-card[_ngcontent-%COMP%]{margin-bottom:20px}"]},"7Bws":function(n,l,e){"use strict";var t=e("SJy7"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.blockchainService=n,this.router=l}return n.prototype.ngOnInit=function(){var n=this;this.blockchainService.blocks().subscribe(function(l){return n.blocks=l})},n.prototype.onActivate=function(n){n.row&&n.row.header&&this.router.navigate(["/explorer",n.row.header.seq])},n.ctorParameters=function(){return[{type:t.a},{type:u.c}]},n}()},"7zlW":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},"8Sw2":function(n,l,e){function t(n){return e(u(n))}function u(n){var l=i[n];if(!(l+1))throw new Error("Cannot find module '"+n+"'.");return l}var i={"./af":"FQor","./af.js":"FQor","./ar":"EcI0","./ar-dz":"cw72","./ar-dz.js":"cw72","./ar-kw":"fu+8","./ar-kw.js":"fu+8","./ar-ly":"7CVz","./ar-ly.js":"7CVz","./ar-ma":"1dFW","./ar-ma.js":"1dFW","./ar-sa":"UxV2","./ar-sa.js":"UxV2","./ar-tn":"nm31","./ar-tn.js":"nm31","./ar.js":"EcI0","./az":"EbpX","./az.js":"EbpX","./be":"SQlX","./be.js":"SQlX","./bg":"R3V+","./bg.js":"R3V+","./bn":"cAX3","./bn.js":"cAX3","./bo":"dHgA","./bo.js":"dHgA","./br":"W7ww","./br.js":"W7ww","./bs":"lZ6K","./bs.js":"lZ6K","./ca":"udbC","./ca.js":"udbC","./cs":"oSQb","./cs.js":"oSQb","./cv":"yPEm","./cv.js":"yPEm","./cy":"PpYB","./cy.js":"PpYB","./da":"oiZG","./da.js":"oiZG","./de":"LYe6","./de-at":"KOG5","./de-at.js":"KOG5","./de-ch":"o3JY","./de-ch.js":"o3JY","./de.js":"LYe6","./dv":"OkYT","./dv.js":"OkYT","./el":"QFnp","./el.js":"QFnp","./en-au":"dBGl","./en-au.js":"dBGl","./en-ca":"vv0C","./en-ca.js":"vv0C","./en-gb":"IoCy","./en-gb.js":"IoCy","./en-ie":"qVAg","./en-ie.js":"qVAg","./en-nz":"FbT0","./en-nz.js":"FbT0","./eo":"y/6j","./eo.js":"y/6j","./es":"ivpd","./es-do":"DBbP","./es-do.js":"DBbP","./es.js":"ivpd","./et":"FrUj","./et.js":"FrUj","./eu":"LAVD","./eu.js":"LAVD","./fa":"J2cK","./fa.js":"J2cK","./fi":"5FCP","./fi.js":"5FCP","./fo":"jIZb","./fo.js":"jIZb","./fr":"Lq8I","./fr-ca":"ICRA","./fr-ca.js":"ICRA","./fr-ch":"QWmo","./fr-ch.js":"QWmo","./fr.js":"Lq8I","./fy":"Fbnp","./fy.js":"Fbnp","./gd":"zhFu","./gd.js":"zhFu","./gl":"o10m","./gl.js":"o10m","./gom-latn":"UoXv","./gom-latn.js":"UoXv","./he":"gbvS","./he.js":"gbvS","./hi":"+UQz","./hi.js":"+UQz","./hr":"qGYH","./hr.js":"qGYH","./hu":"hGGh","./hu.js":"hGGh","./hy-am":"1AR5","./hy-am.js":"1AR5","./id":"Ie9h","./id.js":"Ie9h","./is":"piS+","./is.js":"piS+","./it":"5P9/","./it.js":"5P9/","./ja":"3cRe","./ja.js":"3cRe","./jv":"vwCJ","./jv.js":"vwCJ","./ka":"R7aq","./ka.js":"R7aq","./kk":"d8hV","./kk.js":"d8hV","./km":"nCdc","./km.js":"nCdc","./kn":"5K8n","./kn.js":"5K8n","./ko":"1jN3","./ko.js":"1jN3","./ky":"74/W","./ky.js":"74/W","./lb":"XAyT","./lb.js":"XAyT","./lo":"w/Mz","./lo.js":"w/Mz","./lt":"IetG","./lt.js":"IetG","./lv":"CRPd","./lv.js":"CRPd","./me":"1mee","./me.js":"1mee","./mi":"yRr3","./mi.js":"yRr3","./mk":"bfn1","./mk.js":"bfn1","./ml":"r1ky","./ml.js":"r1ky","./mr":"+K6p","./mr.js":"+K6p","./ms":"asMk","./ms-my":"IcWU","./ms-my.js":"IcWU","./ms.js":"asMk","./my":"Isng","./my.js":"Isng","./nb":"FjNp","./nb.js":"FjNp","./ne":"P9zQ","./ne.js":"P9zQ","./nl":"pejQ","./nl-be":"c3/i","./nl-be.js":"c3/i","./nl.js":"pejQ","./nn":"ALD8","./nn.js":"ALD8","./pa-in":"1YTd","./pa-in.js":"1YTd","./pl":"Gs99","./pl.js":"Gs99","./pt":"eunO","./pt-br":"uHKL","./pt-br.js":"uHKL","./pt.js":"eunO","./ro":"1FZw","./ro.js":"1FZw","./ru":"Cy48","./ru.js":"Cy48","./sd":"+28k","./sd.js":"+28k","./se":"EiHj","./se.js":"EiHj","./si":"77bI","./si.js":"77bI","./sk":"BgyH","./sk.js":"BgyH","./sl":"K7sG","./sl.js":"K7sG","./sq":"pwQJ","./sq.js":"pwQJ","./sr":"N0T2","./sr-cyrl":"9OoP","./sr-cyrl.js":"9OoP","./sr.js":"N0T2","./ss":"Mh9L","./ss.js":"Mh9L","./sv":"YZAt","./sv.js":"YZAt","./sw":"/7jV","./sw.js":"/7jV","./ta":"VKBt","./ta.js":"VKBt","./te":"TIcV","./te.js":"TIcV","./tet":"BlC1","./tet.js":"BlC1","./th":"Zi3L","./th.js":"Zi3L","./tl-ph":"D4Y9","./tl-ph.js":"D4Y9","./tlh":"muBP","./tlh.js":"muBP","./tr":"82s3","./tr.js":"82s3","./tzl":"vjXv","./tzl.js":"vjXv","./tzm":"Vhfe","./tzm-latn":"m0Pg","./tzm-latn.js":"m0Pg","./tzm.js":"Vhfe","./uk":"Y3Ex","./uk.js":"Y3Ex","./ur":"4DIg","./ur.js":"4DIg","./uz":"wDue","./uz-latn":"Li28","./uz-latn.js":"Li28","./uz.js":"wDue","./vi":"UbP6","./vi.js":"UbP6","./x-pseudo":"fQLM","./x-pseudo.js":"fQLM","./yo":"evMD","./yo.js":"evMD","./zh-cn":"DV5H","./zh-cn.js":"DV5H","./zh-hk":"iEKH","./zh-hk.js":"iEKH","./zh-tw":"X9uL","./zh-tw.js":"X9uL"};t.keys=function(){return Object.keys(i)},t.resolve=u,n.exports=t,t.id="8Sw2"},"9z2b":function(n,l,e){"use strict";function t(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,13,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),p["ɵppd"](1),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "," "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      ","\n    "])),p["ɵppd"](1)],null,function(n,l){var e=l.component;n(l,1,0,e.block&&e.block.header?e.block.header.seq:""),n(l,3,0,p["ɵunv"](l,3,0,n(l,4,0,p["ɵnov"](l.parent,0),e.block.header.timestamp))),n(l,6,0,e.block&&e.block.header?e.block.header.block_hash:""),n(l,8,0,e.block&&e.block.header?e.block.header.previous_block_hash:""),n(l,10,0,e.block&&e.block.body&&e.block.body.txns?e.block.body.txns.length:""),n(l,12,0,p["ɵunv"](l,12,0,n(l,13,0,p["ɵnov"](l.parent,1),e.block.body.txns)))})}function u(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==p["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==p["ɵnov"](n,2)._handleBlur()&&t}return t},f.y,f.z)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](1097728,null,2,m._71,[p.Renderer2,p.ElementRef,[2,m._70],[2,m._72]],null,null),p["ɵqud"](603979776,1,{_lines:1}),p["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),p["ɵted"](2,["\n          "])),(n()(),p["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[1,4]],0,m._111,[],null,null),(n()(),p["ɵted"](null,["",""])),(n()(),p["ɵted"](2,["\n        "]))],null,function(n,l){n(l,8,0,l.context.$implicit)})}function i(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==p["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==p["ɵnov"](n,2)._handleBlur()&&t}return t},f.y,f.z)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](1097728,null,2,m._71,[p.Renderer2,p.ElementRef,[2,m._70],[2,m._72]],null,null),p["ɵqud"](603979776,3,{_lines:1}),p["ɵqud"](335544320,4,{_hasAvatar:0}),(n()(),p["ɵted"](2,["\n          "])),(n()(),p["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[3,4]],0,m._111,[],null,null),(n()(),p["ɵted"](null,[""," ("," SC2)"])),(n()(),p["ɵted"](2,["\n        "]))],null,function(n,l){n(l,8,0,l.context.$implicit.dst,l.context.$implicit.coins)})}function r(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,23,"md-list",[["class","mat-list"],["role","list"]],null,null,null,f.C,f.D)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,m._70,[],null,null),p["ɵdid"](16384,null,0,m._113,[],null,null),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._112,[],null,null),(n()(),p["ɵted"](null,["Inputs"])),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵand"](16777216,null,0,1,null,u)),p["ɵdid"](802816,null,0,v.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵeld"](0,null,0,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,m._109,[],null,null),p["ɵdid"](16384,null,0,m._110,[],null,null),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._112,[],null,null),(n()(),p["ɵted"](null,["Outputs"])),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵand"](16777216,null,0,1,null,i)),p["ɵdid"](802816,null,0,v.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](0,["\n      "]))],function(n,l){n(l,10,0,l.parent.context.$implicit.inputs),n(l,22,0,l.parent.context.$implicit.outputs)},null)}function o(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,30,"md-expansion-panel",[["class","mat-expansion-panel"]],[[2,"mat-expanded",null],[2,"mat-expansion-panel-spacing",null]],null,null,f.E,f.F)),p["ɵprd"](6144,null,m._61,null,[m._62]),p["ɵdid"](704512,null,0,m._62,[[8,null],p.ChangeDetectorRef,m.f],null,null),(n()(),p["ɵted"](1,["\n      "])),(n()(),p["ɵeld"](0,null,0,11,"md-expansion-panel-header",[["class","mat-expansion-panel-header"],["role","button"]],[[1,"tabindex",0],[1,"aria-controls",0],[1,"aria-expanded",0],[1,"aria-disabled",0],[2,"mat-expanded",null],[40,"@expansionHeight",0]],[[null,"click"],[null,"keyup"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==p["ɵnov"](n,5)._toggle()&&t}if("keyup"===l){t=!1!==p["ɵnov"](n,5)._keyup(e)&&t}return t},f.G,f.H)),p["ɵdid"](180224,null,0,m._64,[m._62,p.Renderer2,p.ElementRef,m.a,p.ChangeDetectorRef],null,null),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,0,2,"md-panel-title",[["class","mat-expansion-panel-header-title"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._114,[],null,null),(n()(),p["ɵted"](null,["\n          ","\n        "])),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,1,3,"md-panel-description",[["class","mat-expansion-panel-header-description"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m._115,[],null,null),(n()(),p["ɵted"](null,["\n          ","\n        "])),p["ɵppd"](1),(n()(),p["ɵted"](2,["\n      "])),(n()(),p["ɵted"](1,["\n      "])),(n()(),p["ɵand"](16777216,null,1,1,null,r)),p["ɵdid"](16384,null,0,v.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](1,["\n      "])),(n()(),p["ɵeld"](0,null,1,9,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n        "])),(n()(),p["ɵeld"](0,null,null,6,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0],[1,"target",0],[8,"href",4]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==p["ɵnov"](n,24)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==p["ɵnov"](n,26).onClick(e.button,e.ctrlKey,e.metaKey,e.shiftKey)&&t}return t},f.q,f.r)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](180224,null,0,m._38,[b.a,m.a,p.ElementRef,p.Renderer2],{color:[0,"color"]},null),p["ɵdid"](16384,null,0,m._107,[],null,null),p["ɵdid"](671744,null,0,h.B,[h.c,h.a,v.LocationStrategy],{routerLink:[0,"routerLink"]},null),p["ɵpad"](2),(n()(),p["ɵted"](0,["Details"])),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵted"](1,["\n    "]))],function(n,l){n(l,18,0,l.context.$implicit);n(l,24,0,"primary"),n(l,26,0,n(l,27,0,"/explorer/transaction/",l.context.$implicit.txid))},function(n,l){var e=l.component;n(l,0,0,p["ɵnov"](l,2).expanded,p["ɵnov"](l,2)._hasSpacing()),n(l,4,0,p["ɵnov"](l,5).panel.disabled?-1:0,p["ɵnov"](l,5)._getPanelId(),p["ɵnov"](l,5)._isExpanded(),p["ɵnov"](l,5).panel.disabled,p["ɵnov"](l,5)._isExpanded(),p["ɵnov"](l,5)._getExpandedState()),n(l,9,0,l.context.$implicit.txid),n(l,13,0,p["ɵunv"](l,13,0,n(l,14,0,p["ɵnov"](l.parent.parent,0),e.block.header.timestamp))),n(l,22,0,p["ɵnov"](l,24).disabled?-1:0,p["ɵnov"](l,24).disabled||null,p["ɵnov"](l,24).disabled.toString(),p["ɵnov"](l,26).target,p["ɵnov"](l,26).href)})}function a(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,4,"div",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n    "])),(n()(),p["ɵand"](16777216,null,null,1,null,o)),p["ɵdid"](802816,null,0,v.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](null,["\n  "]))],function(n,l){n(l,3,0,l.component.block.body.txns)},null)}function d(n){return p["ɵvid"](0,[p["ɵpid"](0,g.a,[]),p["ɵpid"](0,y.a,[]),(n()(),p["ɵeld"](0,null,null,39,"md-card",[["class","mat-card"]],null,null,null,f.w,f.x)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,m._42,[],null,null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,5,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,m._108,[],null,null),(n()(),p["ɵted"](null,["Block "," "])),(n()(),p["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,_.a,_.b)),p["ɵdid"](49152,null,0,x.a,[v.Location],null,null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,21,"md-card",[["class","skycoin-details mat-card"]],null,null,null,f.w,f.x)),p["ɵdid"](16384,null,0,m.T,[[2,m.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,m._42,[],null,null),(n()(),p["ɵted"](0,["\n    "])),(n()(),p["ɵeld"](0,null,0,13,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      ID: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Timestamp: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Hash: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Parent hash: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Transactions: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      Total value: "])),(n()(),p["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n    "])),(n()(),p["ɵted"](0,["\n    "])),(n()(),p["ɵand"](16777216,null,0,1,null,t)),p["ɵdid"](16384,null,0,v.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵted"](0,["\n\n  "])),(n()(),p["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["Transactions"])),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵand"](16777216,null,0,1,null,a)),p["ɵdid"](16384,null,0,v.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](0,["\n"])),(n()(),p["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,33,0,e.block),n(l,40,0,e.block&&e.block.body&&e.block.body.txns)},function(n,l){var e=l.component;n(l,9,0,e.block&&e.block.header?e.block.header.seq:"..")})}function c(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,1,"app-block",[],null,null,null,d,C)),p["ɵdid"](114688,null,0,k.a,[w.a,h.a],null,null)],function(n,l){n(l,1,0)},null)}var s=e("7zlW"),p=e("/oeL"),f=e("v6Q/"),m=e("Z04r"),v=e("qbdv"),b=e("JYHx"),h=e("BkNc"),g=e("lL01"),y=e("Y66J"),_=e("zVgQ"),x=e("/Tz7"),k=e("KwZO"),w=e("SJy7");e.d(l,"a",function(){return S});var R=[s.a],C=p["ɵcrt"]({encapsulation:0,styles:R,data:{}}),S=p["ɵccf"]("app-block",k.a,c,{},{},[])},A4rF:function(n,l,e){"use strict";var t=e("cP51"),u=e("Z04r"),i=e("3VAl"),r=e("Qm7a");e.d(l,"a",function(){return o});var o=function(){function n(n,l){this.walletService=n,this.dialog=l}return n.prototype.addAddress=function(){var n=this;this.walletService.addAddress(this.wallet).subscribe(function(l){return n.wallet.entries.push(l)})},n.prototype.renameWallet=function(){var n=this,l=new u.Q;l.width="500px",l.data=this.wallet,this.dialog.open(i.a,l).afterClosed().subscribe(function(l){l&&(n.wallet.meta.label=l)})},n.prototype.showQr=function(n){var l=new u.Q;l.data=n,this.dialog.open(r.a,l)},n.ctorParameters=function(){return[{type:t.a},{type:u.e}]},n}()},ApLd:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},"Aq/i":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-input-container[_ngcontent-%COMP%]{width:100%}"]},B4F0:function(n,l,e){"use strict";var t=e("bm2B"),u=e("cP51"),i=e("ScY5"),r=e("Z04r");e.d(l,"a",function(){return o});var o=function(){function n(n,l,e,t){this.walletService=n,this.dialogRef=l,this.formBuilder=e,this.purchaseService=t}return n.prototype.ngOnInit=function(){this.initForm()},n.prototype.generate=function(){var n=this;this.purchaseService.generate(this.form.value.address).subscribe(function(){return n.dialogRef.close()})},n.prototype.initForm=function(){this.form=this.formBuilder.group({address:["",t.k.required]})},n.ctorParameters=function(){return[{type:u.a},{type:r.R},{type:t.a},{type:i.a}]},n}()},BZkh:function(n,l,e){"use strict";var t=e("ScY5"),u=e("Z04r"),i=e("B4F0"),r=e("5ERs");e.d(l,"a",function(){return o});var o=function(){function n(n,l){this.purchaseService=n,this.dialog=l,this.orders=[],this.scanning=!1,this.otcEnabled=r.a.otcEnabled}return n.prototype.addDepositAddress=function(){var n=new u.Q;n.width="500px",this.dialog.open(i.a,n)},n.prototype.searchDepositAddress=function(n){var l=this;this.scanning=!0,this.purchaseService.scan(n).subscribe(function(){l.disableScanning()},function(n){l.disableScanning()})},n.prototype.disableScanning=function(){var n=this;setTimeout(function(){return n.scanning=!1},1e3)},n.ctorParameters=function(){return[{type:t.a},{type:u.e}]},n}()},BruE:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.transform=function(n){return null==n||n<0?"loading .. ":(n?n/1e6:0)+" SC2"},n}()},C2LC:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,25,"md-expansion-panel",[["class","mat-expansion-panel"]],[[2,"mat-expanded",null],[2,"mat-expansion-panel-spacing",null]],null,null,a.E,a.F)),o["ɵprd"](6144,null,d._61,null,[d._62]),o["ɵdid"](704512,null,0,d._62,[[8,null],o.ChangeDetectorRef,d.f],null,null),(n()(),o["ɵted"](1,["\n  "])),(n()(),o["ɵeld"](0,null,0,17,"md-expansion-panel-header",[["class","mat-expansion-panel-header"],["role","button"]],[[1,"tabindex",0],[1,"aria-controls",0],[1,"aria-expanded",0],[1,"aria-disabled",0],[2,"mat-expanded",null],[40,"@expansionHeight",0]],[[null,"click"],[null,"keyup"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==o["ɵnov"](n,5)._toggle()&&t}if("keyup"===l){t=!1!==o["ɵnov"](n,5)._keyup(e)&&t}return t},a.G,a.H)),o["ɵdid"](180224,null,0,d._64,[d._62,o.Renderer2,o.ElementRef,d.a,o.ChangeDetectorRef],null,null),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,0,2,"md-panel-title",[["class","mat-expansion-panel-header-title"]],null,null,null,null,null)),o["ɵdid"](16384,null,0,d._114,[],null,null),(n()(),o["ɵted"](null,["\n      ","\n    "])),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,1,9,"md-panel-description",[["class","mat-expansion-panel-header-description"]],null,null,null,null,null)),o["ɵdid"](16384,null,0,d._115,[],null,null),(n()(),o["ɵted"](null,["\n      "])),(n()(),o["ɵeld"](0,null,null,2,"span",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["",""])),o["ɵppd"](1),(n()(),o["ɵted"](null,[" "])),(n()(),o["ɵeld"](0,null,null,1,"span",[],null,null,null,null,null)),(n()(),o["ɵted"](null,[""," Coin Hours"])),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵted"](2,["\n  "])),(n()(),o["ɵted"](1,["\n  "])),(n()(),o["ɵeld"](0,null,1,1,"app-wallet-detail",[],null,null,null,c.a,c.b)),o["ɵdid"](49152,null,0,s.a,[p.a,d.e],{wallet:[0,"wallet"]},null),(n()(),o["ɵted"](1,["\n"]))],function(n,l){n(l,24,0,l.context.$implicit)},function(n,l){n(l,0,0,o["ɵnov"](l,2).expanded,o["ɵnov"](l,2)._hasSpacing()),n(l,4,0,o["ɵnov"](l,5).panel.disabled?-1:0,o["ɵnov"](l,5)._getPanelId(),o["ɵnov"](l,5)._isExpanded(),o["ɵnov"](l,5).panel.disabled,o["ɵnov"](l,5)._isExpanded(),o["ɵnov"](l,5)._getExpandedState()),n(l,9,0,l.context.$implicit.meta.label),n(l,15,0,o["ɵunv"](l,15,0,n(l,16,0,o["ɵnov"](l.parent,0),l.context.$implicit.balance))),n(l,19,0,l.context.$implicit.hours?l.context.$implicit.hours:0)})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,f.a,[]),(n()(),o["ɵand"](16777216,null,null,2,null,t)),o["ɵdid"](802816,null,0,m.NgForOf,[o.ViewContainerRef,o.TemplateRef,o.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),o["ɵpid"](131072,m.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,9)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.addWallet()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[b.a,d.a,o.ElementRef,o.Renderer2],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Add wallet"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,2,0,o["ɵunv"](l,2,0,o["ɵnov"](l,3).transform(e.walletService.all())));n(l,9,0,"primary")},function(n,l){n(l,7,0,o["ɵnov"](l,9).disabled?-1:0,o["ɵnov"](l,9).disabled||null,o["ɵnov"](l,9).disabled.toString())})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-wallets",[],null,null,null,u,g)),o["ɵdid"](49152,null,0,v.a,[p.a,d.e],null,null)],null,null)}var r=e("k9Ay"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("Mjq+"),s=e("A4rF"),p=e("cP51"),f=e("BruE"),m=e("qbdv"),v=e("5WMG"),b=e("JYHx");e.d(l,"a",function(){return y});var h=[r.a],g=o["ɵcrt"]({encapsulation:0,styles:h,data:{}}),y=o["ɵccf"]("app-wallets",v.a,i,{},{},[])},Caxp:function(n,l,e){"use strict";var t=e("ge1L");e.n(t);e.d(l,"a",function(){return u});var u=function(){function n(){}return n.prototype.transform=function(n){return t.unix(n).fromNow()},n}()},CpEn:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-icon[_ngcontent-%COMP%]{margin-left:10px;opacity:.3}md-spinner[_ngcontent-%COMP%]{display:inline-block;height:24px!important;width:24px!important;margin-left:10px}"]},DtrN:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},HHiG:function(n,l,e){"use strict";var t=e("cP51"),u=e("ge1L"),i=(e.n(u),e("BkNc"));e.d(l,"a",function(){return r});var r=function(){function n(n,l){this.walletService=n,this.router=l}return n.prototype.ngOnInit=function(){var n=this;this.walletService.pendingTransactions().subscribe(function(l){n.transactions=n.mapTransactions(l)})},n.prototype.onActivate=function(n){n.row&&n.row.txid&&this.router.navigate(["/history",n.row.txid])},n.prototype.mapTransactions=function(n){return n.map(function(n){return n.transaction.timestamp=u(n.received).unix(),n.transaction}).map(function(n){return n.amount=n.outputs.map(function(n){return n.coins>=0?n.coins:0}).reduce(function(n,l){return n+parseInt(l)},0),n})},n.ctorParameters=function(){return[{type:t.a},{type:i.c}]},n}()},IFVI:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-card[_ngcontent-%COMP%], ngx-datatable[_ngcontent-%COMP%]{margin-bottom:20px}md-card[_ngcontent-%COMP%]   h3[_ngcontent-%COMP%]   button[_ngcontent-%COMP%]{display:inline;float:right;margin-top:-8px}"]},KbYb:function(n,l,e){"use strict";var t=e("cP51");e.d(l,"a",function(){return u});var u=function(){function n(n){this.walletService=n}return n.prototype.ngOnInit=function(){var n=this;this.walletService.outputs().subscribe(function(l){return n.outputs=l.head_outputs})},n.ctorParameters=function(){return[{type:t.a}]},n}()},KwZO:function(n,l,e){"use strict";var t=e("SJy7"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.blockchainService=n,this.route=l}return n.prototype.ngOnInit=function(){var n=this;this.route.params.switchMap(function(l){return n.blockchainService.block(l.block)}).subscribe(function(l){n.block=l})},n.ctorParameters=function(){return[{type:t.a},{type:u.a}]},n}()},Lx3X:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-icon[_ngcontent-%COMP%]{cursor:pointer}"]},"Mjq+":function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,13,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==o["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==o["ɵnov"](n,2)._handleBlur()&&t}return t},a.y,a.z)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](1097728,null,2,d._71,[o.Renderer2,o.ElementRef,[2,d._70],[2,d._72]],null,null),o["ɵqud"](603979776,1,{_lines:1}),o["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,0,1,"i",[["class","fa fa-qrcode mat-list-icon"],["md-list-icon",""]],null,[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.showQr(n.context.$implicit)&&t}return t},null,null)),o["ɵdid"](16384,null,0,d._116,[],null,null),(n()(),o["ɵted"](2,["\n    "])),(n()(),o["ɵeld"](0,null,1,3,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],0,d._111,[],null,null),(n()(),o["ɵted"](null,[""," - "," ("," Coin Hours)"])),o["ɵppd"](1),(n()(),o["ɵted"](2,["\n  "]))],null,function(n,l){n(l,11,0,l.context.$implicit.address,o["ɵunv"](l,11,1,n(l,12,0,o["ɵnov"](l.parent,0),l.context.$implicit.balance)),l.context.$implicit.hours?l.context.$implicit.hours:0)})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,c.a,[]),(n()(),o["ɵeld"](0,null,null,26,"md-list",[["class","mat-list"],["role","list"]],null,null,null,a.C,a.D)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,d._70,[],null,null),o["ɵdid"](16384,null,0,d._113,[],null,null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),o["ɵdid"](16384,null,0,d._112,[],null,null),(n()(),o["ɵted"](null,["Addresses"])),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵand"](16777216,null,0,1,null,t)),o["ɵdid"](802816,null,0,s.NgForOf,[o.ViewContainerRef,o.TemplateRef,o.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,13,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,17)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.renameWallet()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[f.a,d.a,o.ElementRef,o.Renderer2],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Rename wallet"])),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,23)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.addAddress()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[f.a,d.a,o.ElementRef,o.Renderer2],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Add address"])),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](0,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){n(l,11,0,l.component.wallet.entries);n(l,17,0,"primary");n(l,23,0,"primary")},function(n,l){n(l,15,0,o["ɵnov"](l,17).disabled?-1:0,o["ɵnov"](l,17).disabled||null,o["ɵnov"](l,17).disabled.toString()),n(l,21,0,o["ɵnov"](l,23).disabled?-1:0,o["ɵnov"](l,23).disabled||null,o["ɵnov"](l,23).disabled.toString())})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-wallet-detail",[],null,null,null,u,b)),o["ɵdid"](49152,null,0,p.a,[m.a,d.e],null,null)],null,null)}var r=e("Lx3X"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("BruE"),s=e("qbdv"),p=e("A4rF"),f=e("JYHx"),m=e("cP51");e.d(l,"b",function(){return b}),l.a=u;var v=[r.a],b=o["ɵcrt"]({encapsulation:0,styles:v,data:{}});o["ɵccf"]("app-wallet-detail",p.a,i,{wallet:"wallet"},{},[])},"NED+":function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵted"](null,["\n      "])),(n()(),o["ɵeld"](0,null,null,4,"button",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.download(n.context.row)&&t}return t},a.e,a.f)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d.V,[o.Renderer2,o.ElementRef,c.a,d.a],{color:[0,"color"]},null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Download"])),(n()(),o["ɵted"](null,["\n    "]))],function(n,l){n(l,3,0,"primary")},function(n,l){n(l,1,0,o["ɵnov"](l,3).disabled||null)})}function u(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,9,"md-card",[["class","mat-card"]],null,null,null,a.w,a.x)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,d._42,[],null,null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,1,"p",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["Wallet Directory: ",""])),(n()(),o["ɵted"](0,["\n\n  "])),(n()(),o["ɵeld"](0,null,0,1,"p",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["BACKUP YOUR SEED. ON PAPER. IN A SAFE PLACE. As long as you have your seed, you can recover your coins."])),(n()(),o["ɵted"](0,["\n"])),(n()(),o["ɵted"](null,["\n\n"])),(n()(),o["ɵeld"](0,null,null,25,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==o["ɵnov"](n,12).onWindowResize()&&t}return t},s.a,s.b)),o["ɵdid"](4571136,[["table",4]],3,p.DatatableComponent,[f.ScrollbarHelper,o.ElementRef,o.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},null),o["ɵqud"](603979776,1,{columnTemplates:1}),o["ɵqud"](335544320,2,{rowDetail:0}),o["ɵqud"](335544320,3,{footer:0}),o["ɵpid"](131072,m.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Wallet label"],["prop","meta.label"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,v.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,4,{cellTemplate:0}),o["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","File name"],["prop","meta.filename"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,v.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,6,{cellTemplate:0}),o["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Download"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,v.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),o["ɵqud"](335544320,8,{cellTemplate:0}),o["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵand"](0,[[8,2]],null,1,null,t)),o["ɵdid"](16384,null,0,b.DataTableColumnCellDirective,[o.TemplateRef],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,12,0,o["ɵunv"](l,12,0,o["ɵnov"](l,16).transform(e.walletService.all())),!0,50,"flex",50,50,10);n(l,19,0,"Wallet label","meta.label",1);n(l,24,0,"File name","meta.filename",1);n(l,29,0,"Download",1)},function(n,l){n(l,5,0,l.component.folder),n(l,11,0,o["ɵnov"](l,12).isFixedHeader,o["ɵnov"](l,12).isFixedRow,o["ɵnov"](l,12).isVertScroll,o["ɵnov"](l,12).isHorScroll,o["ɵnov"](l,12).isSelectable,o["ɵnov"](l,12).isCheckboxSelection,o["ɵnov"](l,12).isCellSelection,o["ɵnov"](l,12).isSingleSelection,o["ɵnov"](l,12).isMultiSelection,o["ɵnov"](l,12).isMultiClickSelection)})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-backup",[],null,null,null,u,_)),o["ɵdid"](245760,null,0,h.a,[g.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("a9x3"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("LwgZ"),p=e("f2O0"),f=(e.n(p),e("7i8B")),m=(e.n(f),e("qbdv")),v=e("47oE"),b=(e.n(v),e("GHh8")),h=(e.n(b),e("WZ1N")),g=e("cP51");e.d(l,"a",function(){return x});var y=[r.a],_=o["ɵcrt"]({encapsulation:0,styles:y,data:{}}),x=o["ɵccf"]("app-backup",h.a,i,{},{},[])},ONOE:function(n,l,e){"use strict";var t=e("SJy7"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.blockchainService=n,this.route=l}return n.prototype.ngOnInit=function(){var n=this;this.route.params.switchMap(function(l){return n.id=l.address,n.blockchainService.addressTransactions(l.address)}).subscribe(function(l){n.transactions=l,console.log(l)}),this.route.params.switchMap(function(l){return n.blockchainService.addressBalance(l.address)}).subscribe(function(l){n.balance=l,console.log(l)})},n.ctorParameters=function(){return[{type:t.a},{type:u.a}]},n}()},PRn9:function(n,l,e){"use strict";var t=e("dSyq");e.d(l,"a",function(){return u});var u=function(){function n(n){this.networkService=n}return n.prototype.ngOnInit=function(){var n=this;this.networkService.automatic().subscribe(function(n){return console.log(n)}),this.networkService.retrieveDefaultConnections().first().subscribe(function(l){return n.defaultConnections=l})},n.ctorParameters=function(){return[{type:t.a}]},n}()},Pdzc:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-input-container[_ngcontent-%COMP%]{width:100%}"]},QAaR:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}"]},Qgkl:function(n,l,e){"use strict";function t(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,6,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      "," "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      "," "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      ","\n    "])),s["ɵppd"](1)],null,function(n,l){var e=l.component;n(l,1,0,e.id),n(l,3,0,e.transactions.length),n(l,5,0,s["ɵunv"](l,5,0,n(l,6,0,s["ɵnov"](l.parent,0),e.transactions)))})}function u(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==s["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==s["ɵnov"](n,2)._handleBlur()&&t}return t},p.y,p.z)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](1097728,null,2,f._71,[s.Renderer2,s.ElementRef,[2,f._70],[2,f._72]],null,null),s["ɵqud"](603979776,1,{_lines:1}),s["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),s["ɵted"](2,["\n        "])),(n()(),s["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),s["ɵdid"](16384,[[1,4]],0,f._111,[],null,null),(n()(),s["ɵted"](null,["",""])),(n()(),s["ɵted"](2,["\n      "]))],null,function(n,l){n(l,8,0,l.context.$implicit.owner)})}function i(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,9,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==s["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==s["ɵnov"](n,2)._handleBlur()&&t}return t},p.y,p.z)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](1097728,null,2,f._71,[s.Renderer2,s.ElementRef,[2,f._70],[2,f._72]],null,null),s["ɵqud"](603979776,3,{_lines:1}),s["ɵqud"](335544320,4,{_hasAvatar:0}),(n()(),s["ɵted"](2,["\n        "])),(n()(),s["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),s["ɵdid"](16384,[[3,4]],0,f._111,[],null,null),(n()(),s["ɵted"](null,[""," ("," SC2)"])),(n()(),s["ɵted"](2,["\n      "]))],null,function(n,l){n(l,8,0,l.context.$implicit.dst,l.context.$implicit.coins)})}function r(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,23,"md-list",[["class","mat-list"],["role","list"]],null,null,null,p.C,p.D)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](49152,null,0,f._70,[],null,null),s["ɵdid"](16384,null,0,f._113,[],null,null),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._112,[],null,null),(n()(),s["ɵted"](null,["Inputs"])),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵand"](16777216,null,0,1,null,u)),s["ɵdid"](802816,null,0,m.NgForOf,[s.ViewContainerRef,s.TemplateRef,s.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵeld"](0,null,0,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](16384,null,0,f._109,[],null,null),s["ɵdid"](16384,null,0,f._110,[],null,null),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._112,[],null,null),(n()(),s["ɵted"](null,["Outputs"])),(n()(),s["ɵted"](0,["\n      "])),(n()(),s["ɵand"](16777216,null,0,1,null,i)),s["ɵdid"](802816,null,0,m.NgForOf,[s.ViewContainerRef,s.TemplateRef,s.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),s["ɵted"](0,["\n    "]))],function(n,l){n(l,10,0,l.parent.context.$implicit.inputs),n(l,22,0,l.parent.context.$implicit.outputs)},null)}function o(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,30,"md-expansion-panel",[["class","mat-expansion-panel"]],[[2,"mat-expanded",null],[2,"mat-expansion-panel-spacing",null]],null,null,p.E,p.F)),s["ɵprd"](6144,null,f._61,null,[f._62]),s["ɵdid"](704512,null,0,f._62,[[8,null],s.ChangeDetectorRef,f.f],null,null),(n()(),s["ɵted"](1,["\n    "])),(n()(),s["ɵeld"](0,null,0,11,"md-expansion-panel-header",[["class","mat-expansion-panel-header"],["role","button"]],[[1,"tabindex",0],[1,"aria-controls",0],[1,"aria-expanded",0],[1,"aria-disabled",0],[2,"mat-expanded",null],[40,"@expansionHeight",0]],[[null,"click"],[null,"keyup"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==s["ɵnov"](n,5)._toggle()&&t}if("keyup"===l){t=!1!==s["ɵnov"](n,5)._keyup(e)&&t}return t},p.G,p.H)),s["ɵdid"](180224,null,0,f._64,[f._62,s.Renderer2,s.ElementRef,f.a,s.ChangeDetectorRef],null,null),(n()(),s["ɵted"](2,["\n      "])),(n()(),s["ɵeld"](0,null,0,2,"md-panel-title",[["class","mat-expansion-panel-header-title"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._114,[],null,null),(n()(),s["ɵted"](null,["\n        ","\n      "])),(n()(),s["ɵted"](2,["\n      "])),(n()(),s["ɵeld"](0,null,1,3,"md-panel-description",[["class","mat-expansion-panel-header-description"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f._115,[],null,null),(n()(),s["ɵted"](null,["\n        "])),(n()(),s["ɵted"](null,["\n      "])),(n()(),s["ɵted"](2,["\n    "])),(n()(),s["ɵted"](1,["\n    "])),(n()(),s["ɵand"](16777216,null,1,1,null,r)),s["ɵdid"](16384,null,0,m.NgIf,[s.ViewContainerRef,s.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),s["ɵted"](1,["\n    "])),(n()(),s["ɵeld"](0,null,1,9,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      "])),(n()(),s["ɵeld"](0,null,null,6,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0],[1,"target",0],[8,"href",4]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==s["ɵnov"](n,24)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==s["ɵnov"](n,26).onClick(e.button,e.ctrlKey,e.metaKey,e.shiftKey)&&t}return t},p.q,p.r)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](180224,null,0,f._38,[v.a,f.a,s.ElementRef,s.Renderer2],{color:[0,"color"]},null),s["ɵdid"](16384,null,0,f._107,[],null,null),s["ɵdid"](671744,null,0,b.B,[b.c,b.a,m.LocationStrategy],{routerLink:[0,"routerLink"]},null),s["ɵpad"](2),(n()(),s["ɵted"](0,["Details"])),(n()(),s["ɵted"](null,["\n    "])),(n()(),s["ɵted"](1,["\n  "]))],function(n,l){n(l,18,0,l.context.$implicit);n(l,24,0,"primary"),n(l,26,0,n(l,27,0,"/explorer/transaction/",l.context.$implicit.txid))},function(n,l){n(l,0,0,s["ɵnov"](l,2).expanded,s["ɵnov"](l,2)._hasSpacing()),n(l,4,0,s["ɵnov"](l,5).panel.disabled?-1:0,s["ɵnov"](l,5)._getPanelId(),s["ɵnov"](l,5)._isExpanded(),s["ɵnov"](l,5).panel.disabled,s["ɵnov"](l,5)._isExpanded(),s["ɵnov"](l,5)._getExpandedState()),n(l,9,0,l.context.$implicit.txid),n(l,22,0,s["ɵnov"](l,24).disabled?-1:0,s["ɵnov"](l,24).disabled||null,s["ɵnov"](l,24).disabled.toString(),s["ɵnov"](l,26).target,s["ɵnov"](l,26).href)})}function a(n){return s["ɵvid"](0,[s["ɵpid"](0,h.a,[]),(n()(),s["ɵeld"](0,null,null,33,"md-card",[["class","mat-card"]],null,null,null,p.w,p.x)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵeld"](0,null,0,5,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](16384,null,0,f._108,[],null,null),(n()(),s["ɵted"](null,["Address "])),(n()(),s["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,g.a,g.b)),s["ɵdid"](49152,null,0,y.a,[m.Location],null,null),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵeld"](0,null,0,15,"md-card",[["class","skycoin-details mat-card"]],null,null,null,p.w,p.x)),s["ɵdid"](16384,null,0,f.T,[[2,f.U],s.ElementRef],null,null),s["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),s["ɵted"](0,["\n    "])),(n()(),s["ɵeld"](0,null,0,7,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      ID: "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      Transactions: "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n      Balance: "])),(n()(),s["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["\n    "])),(n()(),s["ɵted"](0,["\n    "])),(n()(),s["ɵand"](16777216,null,0,1,null,t)),s["ɵdid"](16384,null,0,m.NgIf,[s.ViewContainerRef,s.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵted"](0,["\n\n  "])),(n()(),s["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),s["ɵted"](null,["Transactions"])),(n()(),s["ɵted"](0,["\n  "])),(n()(),s["ɵand"](16777216,null,0,1,null,o)),s["ɵdid"](802816,null,0,m.NgForOf,[s.ViewContainerRef,s.TemplateRef,s.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),s["ɵted"](0,["\n"])),(n()(),s["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,26,0,e.transactions),n(l,33,0,e.transactions)},null)}function d(n){return s["ɵvid"](0,[(n()(),s["ɵeld"](0,null,null,1,"app-address",[],null,null,null,a,w)),s["ɵdid"](114688,null,0,_.a,[x.a,b.a],null,null)],function(n,l){n(l,1,0)},null)}var c=e("DtrN"),s=e("/oeL"),p=e("v6Q/"),f=e("Z04r"),m=e("qbdv"),v=e("JYHx"),b=e("BkNc"),h=e("Y66J"),g=e("zVgQ"),y=e("/Tz7"),_=e("ONOE"),x=e("SJy7");e.d(l,"a",function(){return R});var k=[c.a],w=s["ɵcrt"]({encapsulation:0,styles:k,data:{}}),R=s["ɵccf"]("app-address",_.a,d,{},{},[])},Qm7a:function(n,l,e){"use strict";var t=e("/oeL"),u=e("Z04r");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.data=n,this.el=l,this.size=300,this.level="M",this.colordark="#000000",this.colorlight="#ffffff",this.usesvg=!1}return n.prototype.ngOnInit=function(){new QRCode(this.qr.nativeElement,{text:this.data.address,width:this.size,height:this.size,colorDark:this.colordark,colorLight:this.colorlight,useSVG:this.usesvg,correctLevel:QRCode.CorrectLevel[this.level.toString()]})},n.ctorParameters=function(){return[{type:void 0,decorators:[{type:t.Inject,args:[u._103]}]},{type:t.ElementRef}]},n}()},Qoso:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,3,"md-icon",[["class","mat-icon"],["role","img"]],null,null,null,c.A,c.B)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](638976,null,0,s._48,[d.Renderer2,d.ElementRef,s.h,[8,null]],null,null),(n()(),d["ɵted"](0,["done"]))],function(n,l){n(l,2,0)},null)}function u(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,3,"md-icon",[["class","mat-icon"],["role","img"]],null,null,null,c.A,c.B)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](638976,null,0,s._48,[d.Renderer2,d.ElementRef,s.h,[8,null]],null,null),(n()(),d["ɵted"](0,["error"]))],function(n,l){n(l,2,0)},null)}function i(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,2,"md-spinner",[["class","in-button mat-spinner mat-progress-spinner"],["mode","indeterminate"],["role","progressbar"]],null,null,null,c.I,c.J)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s._80,[d.ElementRef,d.NgZone,d.Renderer2],null,null)],null,null)}function r(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](16777216,null,null,14,"button",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""],["type","submit"]],[[8,"disabled",0]],[[null,"longpress"],[null,"touchend"]],function(n,l,e){var t=!0;if("longpress"===l){t=!1!==d["ɵnov"](n,4).show()&&t}if("touchend"===l){t=!1!==d["ɵnov"](n,4).hide(1500)&&t}return t},c.e,c.f)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s.V,[d.Renderer2,d.ElementRef,p.a,s.a],{disabled:[0,"disabled"],color:[1,"color"]},null),d["ɵdid"](16384,null,0,s._107,[],null,null),d["ɵdid"](147456,null,0,s._77,[f.e,d.ElementRef,m.a,d.ViewContainerRef,d.NgZone,d.Renderer2,p.a,s.o,[2,v.b]],{message:[0,"message"]},null),(n()(),d["ɵted"](0,["\n  ","\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,t)),d["ɵdid"](16384,null,0,b.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,u)),d["ɵdid"](16384,null,0,b.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,i)),d["ɵdid"](16384,null,0,b.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,2,0,e.disabled(),"primary"),n(l,4,0,e.error?e.error:null),n(l,7,0,1===e.state),n(l,10,0,2===e.state),n(l,13,0,0===e.state)},function(n,l){var e=l.component;n(l,0,0,d["ɵnov"](l,2).disabled||null),n(l,5,0,e.placeholder)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-button",[],null,null,null,r,y)),d["ɵdid"](49152,null,0,h.a,[],null,null)],null,null)}var a=e("CpEn"),d=e("/oeL"),c=e("v6Q/"),s=e("Z04r"),p=e("JYHx"),f=e("z1Gz"),m=e("vVgA"),v=e("UPmf"),b=e("qbdv"),h=e("boXM");e.d(l,"b",function(){return y}),l.a=r;var g=[a.a],y=d["ɵcrt"]({encapsulation:0,styles:g,data:{}});d["ɵccf"]("app-button",h.a,o,{form:"form",placeholder:"placeholder"},{},[])},RMl1:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,4,"md-option",[["class","mat-option"],["role","option"]],[[1,"tabindex",0],[2,"mat-selected",null],[2,"mat-option-multiple",null],[2,"mat-active",null],[8,"id",0],[1,"aria-selected",0],[1,"aria-disabled",0],[2,"mat-option-disabled",null]],[[null,"click"],[null,"keydown"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,2)._selectViaInteraction()&&t}if("keydown"===l){t=!1!==d["ɵnov"](n,2)._handleKeydown(e)&&t}return t},c.s,c.t)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,[[2,4]],0,s._35,[d.ElementRef,d.ChangeDetectorRef,[2,s._36],[2,s.U]],{value:[0,"value"],disabled:[1,"disabled"]},null),(n()(),d["ɵted"](0,["\n      "," (",")\n    "])),d["ɵppd"](1)],function(n,l){n(l,2,0,l.context.$implicit,l.context.$implicit.balance<=0)},function(n,l){n(l,0,0,d["ɵnov"](l,2)._getTabIndex(),d["ɵnov"](l,2).selected,d["ɵnov"](l,2).multiple,d["ɵnov"](l,2).active,d["ɵnov"](l,2).id,d["ɵnov"](l,2).selected.toString(),d["ɵnov"](l,2).disabled.toString(),d["ɵnov"](l,2).disabled),n(l,3,0,l.context.$implicit.meta.label,d["ɵunv"](l,3,1,n(l,4,0,d["ɵnov"](l.parent,0),l.context.$implicit.balance)))})}function u(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n        "])),(n()(),d["ɵeld"](0,null,null,2,"strong",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["",""])),d["ɵppd"](1),(n()(),d["ɵted"](null,["\n      "]))],null,function(n,l){n(l,2,0,d["ɵunv"](l,2,0,n(l,3,0,d["ɵnov"](l.parent,1),l.context.value)))})}function i(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n        "])),(n()(),d["ɵeld"](0,null,null,1,"strong",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["",""])),(n()(),d["ɵted"](null,["\n      "]))],null,function(n,l){n(l,2,0,l.context.value.confirmed?"Confirmed":"Unconfirmed")})}function r(n){return d["ɵvid"](0,[d["ɵpid"](0,p.a,[]),d["ɵpid"](0,f.a,[]),d["ɵqud"](402653184,1,{button:0}),(n()(),d["ɵeld"](0,null,null,70,"md-card",[["class","send-skycoin-form mat-card"]],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==d["ɵnov"](n,6).onSubmit(e)&&t}if("reset"===l){t=!1!==d["ɵnov"](n,6).onReset()&&t}return t},c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),d["ɵdid"](540672,null,0,m.i,[[8,null],[8,null]],{form:[0,"form"]},null),d["ɵprd"](2048,null,m.l,null,[m.i]),d["ɵdid"](16384,null,0,m.m,[m.l],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,13,"md-select",[["class","input-field mat-select"],["formControlName","wallet"],["placeholder","Select Wallet"],["role","listbox"]],[[1,"tabindex",0],[1,"aria-label",0],[1,"aria-labelledby",0],[1,"aria-required",0],[1,"aria-disabled",0],[1,"aria-invalid",0],[1,"aria-owns",0],[1,"aria-multiselectable",0],[2,"mat-select-disabled",null],[2,"mat-select-invalid",null],[2,"mat-select-required",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"keydown"],[null,"blur"]],function(n,l,e){var t=!0;if("keydown"===l){t=!1!==d["ɵnov"](n,14)._handleClosedKeydown(e)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,14)._onBlur()&&t}return t},c.u,c.v)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](671744,null,0,m.p,[[3,m.l],[8,null],[8,null],[8,null]],{name:[0,"name"]},null),d["ɵprd"](2048,null,m.j,null,[m.p]),d["ɵdid"](1294336,null,3,s._75,[v.c,d.ChangeDetectorRef,b.e,h.a,d.Renderer2,d.ElementRef,[2,g.b],[2,m.h],[2,m.i],[2,m.j],[8,null],[2,s._66],s.l],{placeholder:[0,"placeholder"]},null),d["ɵqud"](603979776,2,{options:1}),d["ɵqud"](603979776,3,{optionGroups:1}),d["ɵqud"](335544320,4,{customTrigger:0}),d["ɵdid"](16384,null,0,m.q,[m.j],null,null),(n()(),d["ɵted"](1,["\n    "])),(n()(),d["ɵand"](16777216,null,1,2,null,t)),d["ɵdid"](802816,null,0,y.NgForOf,[d.ViewContainerRef,d.TemplateRef,d.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),d["ɵpid"](131072,y.AsyncPipe,[d.ChangeDetectorRef]),(n()(),d["ɵted"](1,["\n  "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,19,"md-input-container",[["class","input-field mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,27)._control.focus()&&t}return t},c.o,c.p)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](7389184,null,6,s._65,[d.ElementRef,d.ChangeDetectorRef,[2,s._66]],null,null),d["ɵqud"](335544320,5,{_control:0}),d["ɵqud"](335544320,6,{_placeholderChild:0}),d["ɵqud"](603979776,7,{_errorChildren:1}),d["ɵqud"](603979776,8,{_hintChildren:1}),d["ɵqud"](603979776,9,{_prefixChildren:1}),d["ɵqud"](603979776,10,{_suffixChildren:1}),(n()(),d["ɵted"](1,["\n    "])),(n()(),d["ɵeld"](0,null,1,8,"input",[["class","mat-input-element"],["formControlName","address"],["mdInput",""],["placeholder","Recipient address"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==d["ɵnov"](n,37)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,37).onTouched()&&t}if("compositionstart"===l){t=!1!==d["ɵnov"](n,37)._compositionStart()&&t}if("compositionend"===l){t=!1!==d["ɵnov"](n,37)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,41)._focusChanged(!1)&&t}if("focus"===l){t=!1!==d["ɵnov"](n,41)._focusChanged(!0)&&t}if("input"===l){t=!1!==d["ɵnov"](n,41)._onInput()&&t}return t},null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,m.n,[d.Renderer2,d.ElementRef,[2,m.o]],null,null),d["ɵprd"](1024,null,m.e,function(n){return[n]},[m.n]),d["ɵdid"](671744,null,0,m.p,[[3,m.l],[8,null],[8,null],[2,m.e]],{name:[0,"name"]},null),d["ɵprd"](2048,null,m.j,null,[m.p]),d["ɵdid"](933888,null,0,s._104,[d.ElementRef,d.Renderer2,h.a,[2,m.j],[2,m.h],[2,m.i],[2,s._105]],{placeholder:[0,"placeholder"]},null),d["ɵdid"](16384,null,0,m.q,[m.j],null,null),d["ɵprd"](2048,[[5,4]],s._106,null,[s._104]),(n()(),d["ɵted"](1,["\n  "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,20,"md-input-container",[["class","input-field mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,48)._control.focus()&&t}return t},c.o,c.p)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](7389184,null,6,s._65,[d.ElementRef,d.ChangeDetectorRef,[2,s._66]],null,null),d["ɵqud"](335544320,11,{_control:0}),d["ɵqud"](335544320,12,{_placeholderChild:0}),d["ɵqud"](603979776,13,{_errorChildren:1}),d["ɵqud"](603979776,14,{_hintChildren:1}),d["ɵqud"](603979776,15,{_prefixChildren:1}),d["ɵqud"](603979776,16,{_suffixChildren:1}),(n()(),d["ɵted"](1,["\n    "])),(n()(),d["ɵeld"](0,null,1,9,"input",[["class","mat-input-element"],["formControlName","amount"],["mdInput",""],["placeholder","Amount"],["type","number"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"change"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==d["ɵnov"](n,58)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,58).onTouched()&&t}if("compositionstart"===l){t=!1!==d["ɵnov"](n,58)._compositionStart()&&t}if("compositionend"===l){t=!1!==d["ɵnov"](n,58)._compositionEnd(e.target.value)&&t}if("change"===l){t=!1!==d["ɵnov"](n,59).onChange(e.target.value)&&t}if("input"===l){t=!1!==d["ɵnov"](n,59).onChange(e.target.value)&&t}if("blur"===l){t=!1!==d["ɵnov"](n,59).onTouched()&&t}if("blur"===l){t=!1!==d["ɵnov"](n,63)._focusChanged(!1)&&t}if("focus"===l){t=!1!==d["ɵnov"](n,63)._focusChanged(!0)&&t}if("input"===l){t=!1!==d["ɵnov"](n,63)._onInput()&&t}return t},null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,m.n,[d.Renderer2,d.ElementRef,[2,m.o]],null,null),d["ɵdid"](16384,null,0,m.r,[d.Renderer2,d.ElementRef],null,null),d["ɵprd"](1024,null,m.e,function(n,l){return[n,l]},[m.n,m.r]),d["ɵdid"](671744,null,0,m.p,[[3,m.l],[8,null],[8,null],[2,m.e]],{name:[0,"name"]},null),d["ɵprd"](2048,null,m.j,null,[m.p]),d["ɵdid"](933888,null,0,s._104,[d.ElementRef,d.Renderer2,h.a,[2,m.j],[2,m.h],[2,m.i],[2,s._105]],{placeholder:[0,"placeholder"],type:[1,"type"]},null),d["ɵdid"](16384,null,0,m.q,[m.j],null,null),d["ɵprd"](2048,[[11,4]],s._106,null,[s._104]),(n()(),d["ɵted"](1,["\n  "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,4,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,1,"app-button",[["placeholder","Send"]],null,[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.send()&&t}return t},x.a,x.b)),d["ɵdid"](49152,[[1,4],["button",4]],0,k.a,[],{form:[0,"form"],placeholder:[1,"placeholder"]},null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n"])),(n()(),d["ɵted"](null,["\n"])),(n()(),d["ɵeld"](0,null,null,41,"md-card",[["class","mat-card"]],null,null,null,c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["Recent transactions"])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,33,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==d["ɵnov"](n,84).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},w.a,w.b)),d["ɵdid"](4571136,[["table",4]],3,R.DatatableComponent,[C.ScrollbarHelper,d.ElementRef,d.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),d["ɵqud"](603979776,17,{columnTemplates:1}),d["ɵqud"](335544320,18,{rowDetail:0}),d["ɵqud"](335544320,19,{footer:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","txn.timestamp"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,20,{cellTemplate:0}),d["ɵqud"](335544320,21,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n      "])),(n()(),d["ɵand"](0,[[20,2]],null,1,null,u)),d["ɵdid"](16384,null,0,T.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Status"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),d["ɵqud"](335544320,22,{cellTemplate:0}),d["ɵqud"](335544320,23,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n      "])),(n()(),d["ɵand"](0,[[22,2]],null,1,null,i)),d["ɵdid"](16384,null,0,T.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),d["ɵqud"](335544320,24,{cellTemplate:0}),d["ɵqud"](335544320,25,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Amount"]],null,null,null,null,null)),d["ɵdid"](16384,[[17,4]],2,S.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),d["ɵqud"](335544320,26,{cellTemplate:0}),d["ɵqud"](335544320,27,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,6,0,e.form);n(l,12,0,"wallet");n(l,14,0,"Select Wallet"),n(l,21,0,d["ɵunv"](l,21,0,d["ɵnov"](l,22).transform(e.walletService.all())));n(l,39,0,"address");n(l,41,0,"Recipient address");n(l,61,0,"amount");n(l,63,0,"Amount","number");n(l,71,0,e.form,"Send");n(l,84,0,e.records,!0,50,"flex",50,50,10);n(l,90,0,"Timestamp","txn.timestamp",2);n(l,99,0,"Status",2);n(l,108,0,"Address",4);n(l,113,0,"Amount",1)},function(n,l){n(l,3,0,d["ɵnov"](l,8).ngClassUntouched,d["ɵnov"](l,8).ngClassTouched,d["ɵnov"](l,8).ngClassPristine,d["ɵnov"](l,8).ngClassDirty,d["ɵnov"](l,8).ngClassValid,d["ɵnov"](l,8).ngClassInvalid,d["ɵnov"](l,8).ngClassPending),n(l,10,1,[d["ɵnov"](l,14).tabIndex,d["ɵnov"](l,14)._ariaLabel,d["ɵnov"](l,14).ariaLabelledby,d["ɵnov"](l,14).required.toString(),d["ɵnov"](l,14).disabled.toString(),d["ɵnov"](l,14)._isErrorState(),d["ɵnov"](l,14)._optionIds,d["ɵnov"](l,14).multiple,d["ɵnov"](l,14).disabled,d["ɵnov"](l,14)._isErrorState(),d["ɵnov"](l,14).required,d["ɵnov"](l,18).ngClassUntouched,d["ɵnov"](l,18).ngClassTouched,d["ɵnov"](l,18).ngClassPristine,d["ɵnov"](l,18).ngClassDirty,d["ɵnov"](l,18).ngClassValid,d["ɵnov"](l,18).ngClassInvalid,d["ɵnov"](l,18).ngClassPending]),n(l,25,0,d["ɵnov"](l,27)._control.errorState,d["ɵnov"](l,27)._control.errorState,d["ɵnov"](l,27)._control.focused,d["ɵnov"](l,27)._shouldForward("untouched"),d["ɵnov"](l,27)._shouldForward("touched"),d["ɵnov"](l,27)._shouldForward("pristine"),d["ɵnov"](l,27)._shouldForward("dirty"),d["ɵnov"](l,27)._shouldForward("valid"),d["ɵnov"](l,27)._shouldForward("invalid"),d["ɵnov"](l,27)._shouldForward("pending")),n(l,35,1,[d["ɵnov"](l,41).id,d["ɵnov"](l,41).placeholder,d["ɵnov"](l,41).disabled,d["ɵnov"](l,41).required,d["ɵnov"](l,41)._ariaDescribedby||null,d["ɵnov"](l,41).errorState,d["ɵnov"](l,42).ngClassUntouched,d["ɵnov"](l,42).ngClassTouched,d["ɵnov"](l,42).ngClassPristine,d["ɵnov"](l,42).ngClassDirty,d["ɵnov"](l,42).ngClassValid,d["ɵnov"](l,42).ngClassInvalid,d["ɵnov"](l,42).ngClassPending]),n(l,46,0,d["ɵnov"](l,48)._control.errorState,d["ɵnov"](l,48)._control.errorState,d["ɵnov"](l,48)._control.focused,d["ɵnov"](l,48)._shouldForward("untouched"),d["ɵnov"](l,48)._shouldForward("touched"),d["ɵnov"](l,48)._shouldForward("pristine"),d["ɵnov"](l,48)._shouldForward("dirty"),d["ɵnov"](l,48)._shouldForward("valid"),d["ɵnov"](l,48)._shouldForward("invalid"),d["ɵnov"](l,48)._shouldForward("pending")),n(l,56,1,[d["ɵnov"](l,63).id,d["ɵnov"](l,63).placeholder,d["ɵnov"](l,63).disabled,d["ɵnov"](l,63).required,d["ɵnov"](l,63)._ariaDescribedby||null,d["ɵnov"](l,63).errorState,d["ɵnov"](l,64).ngClassUntouched,d["ɵnov"](l,64).ngClassTouched,d["ɵnov"](l,64).ngClassPristine,d["ɵnov"](l,64).ngClassDirty,d["ɵnov"](l,64).ngClassValid,d["ɵnov"](l,64).ngClassInvalid,d["ɵnov"](l,64).ngClassPending]),n(l,83,0,d["ɵnov"](l,84).isFixedHeader,d["ɵnov"](l,84).isFixedRow,d["ɵnov"](l,84).isVertScroll,d["ɵnov"](l,84).isHorScroll,d["ɵnov"](l,84).isSelectable,d["ɵnov"](l,84).isCheckboxSelection,d["ɵnov"](l,84).isCellSelection,d["ɵnov"](l,84).isSingleSelection,d["ɵnov"](l,84).isMultiSelection,d["ɵnov"](l,84).isMultiClickSelection)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-send-skycoin",[],null,null,null,r,I)),d["ɵdid"](114688,null,0,_.a,[m.a,E.a,D.c,s.n],null,null)],function(n,l){n(l,1,0)},null)}var a=e("79YC"),d=e("/oeL"),c=e("v6Q/"),s=e("Z04r"),p=e("BruE"),f=e("lL01"),m=e("bm2B"),v=e("vVgA"),b=e("z1Gz"),h=e("JYHx"),g=e("UPmf"),y=e("qbdv"),_=e("nI5/"),x=e("Qoso"),k=e("boXM"),w=e("LwgZ"),R=e("f2O0"),C=(e.n(R),e("7i8B")),S=(e.n(C),e("47oE")),T=(e.n(S),e("GHh8")),E=(e.n(T),e("cP51")),D=e("BkNc");e.d(l,"a",function(){return P});var q=[a.a],I=d["ɵcrt"]({encapsulation:0,styles:q,data:{}}),P=d["ɵccf"]("app-send-skycoin",_.a,o,{},{},[])},SJy7:function(n,l,e){"use strict";var t=e("1Zxj"),u=e("bKpL");e.n(u);e.d(l,"a",function(){return i});var i=function(){function n(n){this.apiService=n}return n.prototype.addressTransactions=function(n){return this.apiService.get("explorer/address",{address:n})},n.prototype.addressBalance=function(n){return this.apiService.get("outputs",{addrs:n})},n.prototype.block=function(n){var l=this;return this.apiService.get("blocks",{start:n,end:n}).map(function(n){return n.blocks[0]}).flatMap(function(n){return u.Observable.forkJoin(n.body.txns.map(function(n){return n.inputs&&!n.inputs.length?u.Observable.of(n):u.Observable.forkJoin(n.inputs.map(function(n){return l.retrieveInputAddress(n).map(function(n){return n.owner_address})})).map(function(l){return n.inputs=l,n})})).map(function(l){return n.body.txns=l,n})})},n.prototype.blocks=function(n){return void 0===n&&(n=5100),this.apiService.get("last_blocks",{num:n}).map(function(n){return n.blocks.reverse()})},n.prototype.lastBlock=function(){return this.blocks(1).map(function(n){return n[0]})},n.prototype.progress=function(){return this.apiService.get("blockchain/progress")},n.prototype.retrieveInputAddress=function(n){return this.apiService.get("uxout",{uxid:n})},n.ctorParameters=function(){return[{type:t.a}]},n}()},ScY5:function(n,l,e){"use strict";var t=e("gvep"),u=(e.n(t),e("CPp0"));e.d(l,"a",function(){return i});var i=function(){function n(n){this.http=n,this.purchaseOrders=new t.BehaviorSubject([]),this.purchaseUrl="http://127.0.01:7071/api/",this.retrievePurchaseOrders()}return n.prototype.all=function(){return this.purchaseOrders.asObservable()},n.prototype.generate=function(n){var l=this;return this.post("bind",{skyaddr:n}).do(function(e){l.purchaseOrders.first().subscribe(function(t){var u=t.findIndex(function(l){return l.address===n});-1===u&&(t.push({address:n,addresses:[]}),u=t.length-1);var i=Math.floor(Date.now()/1e3);t[u].addresses.unshift({btc:e.btc_address,status:"waiting_deposit",created:i,updated:i}),l.updatePurchaseOrders(t)})})},n.prototype.scan=function(n){var l=this;return this.get("status?skyaddr="+n).do(function(e){l.purchaseOrders.first().subscribe(function(t){var u=t.findIndex(function(l){return l.address===n});t[u].addresses.sort(function(n,l){return l.created-n.created});for(var i=0,r=t[u].addresses;i<r.length;i++){var o=r[i],a=e.statuses.splice(-1,1)[0];o.status=a.status,o.updated=a.update_at}l.updatePurchaseOrders(t)})})},n.prototype.get=function(n){return this.http.get(this.purchaseUrl+n).map(function(n){return n.json()})},n.prototype.post=function(n,l){return void 0===l&&(l={}),this.http.post(this.purchaseUrl+n,l).map(function(n){return n.json()})},n.prototype.retrievePurchaseOrders=function(){var n=JSON.parse(window.localStorage.getItem("purchaseOrders"));n&&this.purchaseOrders.next(n)},n.prototype.updatePurchaseOrders=function(n){this.purchaseOrders.next(n),window.localStorage.setItem("purchaseOrders",JSON.stringify(n))},n.ctorParameters=function(){return[{type:u.i}]},n}()},TvuP:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[".skycoin-details[_ngcontent-%COMP%]{margin-top:40px;background-color:#eee;margin-bottom:20px}.skycoin-detail-keys[_ngcontent-%COMP%], .skycoin-detail-values[_ngcontent-%COMP%]{display:inline-block}"]},"W/ps":function(n,l,e){"use strict";var t=e("SJy7");e.d(l,"a",function(){return u});var u=function(){function n(n){this.blockchainService=n}return n.prototype.ngOnInit=function(){var n=this;this.blockchainService.lastBlock().subscribe(function(l){return n.block=l})},n.ctorParameters=function(){return[{type:t.a}]},n}()},WZ1N:function(n,l,e){"use strict";var t=e("cP51");e.d(l,"a",function(){return u});var u=function(){function n(n){this.walletService=n}return n.prototype.ngOnInit=function(){var n=this;this.walletService.folder().subscribe(function(l){return n.folder=l})},n.prototype.ngOnDestroy=function(){this.walletService.all().subscribe(function(n){return n.forEach(function(n){return n.visible=!1})})},n.prototype.download=function(n){var l=new Blob([JSON.stringify({seed:n.meta.seed})],{type:"application/json"}),e=document.createElement("a");e.href=window.URL.createObjectURL(l),e.download=n.meta.filename+".json",e.click()},n.ctorParameters=function(){return[{type:t.a}]},n}()},Y66J:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.transform=function(n){return n.reduce(function(n,l){return n+l.outputs.reduce(function(n,l){return n+parseInt(l.coins)},0)},0)},n}()},Ymo7:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["ngx-datatable[_ngcontent-%COMP%]{margin-top:20px}md-icon[_ngcontent-%COMP%]{cursor:pointer}"]},a9x3:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-card[_ngcontent-%COMP%]{margin-bottom:20px}"]},"aR8+":function(n,l,e){"use strict";var t=e("5WMG"),u=e("nI5/"),i=e("iXFZ"),r=e("2yQV"),o=e("7Bws"),a=e("KwZO"),d=e("ONOE"),c=e("HHiG"),s=e("KbYb"),p=e("W/ps"),f=e("WZ1N"),m=e("PRn9"),v=e("BZkh");e.d(l,"a",function(){return b});var b=(t.a,u.a,i.a,r.a,o.a,d.a,a.a,r.a,v.a,f.a,p.a,m.a,s.a,c.a,function(){function n(){}return n}())},aUD3:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵted"](null,["\n      "])),(n()(),o["ɵeld"](0,null,null,2,"strong",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["",""])),o["ɵppd"](1),(n()(),o["ɵted"](null,["\n    "]))],null,function(n,l){n(l,2,0,o["ɵunv"](l,2,0,n(l,3,0,o["ɵnov"](l.parent,0),l.context.value)))})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,a.a,[]),(n()(),o["ɵeld"](0,null,null,24,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==o["ɵnov"](n,2).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},c.a,c.b)),o["ɵdid"](4571136,[["table",4]],3,s.DatatableComponent,[p.ScrollbarHelper,o.ElementRef,o.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),o["ɵqud"](603979776,1,{columnTemplates:1}),o["ɵqud"](335544320,2,{rowDetail:0}),o["ɵqud"](335544320,3,{footer:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","timestamp"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,4,{cellTemplate:0}),o["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵand"](0,[[4,2]],null,1,null,t)),o["ɵdid"](16384,null,0,m.DataTableColumnCellDirective,[o.TemplateRef],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Amount"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),o["ɵqud"](335544320,6,{cellTemplate:0}),o["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Transaction ID"],["prop","txid"]],null,null,null,null,null)),o["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,8,{cellTemplate:0}),o["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){n(l,2,0,l.component.transactions,!0,50,"flex",50,50,10);n(l,8,0,"Timestamp","timestamp",2);n(l,17,0,"Amount",1);n(l,22,0,"Transaction ID","txid",8)},function(n,l){n(l,1,0,o["ɵnov"](l,2).isFixedHeader,o["ɵnov"](l,2).isFixedRow,o["ɵnov"](l,2).isVertScroll,o["ɵnov"](l,2).isHorScroll,o["ɵnov"](l,2).isSelectable,o["ɵnov"](l,2).isCheckboxSelection,o["ɵnov"](l,2).isCellSelection,o["ɵnov"](l,2).isSingleSelection,o["ɵnov"](l,2).isMultiSelection,o["ɵnov"](l,2).isMultiClickSelection)})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-pending-transactions",[],null,null,null,u,g)),o["ɵdid"](114688,null,0,d.a,[v.a,b.c],null,null)],function(n,l){n(l,1,0)},null)}var r=e("gm5P"),o=e("/oeL"),a=e("lL01"),d=e("HHiG"),c=e("LwgZ"),s=e("f2O0"),p=(e.n(s),e("7i8B")),f=(e.n(p),e("47oE")),m=(e.n(f),e("GHh8")),v=(e.n(m),e("cP51")),b=e("BkNc");e.d(l,"a",function(){return y});var h=[r.a],g=o["ɵcrt"]({encapsulation:0,styles:h,data:{}}),y=o["ɵccf"]("app-pending-transactions",d.a,i,{},{},[])},aZaK:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,6,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      "," "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      "," "])),o["ɵppd"](1),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      ","\n    "]))],null,function(n,l){var e=l.component;n(l,1,0,e.block.header.seq),n(l,3,0,o["ɵunv"](l,3,0,n(l,4,0,o["ɵnov"](l.parent,0),e.block.header.timestamp))),n(l,6,0,e.block.header.block_hash)})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,a.a,[]),(n()(),o["ɵeld"](0,null,null,25,"md-card",[["class","mat-card"]],null,null,null,d.w,d.x)),o["ɵdid"](16384,null,0,c.T,[[2,c.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,c._42,[],null,null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,3,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),o["ɵdid"](16384,null,0,c.T,[[2,c.U],o.ElementRef],null,null),o["ɵdid"](16384,null,0,c._108,[],null,null),(n()(),o["ɵted"](null,["Blockchain"])),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵeld"](0,null,0,15,"md-card",[["class","skycoin-details mat-card"]],null,null,null,d.w,d.x)),o["ɵdid"](16384,null,0,c.T,[[2,c.U],o.ElementRef],null,null),o["ɵdid"](49152,null,0,c._42,[],null,null),(n()(),o["ɵted"](0,["\n    "])),(n()(),o["ɵeld"](0,null,0,7,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      Number of blocks: "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      Time since last block: "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n      Hash of last block: "])),(n()(),o["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵted"](0,["\n    "])),(n()(),o["ɵand"](16777216,null,0,1,null,t)),o["ɵdid"](16384,null,0,s.NgIf,[o.ViewContainerRef,o.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),o["ɵted"](0,["\n  "])),(n()(),o["ɵted"](0,["\n"])),(n()(),o["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,24,0,e.block&&e.block.header)},null)}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-blockchain",[],null,null,null,u,v)),o["ɵdid"](114688,null,0,p.a,[f.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("ApLd"),o=e("/oeL"),a=e("Caxp"),d=e("v6Q/"),c=e("Z04r"),s=e("qbdv"),p=e("W/ps"),f=e("SJy7");e.d(l,"a",function(){return b});var m=[r.a],v=o["ɵcrt"]({encapsulation:0,styles:m,data:{}}),b=o["ɵccf"]("app-blockchain",p.a,i,{},{},[])},boXM:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=function(){function n(){}return n.prototype.setLoading=function(){this.state=0},n.prototype.setSuccess=function(){var n=this;this.state=1,setTimeout(function(){return n.state=null},3e3)},n.prototype.setError=function(n){this.error=n._body,this.state=2},n.prototype.disabled=function(){return!(0!==this.state&&(void 0===this.form||this.form&&this.form.valid))},n}()},cDNt:function(n,l,e){"use strict";Object.defineProperty(l,"__esModule",{value:!0});var t=e("/oeL"),u=e("p5Ee"),i=e("+h1B"),r=e("fc+i");u.a.production&&e.i(t.enableProdMode)(),e.i(r.platformBrowser)().bootstrapModuleFactory(i.a)},cP51:function(n,l,e){"use strict";var t=e("1Zxj"),u=e("gvep"),i=(e.n(u),e("bKpL")),r=(e.n(i),e("q+5A")),o=(e.n(r),e("GPwi")),a=(e.n(o),e("/zHi")),d=(e.n(a),e("eqpX")),c=(e.n(d),e("gbhw")),s=(e.n(c),e("ZQKz")),p=(e.n(s),e("RpuY"));e.n(p);e.d(l,"a",function(){return f});var f=function(){function n(n){var l=this;this.apiService=n,this.recentTransactions=new u.BehaviorSubject([]),this.transactions=new u.BehaviorSubject([]),this.wallets=new u.BehaviorSubject([]),this.loadData(),r.IntervalObservable.create(3e4).subscribe(function(){return l.refreshBalances()})}return n.prototype.addressesAsString=function(){return this.all().map(function(n){return n.map(function(n){return n.entries.reduce(function(n,l){return n.push(l.address),n},[]).join(",")}).join(",")})},n.prototype.addAddress=function(n){return this.apiService.post("wallet/newAddress",{id:n.meta.filename}).map(function(n){return{address:n.addresses[0],balance:0}})},n.prototype.all=function(){return this.wallets.asObservable()},n.prototype.allAddresses=function(){return this.all().map(function(n){return n.reduce(function(n,l){return n.concat(l.entries)},[])})},n.prototype.create=function(n,l){var e=this;return this.apiService.post("wallet/create",{label:n||"undefined",seed:l}).do(function(n){e.wallets.first().subscribe(function(l){l.push(n),e.wallets.next(l),e.refreshBalances()})})},n.prototype.folder=function(){return this.apiService.get("wallets/folderName").map(function(n){return n.address})},n.prototype.generateSeed=function(){return this.apiService.get("wallet/newSeed").map(function(n){return n.seed})},n.prototype.history=function(){return this.transactions.asObservable()},n.prototype.outputs=function(){var n=this;return this.addressesAsString().filter(function(n){return!!n}).flatMap(function(l){return n.apiService.get("outputs",{addrs:l})})},n.prototype.pendingTransactions=function(){return this.apiService.get("pendingTxs")},n.prototype.recent=function(){return this.recentTransactions.asObservable()},n.prototype.refreshBalances=function(){var n=this;this.wallets.first().subscribe(function(l){i.Observable.forkJoin(l.map(function(l){return n.retrieveWalletBalance(l).map(function(n){return l.entries=n,l.balance=n.map(function(n){return n.balance>=0?n.balance:0}).reduce(function(n,l){return n+l},0),l.hours=n.map(function(n){return n.hours>=0?n.hours:0}).reduce(function(n,l){return n+l},0),l})})).subscribe(function(l){return n.wallets.next(l)})})},n.prototype.renameWallet=function(n,l){return this.apiService.post("wallet/update",{id:n.meta.filename,label:l})},n.prototype.retrieveUpdatedTransactions=function(n){var l=this;return i.Observable.forkJoin(n.map(function(n){return l.apiService.get("transaction",{txid:n.id}).map(function(l){return l.amount=n.amount,l.address=n.address,l})}))},n.prototype.sendSkycoin=function(n,l,e){var t=this;return this.apiService.post("wallet/spend",{id:n,dst:l,coins:e}).do(function(n){return t.recentTransactions.first().subscribe(function(u){var i={id:n.txn.txid,address:l,amount:e/1e6};u.push(i),t.recentTransactions.next(u)})})},n.prototype.sum=function(){return this.all().map(function(n){return n.map(function(n){return n.balance>=0?n.balance:0}).reduce(function(n,l){return n+l},0)})},n.prototype.transaction=function(n){var l=this;return this.apiService.get("transaction",{txid:n}).flatMap(function(n){return n.txn.inputs&&!n.txn.inputs.length?i.Observable.of(n):i.Observable.forkJoin(n.txn.inputs.map(function(n){return l.retrieveInputAddress(n).map(function(n){return n.owner_address})})).map(function(l){return n.txn.inputs=l,n})})},n.prototype.loadData=function(){var n=this;this.retrieveWallets().first().subscribe(function(l){n.wallets.next(l),n.refreshBalances(),n.retrieveTransactions()})},n.prototype.retrieveAddressBalance=function(n){var l=Array.isArray(n)?n.map(function(n){return n.address}).join(","):n.address;return this.apiService.get("balance",{addrs:l})},n.prototype.retrieveAddressTransactions=function(n){return this.apiService.get("explorer/address",{address:n.address})},n.prototype.retrieveInputAddress=function(n){return this.apiService.get("uxout",{uxid:n})},n.prototype.retrieveTransactions=function(){var n=this;return this.wallets.first().subscribe(function(l){i.Observable.forkJoin(l.map(function(l){return n.retrieveWalletTransactions(l)})).map(function(n){return[].concat.apply([],n).sort(function(n,l){return l.timestamp-n.timestamp})}).map(function(n){return n.reduce(function(n,l){return n.find(function(n){return n.txid===l.txid})||n.push(l),n},[])}).subscribe(function(l){return n.transactions.next(l)})})},n.prototype.retrieveWalletBalance=function(n){var l=this;return i.Observable.forkJoin(n.entries.map(function(n){return l.retrieveAddressBalance(n).map(function(l){return n.balance=l.confirmed.coins,n.hours=l.confirmed.hours,n})}))},n.prototype.retrieveWalletTransactions=function(n){var l=this;return i.Observable.forkJoin(n.entries.map(function(n){return l.retrieveAddressTransactions(n)})).map(function(n){return[].concat.apply([],n)})},n.prototype.retrieveWalletUnconfirmedTransactions=function(n){return this.apiService.get("wallet/transactions",{id:n.meta.filename})},n.prototype.retrieveWallets=function(){return this.apiService.get("wallets")},n.ctorParameters=function(){return[{type:t.a}]},n}()},dSyq:function(n,l,e){"use strict";var t=e("1Zxj"),u=e("gvep"),i=(e.n(u),e("q+5A")),r=(e.n(i),e("RpuY"));e.n(r);e.d(l,"a",function(){return o});var o=function(){function n(n){this.apiService=n,this.automaticPeers=new u.BehaviorSubject([]),this.loadData()}return n.prototype.automatic=function(){return this.automaticPeers.asObservable()},n.prototype.retrieveDefaultConnections=function(){return this.apiService.post("network/defaultConnections").map(function(n){return n.map(function(n,l){return{id:l+1,address:n,listen_port:7100}})})},n.prototype.loadData=function(){var n=this;this.retrieveConnections().subscribe(function(l){return n.automaticPeers.next(l)}),i.IntervalObservable.create(5e3).flatMap(function(){return n.retrieveConnections()}).subscribe(function(l){return n.automaticPeers.next(l)})},n.prototype.retrieveConnections=function(){return this.apiService.post("network/connections").map(function(n){return n.connections.sort(function(n,l){return n.id-l.id})})},n.ctorParameters=function(){return[{type:t.a}]},n}()},gH5r:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,15,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==d["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==d["ɵnov"](n,2)._handleBlur()&&t}return t},c.y,c.z)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](1097728,null,2,s._71,[d.Renderer2,d.ElementRef,[2,s._70],[2,s._72]],null,null),d["ɵqud"](603979776,1,{_lines:1}),d["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),d["ɵted"](2,["\n      "])),(n()(),d["ɵeld"](0,null,1,8,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],0,s._111,[],null,null),(n()(),d["ɵeld"](0,null,null,6,"button",[["class","mat-button"],["md-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,12).onClick()&&t}return t},c.e,c.f)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s.V,[d.Renderer2,d.ElementRef,p.a,s.a],null,null),d["ɵdid"](16384,null,0,s.W,[],null,null),d["ɵdid"](16384,null,0,f.y,[f.c,f.a,[8,null],d.Renderer2,d.ElementRef],{routerLink:[0,"routerLink"]},null),d["ɵpad"](2),(n()(),d["ɵted"](0,["",""])),(n()(),d["ɵted"](2,["\n    "]))],function(n,l){n(l,12,0,n(l,13,0,"/explorer/address/",l.context.$implicit))},function(n,l){n(l,8,0,d["ɵnov"](l,10).disabled||null),n(l,14,0,l.context.$implicit)})}function u(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,15,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==d["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==d["ɵnov"](n,2)._handleBlur()&&t}return t},c.y,c.z)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](1097728,null,2,s._71,[d.Renderer2,d.ElementRef,[2,s._70],[2,s._72]],null,null),d["ɵqud"](603979776,3,{_lines:1}),d["ɵqud"](335544320,4,{_hasAvatar:0}),(n()(),d["ɵted"](2,["\n      "])),(n()(),d["ɵeld"](0,null,1,8,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),d["ɵdid"](16384,[[3,4]],0,s._111,[],null,null),(n()(),d["ɵeld"](0,null,null,6,"button",[["class","mat-button"],["md-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==d["ɵnov"](n,12).onClick()&&t}return t},c.e,c.f)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](180224,null,0,s.V,[d.Renderer2,d.ElementRef,p.a,s.a],null,null),d["ɵdid"](16384,null,0,s.W,[],null,null),d["ɵdid"](16384,null,0,f.y,[f.c,f.a,[8,null],d.Renderer2,d.ElementRef],{routerLink:[0,"routerLink"]},null),d["ɵpad"](2),(n()(),d["ɵted"](0,[""," ("," SC2)"])),(n()(),d["ɵted"](2,["\n    "]))],function(n,l){n(l,12,0,n(l,13,0,"/explorer/address/",l.context.$implicit.dst))},function(n,l){n(l,8,0,d["ɵnov"](l,10).disabled||null),n(l,14,0,l.context.$implicit.dst,l.context.$implicit.coins)})}function i(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,23,"md-list",[["class","mat-list"],["role","list"]],null,null,null,c.C,c.D)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._70,[],null,null),d["ɵdid"](16384,null,0,s._113,[],null,null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s._112,[],null,null),(n()(),d["ɵted"](null,["Inputs"])),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵand"](16777216,null,0,1,null,t)),d["ɵdid"](802816,null,0,m.NgForOf,[d.ViewContainerRef,d.TemplateRef,d.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,s._109,[],null,null),d["ɵdid"](16384,null,0,s._110,[],null,null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s._112,[],null,null),(n()(),d["ɵted"](null,["Outputs"])),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵand"](16777216,null,0,1,null,u)),d["ɵdid"](802816,null,0,m.NgForOf,[d.ViewContainerRef,d.TemplateRef,d.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),d["ɵted"](0,["\n  "]))],function(n,l){var e=l.component;n(l,10,0,e.transaction.txn.inputs),n(l,22,0,e.transaction.txn.outputs)},null)}function r(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,42,"md-card",[["class","mat-card"]],null,null,null,c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,5,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](16384,null,0,s._108,[],null,null),(n()(),d["ɵted"](null,["Transaction "])),(n()(),d["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,v.a,v.b)),d["ɵdid"](49152,null,0,b.a,[m.Location],null,null),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵeld"](0,null,0,27,"md-card",[["class","skycoin-details mat-card"]],null,null,null,c.w,c.x)),d["ɵdid"](16384,null,0,s.T,[[2,s.U],d.ElementRef],null,null),d["ɵdid"](49152,null,0,s._42,[],null,null),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,11,"div",[["class","skycoin-detail-keys"]],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      ID: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Timestamp: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Block: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Confirmed: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      Total value: "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵted"](0,["\n    "])),(n()(),d["ɵeld"](0,null,0,9,"div",[["class","skycoin-detail-values"]],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      "," "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      "," "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      ",""])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      "," "])),(n()(),d["ɵeld"](0,null,null,0,"br",[],null,null,null,null,null)),(n()(),d["ɵted"](null,["\n      ","\n    "])),(n()(),d["ɵted"](0,["\n  "])),(n()(),d["ɵted"](0,["\n\n  "])),(n()(),d["ɵand"](16777216,null,0,1,null,i)),d["ɵdid"](16384,null,0,m.NgIf,[d.ViewContainerRef,d.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),d["ɵted"](0,["\n"])),(n()(),d["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,41,0,e.transaction&&e.transaction.txn)},function(n,l){var e=l.component;n(l,29,0,e.transaction&&e.transaction.txn?e.transaction.txn.txid:""),n(l,31,0,e.transaction&&e.transaction.txn?e.transaction.txn.timestamp:""),n(l,33,0,e.transaction&&e.transaction.txn?e.transaction.status.block_seq:""),n(l,35,0,e.transaction&&e.transaction.status?e.transaction.status.confirmed?"True":"False":""),n(l,37,0,e.total)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-transaction",[],null,null,null,r,_)),d["ɵdid"](114688,null,0,h.a,[f.a,g.a],null,null)],function(n,l){n(l,1,0)},null)}var a=e("TvuP"),d=e("/oeL"),c=e("v6Q/"),s=e("Z04r"),p=e("JYHx"),f=e("BkNc"),m=e("qbdv"),v=e("zVgQ"),b=e("/Tz7"),h=e("2yQV"),g=e("cP51");e.d(l,"a",function(){return x});var y=[a.a],_=d["ɵcrt"]({encapsulation:0,styles:y,data:{}}),x=d["ɵccf"]("app-transaction",h.a,o,{},{},[])},gm5P:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},iXFZ:function(n,l,e){"use strict";var t=e("cP51"),u=e("BkNc");e.d(l,"a",function(){return i});var i=function(){function n(n,l){this.router=n,this.walletService=l}return n.prototype.ngOnInit=function(){var n=this;this.walletService.history().subscribe(function(l){return n.transactions=n.mapTransactions(l)})},n.prototype.onActivate=function(n){n.row&&n.row.txid&&this.router.navigate(["/history",n.row.txid])},n.prototype.mapTransactions=function(n){return n.map(function(n){return n.amount=n.outputs.map(function(n){return n.coins>=0?n.coins:0}).reduce(function(n,l){return n+parseInt(l)},0),n})},n.ctorParameters=function(){return[{type:u.c},{type:t.a}]},n}()},io6L:function(n,l,e){"use strict";function t(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,1,"p",[],null,null,null,null,null)),(n()(),p["ɵted"](null,["Sorry, otc has currently been disabled!"]))],null,null)}function u(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,3,"md-divider",[["aria-orientation","horizontal"],["class","mat-divider"],["role","separator"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,f._109,[],null,null),p["ɵdid"](16384,null,0,f._110,[],null,null)],null,null)}function i(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,26,"md-list-item",[["class","mat-list-item"],["role","listitem"]],null,[[null,"focus"],[null,"blur"]],function(n,l,e){var t=!0;if("focus"===l){t=!1!==p["ɵnov"](n,2)._handleFocus()&&t}if("blur"===l){t=!1!==p["ɵnov"](n,2)._handleBlur()&&t}return t},m.y,m.z)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](1097728,null,2,f._71,[p.Renderer2,p.ElementRef,[2,f._70],[2,f._72]],null,null),p["ɵqud"](603979776,1,{_lines:1}),p["ɵqud"](335544320,2,{_hasAvatar:0}),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,1,2,"h4",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[1,4]],0,f._111,[],null,null),(n()(),p["ɵted"](null,[" Bitcoin address: ",""])),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,1,4,"p",[["class","mat-line"],["md-line",""]],null,null,null,null,null)),p["ɵdid"](16384,[[1,4]],0,f._111,[],null,null),(n()(),p["ɵted"](null,[" Status: "," (updated at: ",") "])),p["ɵppd"](1),p["ɵppd"](1),(n()(),p["ɵted"](2,["\n        "])),(n()(),p["ɵeld"](0,null,2,9,"button",[["class","mat-icon-button"],["md-icon-button",""]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==u.searchDepositAddress(n.parent.context.$implicit.address)&&t}return t},m.e,m.f)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](180224,null,0,f.V,[p.Renderer2,p.ElementRef,v.a,f.a],{disabled:[0,"disabled"]},null),p["ɵdid"](16384,null,0,f._49,[],null,null),(n()(),p["ɵted"](0,["\n          "])),(n()(),p["ɵeld"](0,null,0,3,"md-icon",[["class","mat-icon"],["role","img"]],null,null,null,m.A,m.B)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](638976,null,0,f._48,[p.Renderer2,p.ElementRef,f.h,[8,null]],null,null),(n()(),p["ɵted"](0,["refresh"])),(n()(),p["ɵted"](0,["\n        "])),(n()(),p["ɵted"](2,["\n      "]))],function(n,l){n(l,18,0,l.component.scanning),n(l,23,0)},function(n,l){n(l,8,0,l.context.$implicit.btc),n(l,12,0,p["ɵunv"](l,12,0,n(l,13,0,p["ɵnov"](l.parent.parent.parent,0),l.context.$implicit.status)),p["ɵunv"](l,12,1,n(l,14,0,p["ɵnov"](l.parent.parent.parent,1),l.context.$implicit.updated))),n(l,16,0,p["ɵnov"](l,18).disabled||null)})}function r(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,11,null,null,null,null,null,null,null)),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵand"](16777216,null,null,1,null,u)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵeld"](0,null,null,2,"h3",[["class","mat-subheader"],["md-subheader",""]],null,null,null,null,null)),p["ɵdid"](16384,null,0,f._112,[],null,null),(n()(),p["ɵted"](null,["Sc2 address: ",""])),(n()(),p["ɵted"](null,["\n      "])),(n()(),p["ɵand"](16777216,null,null,1,null,i)),p["ɵdid"](802816,null,0,b.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),(n()(),p["ɵted"](null,["\n    "]))],function(n,l){n(l,3,0,l.context.index),n(l,10,0,l.context.$implicit.addresses)},function(n,l){n(l,7,0,l.context.$implicit.address)})}function o(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,23,"md-card",[["class","mat-card"]],null,null,null,m.w,m.x)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,3,"md-card-title",[["class","mat-card-title"]],null,null,null,null,null)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](16384,null,0,f._108,[],null,null),(n()(),p["ɵted"](null,["Purchase Skycoin"])),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵeld"](0,null,0,3,"md-card",[["class","skycoin-details mat-card"]],null,null,null,m.w,m.x)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,f._42,[],null,null),(n()(),p["ɵted"](0,["\n    You can buy Shellcoins directly from your wallet using our Shellcoin Teller service. The current rate is 0.0002 BTC per\n    SC2. To buy SC2, request a BTC deposit address. Once you have a BTC deposit address, any BTC deposits will\n    automatically be added to your selected address.\n  "])),(n()(),p["ɵted"](0,["\n\n  "])),(n()(),p["ɵeld"](0,null,0,8,"md-list",[["class","mat-list"],["role","list"]],null,null,null,m.C,m.D)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](49152,null,0,f._70,[],null,null),p["ɵdid"](16384,null,0,f._113,[],null,null),(n()(),p["ɵted"](0,["\n    "])),(n()(),p["ɵand"](16777216,null,0,2,null,r)),p["ɵdid"](802816,null,0,b.NgForOf,[p.ViewContainerRef,p.TemplateRef,p.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),p["ɵpid"](131072,b.AsyncPipe,[p.ChangeDetectorRef]),(n()(),p["ɵted"](0,["\n  "])),(n()(),p["ɵted"](0,["\n"]))],function(n,l){var e=l.component;n(l,20,0,p["ɵunv"](l,20,0,p["ɵnov"](l,21).transform(e.purchaseService.all())))},null)}function a(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),p["ɵted"](null,["\n  "])),(n()(),p["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==p["ɵnov"](n,4)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.addDepositAddress()&&t}return t},m.q,m.r)),p["ɵdid"](16384,null,0,f.T,[[2,f.U],p.ElementRef],null,null),p["ɵdid"](180224,null,0,f._38,[v.a,f.a,p.ElementRef,p.Renderer2],{color:[0,"color"]},null),p["ɵdid"](16384,null,0,f._107,[],null,null),(n()(),p["ɵted"](0,["Add deposit address"])),(n()(),p["ɵted"](null,["\n"]))],function(n,l){n(l,4,0,"primary")},function(n,l){n(l,2,0,p["ɵnov"](l,4).disabled?-1:0,p["ɵnov"](l,4).disabled||null,p["ɵnov"](l,4).disabled.toString())})}function d(n){return p["ɵvid"](0,[p["ɵpid"](0,h.a,[]),p["ɵpid"](0,g.a,[]),(n()(),p["ɵand"](16777216,null,null,1,null,t)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n"])),(n()(),p["ɵand"](16777216,null,null,1,null,o)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n"])),(n()(),p["ɵand"](16777216,null,null,1,null,a)),p["ɵdid"](16384,null,0,b.NgIf,[p.ViewContainerRef,p.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),p["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,3,0,!e.otcEnabled),n(l,6,0,e.otcEnabled),n(l,9,0,e.otcEnabled)},null)}function c(n){return p["ɵvid"](0,[(n()(),p["ɵeld"](0,null,null,1,"app-buy",[],null,null,null,d,k)),p["ɵdid"](49152,null,0,y.a,[_.a,f.e],null,null)],null,null)}var s=e("QAaR"),p=e("/oeL"),f=e("Z04r"),m=e("v6Q/"),v=e("JYHx"),b=e("qbdv"),h=e("6e64"),g=e("lL01"),y=e("BZkh"),_=e("ScY5");e.d(l,"a",function(){return w});var x=[s.a],k=p["ɵcrt"]({encapsulation:0,styles:x,data:{}}),w=p["ɵccf"]("app-buy",y.a,c,{},{},[])},k7ea:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-card[_ngcontent-%COMP%]{max-width:1000px;margin-top:80px;margin-right:auto;margin-left:auto}.logo[_ngcontent-%COMP%]{max-height:100%}.fill-remaining-space[_ngcontent-%COMP%]{-webkit-box-flex:1;-ms-flex:1 1 auto;flex:1 1 auto}.sky-container[_ngcontent-%COMP%]{max-width:1000px;margin-top:20px;margin-right:auto;margin-left:auto}md-toolbar[_ngcontent-%COMP%]   span[_ngcontent-%COMP%]{margin:0 20px}.search-field[_ngcontent-%COMP%]{border-radius:8px;border:none;background-color:#fff;padding:8px}.syncing[_ngcontent-%COMP%]{font-size:14px}.main-menu[_ngcontent-%COMP%]   button[_ngcontent-%COMP%]{margin-right:20px}#top-menu[_ngcontent-%COMP%]   span[_ngcontent-%COMP%]{color:#fbfbfb}#top-menu[_ngcontent-%COMP%]   span#version[_ngcontent-%COMP%]{padding-top:12px}"]},k9Ay:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["span[_ngcontent-%COMP%]{display:inline-block;width:50%}"]},kRbD:function(n,l,e){"use strict";var t=e("bm2B"),u=e("cP51"),i=e("Z04r");e.d(l,"a",function(){return r});var r=function(){function n(n,l,e){this.dialogRef=n,this.formBuilder=l,this.walletService=e}return n.prototype.ngOnInit=function(){this.initForm()},n.prototype.createWallet=function(){var n=this;this.walletService.create(this.form.value.label,this.form.value.seed).subscribe(function(){return n.dialogRef.close()})},n.prototype.generateSeed=function(){var n=this;this.walletService.generateSeed().subscribe(function(l){return n.form.controls.seed.setValue(l)})},n.prototype.initForm=function(){this.form=this.formBuilder.group({label:["",t.k.required],seed:["",t.k.required]}),this.generateSeed()},n.ctorParameters=function(){return[{type:i.R},{type:t.a},{type:u.a}]},n}()},l4UU:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,29,"md-card",[["class","mat-card"]],null,null,null,o.w,o.x)),r["ɵdid"](16384,null,0,a.T,[[2,a.U],r.ElementRef],null,null),r["ɵdid"](49152,null,0,a._42,[],null,null),(n()(),r["ɵted"](0,["\n  "])),(n()(),r["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),r["ɵted"](null,["Automatic peers"])),(n()(),r["ɵted"](0,["\n\n  "])),(n()(),r["ɵeld"](0,null,0,21,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==r["ɵnov"](n,8).onWindowResize()&&t}return t},d.a,d.b)),r["ɵdid"](4571136,null,3,c.DatatableComponent,[s.ScrollbarHelper,r.ElementRef,r.KeyValueDiffers],{rows:[0,"rows"],rowHeight:[1,"rowHeight"],columnMode:[2,"columnMode"],headerHeight:[3,"headerHeight"],footerHeight:[4,"footerHeight"],limit:[5,"limit"]},null),r["ɵqud"](603979776,1,{columnTemplates:1}),r["ɵqud"](335544320,2,{rowDetail:0}),r["ɵqud"](335544320,3,{footer:0}),r["ɵpid"](131072,p.AsyncPipe,[r.ChangeDetectorRef]),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","ID"],["prop","id"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,4,{cellTemplate:0}),r["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"],["prop","address"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,6,{cellTemplate:0}),r["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Port"],["prop","listen_port"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,8,{cellTemplate:0}),r["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵted"](0,["\n"])),(n()(),r["ɵted"](null,["\n\n"])),(n()(),r["ɵeld"](0,null,null,28,"md-card",[["class","mat-card"]],null,null,null,o.w,o.x)),r["ɵdid"](16384,null,0,a.T,[[2,a.U],r.ElementRef],null,null),r["ɵdid"](49152,null,0,a._42,[],null,null),(n()(),r["ɵted"](0,["\n  "])),(n()(),r["ɵeld"](0,null,0,1,"h3",[],null,null,null,null,null)),(n()(),r["ɵted"](null,["Default peers"])),(n()(),r["ɵted"](0,["\n\n  "])),(n()(),r["ɵeld"](0,null,0,20,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==r["ɵnov"](n,39).onWindowResize()&&t}return t},d.a,d.b)),r["ɵdid"](4571136,null,3,c.DatatableComponent,[s.ScrollbarHelper,r.ElementRef,r.KeyValueDiffers],{rows:[0,"rows"],rowHeight:[1,"rowHeight"],columnMode:[2,"columnMode"],headerHeight:[3,"headerHeight"]},null),r["ɵqud"](603979776,10,{columnTemplates:1}),r["ɵqud"](335544320,11,{rowDetail:0}),r["ɵqud"](335544320,12,{footer:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","ID"],["prop","id"]],null,null,null,null,null)),r["ɵdid"](16384,[[10,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,13,{cellTemplate:0}),r["ɵqud"](335544320,14,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"],["prop","address"]],null,null,null,null,null)),r["ɵdid"](16384,[[10,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,15,{cellTemplate:0}),r["ɵqud"](335544320,16,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n    "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Port"],["prop","listen_port"]],null,null,null,null,null)),r["ɵdid"](16384,[[10,4]],2,f.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,17,{cellTemplate:0}),r["ɵqud"](335544320,18,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵted"](0,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,8,0,r["ɵunv"](l,8,0,r["ɵnov"](l,12).transform(e.networkService.automatic())),40,"flex",40,40,5);n(l,15,0,"ID","id",1);n(l,20,0,"Address","address",1);n(l,25,0,"Port","listen_port",1);n(l,39,0,e.defaultConnections,50,"flex",40);n(l,45,0,"ID","id",1);n(l,50,0,"Address","address",1);n(l,55,0,"Port","listen_port",1)},function(n,l){n(l,7,0,r["ɵnov"](l,8).isFixedHeader,r["ɵnov"](l,8).isFixedRow,r["ɵnov"](l,8).isVertScroll,r["ɵnov"](l,8).isHorScroll,r["ɵnov"](l,8).isSelectable,r["ɵnov"](l,8).isCheckboxSelection,r["ɵnov"](l,8).isCellSelection,r["ɵnov"](l,8).isSingleSelection,r["ɵnov"](l,8).isMultiSelection,r["ɵnov"](l,8).isMultiClickSelection),n(l,38,0,r["ɵnov"](l,39).isFixedHeader,r["ɵnov"](l,39).isFixedRow,r["ɵnov"](l,39).isVertScroll,r["ɵnov"](l,39).isHorScroll,r["ɵnov"](l,39).isSelectable,r["ɵnov"](l,39).isCheckboxSelection,r["ɵnov"](l,39).isCellSelection,r["ɵnov"](l,39).isSingleSelection,r["ɵnov"](l,39).isMultiSelection,r["ɵnov"](l,39).isMultiClickSelection)})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-network",[],null,null,null,t,h)),r["ɵdid"](114688,null,0,m.a,[v.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("IFVI"),r=e("/oeL"),o=e("v6Q/"),a=e("Z04r"),d=e("LwgZ"),c=e("f2O0"),s=(e.n(c),e("7i8B")),p=(e.n(s),e("qbdv")),f=e("47oE"),m=(e.n(f),e("PRn9")),v=e("dSyq");e.d(l,"a",function(){return g});var b=[i.a],h=r["ɵcrt"]({encapsulation:0,styles:b,data:{}}),g=r["ɵccf"]("app-network",m.a,u,{},{},[])},lL01:function(n,l,e){"use strict";var t=e("ge1L");e.n(t);e.d(l,"a",function(){return u});var u=function(){function n(){}return n.prototype.transform=function(n){return t.unix(n).format("YYYY-MM-DD HH:mm")},n}()},mZbS:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},nA3a:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,20,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[["window","resize"]],function(n,l,e){var t=!0;if("window:resize"===l){t=!1!==r["ɵnov"](n,1).onWindowResize()&&t}return t},o.a,o.b)),r["ɵdid"](4571136,[["table",4]],3,a.DatatableComponent,[d.ScrollbarHelper,r.ElementRef,r.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},null),r["ɵqud"](603979776,1,{columnTemplates:1}),r["ɵqud"](335544320,2,{rowDetail:0}),r["ɵqud"](335544320,3,{footer:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Address"],["prop","address"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,c.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,4,{cellTemplate:0}),r["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Coins"],["prop","coins"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,c.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,6,{cellTemplate:0}),r["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Coin hours"],["prop","hours"]],null,null,null,null,null)),r["ɵdid"](16384,[[1,4]],2,c.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),r["ɵqud"](335544320,8,{cellTemplate:0}),r["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,1,0,l.component.outputs,!0,50,"flex",50,50,10);n(l,7,0,"Address","address",2);n(l,12,0,"Coins","coins",1);n(l,17,0,"Coin hours","hours",1)},function(n,l){n(l,0,0,r["ɵnov"](l,1).isFixedHeader,r["ɵnov"](l,1).isFixedRow,r["ɵnov"](l,1).isVertScroll,r["ɵnov"](l,1).isHorScroll,r["ɵnov"](l,1).isSelectable,r["ɵnov"](l,1).isCheckboxSelection,r["ɵnov"](l,1).isCellSelection,r["ɵnov"](l,1).isSingleSelection,r["ɵnov"](l,1).isMultiSelection,r["ɵnov"](l,1).isMultiClickSelection)})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-outputs",[],null,null,null,t,m)),r["ɵdid"](114688,null,0,s.a,[p.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("o5tI"),r=e("/oeL"),o=e("LwgZ"),a=e("f2O0"),d=(e.n(a),e("7i8B")),c=(e.n(d),e("47oE")),s=(e.n(c),e("KbYb")),p=e("cP51");e.d(l,"a",function(){return v});var f=[i.a],m=r["ɵcrt"]({encapsulation:0,styles:f,data:{}}),v=r["ɵccf"]("app-outputs",s.a,u,{},{},[])},"nI5/":function(n,l,e){"use strict";var t=e("cP51"),u=e("bm2B"),i=e("q+5A"),r=(e.n(i),e("BkNc")),o=e("Z04r"),a=e("l3Q+"),d=(e.n(a),e("gbhw"));e.n(d);e.d(l,"a",function(){return c});var c=function(){function n(n,l,e,t){this.formBuilder=n,this.walletService=l,this.router=e,this.snackbar=t,this.records=[],this.transactions=[]}return n.prototype.ngOnInit=function(){var n=this;this.initForm(),i.IntervalObservable.create(2500).filter(function(){return!!n.transactions.length}).flatMap(function(){return n.walletService.retrieveUpdatedTransactions(n.transactions)}).subscribe(function(l){return n.records=l}),this.walletService.recent().subscribe(function(l){return n.transactions=l})},n.prototype.onActivate=function(n){n.row&&n.row.txid&&this.router.navigate(["/history",n.row.txid])},n.prototype.send=function(){var n=this;this.button.setLoading();var l=this.form.value.wallet.meta.filename;this.walletService.sendSkycoin(l,this.form.value.address,1e6*this.form.value.amount).delay(1e3).subscribe(function(l){n.resetForm(),n.button.setSuccess()},function(l){var e=new o.S;e.duration=3e5,n.snackbar.open(l._body,null,e),n.button.setError(l)})},n.prototype.initForm=function(){var n=this;this.form=this.formBuilder.group({wallet:["",u.k.required],address:["",u.k.required],amount:["",[u.k.required,u.k.min(0),u.k.max(0)]]}),this.form.controls.wallet.valueChanges.subscribe(function(l){var e=l&&l.balance?l.balance/1e6:0;n.form.controls.amount.setValidators([u.k.required,u.k.min(0),u.k.max(e)]),n.form.controls.amount.updateValueAndValidity()})},n.prototype.resetForm=function(){this.form.controls.wallet.reset(void 0),this.form.controls.address.reset(void 0),this.form.controls.amount.reset(void 0)},n.ctorParameters=function(){return[{type:u.a},{type:t.a},{type:r.c},{type:o.n}]},n}()},o5tI:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},omNI:function(n,l,e){"use strict";function t(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n      ","\n    "])),d["ɵppd"](1)],null,function(n,l){n(l,0,0,d["ɵunv"](l,0,0,n(l,1,0,d["ɵnov"](l.parent,0),l.context.value)))})}function u(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n      ","\n    "]))],null,function(n,l){n(l,0,0,l.context.value?l.context.value.length:0)})}function i(n){return d["ɵvid"](0,[(n()(),d["ɵted"](null,["\n      ","\n    "])),d["ɵppd"](1)],null,function(n,l){n(l,0,0,d["ɵunv"](l,0,0,n(l,1,0,d["ɵnov"](l.parent,1),l.context.value)))})}function r(n){return d["ɵvid"](0,[d["ɵpid"](0,c.a,[]),d["ɵpid"](0,s.a,[]),(n()(),d["ɵeld"](0,null,null,37,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==d["ɵnov"](n,3).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},f.a,f.b)),d["ɵdid"](4571136,[["table",4]],3,m.DatatableComponent,[v.ScrollbarHelper,d.ElementRef,d.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),d["ɵqud"](603979776,1,{columnTemplates:1}),d["ɵqud"](335544320,2,{rowDetail:0}),d["ɵqud"](335544320,3,{footer:0}),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","header.timestamp"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,4,{cellTemplate:0}),d["ɵqud"](335544320,5,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵand"](0,[[4,2]],null,1,null,t)),d["ɵdid"](16384,null,0,h.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Block height"],["prop","header.seq"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,6,{cellTemplate:0}),d["ɵqud"](335544320,7,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Transactions"],["prop","body.txns"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,8,{cellTemplate:0}),d["ɵqud"](335544320,9,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵand"](0,[[8,2]],null,1,null,u)),d["ɵdid"](16384,null,0,h.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Amount"],["prop","body.txns"]],null,null,null,null,null)),d["ɵdid"](16384,[[1,4]],2,b.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),d["ɵqud"](335544320,10,{cellTemplate:0}),d["ɵqud"](335544320,11,{headerTemplate:0}),(n()(),d["ɵted"](null,["\n    "])),(n()(),d["ɵand"](0,[[10,2]],null,1,null,i)),d["ɵdid"](16384,null,0,h.DataTableColumnCellDirective,[d.TemplateRef],null,null),(n()(),d["ɵted"](null,["\n  "])),(n()(),d["ɵted"](null,["\n"])),(n()(),d["ɵted"](null,["\n"]))],function(n,l){n(l,3,0,l.component.blocks,!0,50,"flex",50,50,10);n(l,9,0,"Timestamp","header.timestamp",1);n(l,18,0,"Block height","header.seq",1);n(l,23,0,"Transactions","body.txns",1);n(l,32,0,"Amount","body.txns",1)},function(n,l){n(l,2,0,d["ɵnov"](l,3).isFixedHeader,d["ɵnov"](l,3).isFixedRow,d["ɵnov"](l,3).isVertScroll,d["ɵnov"](l,3).isHorScroll,d["ɵnov"](l,3).isSelectable,d["ɵnov"](l,3).isCheckboxSelection,d["ɵnov"](l,3).isCellSelection,d["ɵnov"](l,3).isSingleSelection,d["ɵnov"](l,3).isMultiSelection,d["ɵnov"](l,3).isMultiClickSelection)})}function o(n){return d["ɵvid"](0,[(n()(),d["ɵeld"](0,null,null,1,"app-explorer",[],null,null,null,r,x)),d["ɵdid"](114688,null,0,p.a,[g.a,y.c],null,null)],function(n,l){n(l,1,0)},null)}var a=e("xrX6"),d=e("/oeL"),c=e("lL01"),s=e("Y66J"),p=e("7Bws"),f=e("LwgZ"),m=e("f2O0"),v=(e.n(m),e("7i8B")),b=(e.n(v),e("47oE")),h=(e.n(b),e("GHh8")),g=(e.n(h),e("SJy7")),y=e("BkNc");e.d(l,"a",function(){return k});var _=[a.a],x=d["ɵcrt"]({encapsulation:0,styles:_,data:{}}),k=d["ɵccf"]("app-explorer",p.a,o,{},{},[])},p5Ee:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t={production:!0}},"p6Q+":function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵted"](null,["\n      ","\n    "])),o["ɵppd"](1)],null,function(n,l){n(l,0,0,o["ɵunv"](l,0,0,n(l,1,0,o["ɵnov"](l.parent,0),l.context.value)))})}function u(n){return o["ɵvid"](0,[o["ɵpid"](0,a.a,[]),o["ɵqud"](402653184,1,{table:0}),(n()(),o["ɵeld"](0,null,null,25,"ngx-datatable",[["class","material ngx-datatable"],["columnMode","flex"]],[[2,"fixed-header",null],[2,"fixed-row",null],[2,"scroll-vertical",null],[2,"scroll-horz",null],[2,"selectable",null],[2,"checkbox-selection",null],[2,"cell-selection",null],[2,"single-selection",null],[2,"multi-selection",null],[2,"multi-click-selection",null]],[[null,"activate"],["window","resize"]],function(n,l,e){var t=!0,u=n.component;if("window:resize"===l){t=!1!==o["ɵnov"](n,3).onWindowResize()&&t}if("activate"===l){t=!1!==u.onActivate(e)&&t}return t},c.a,c.b)),o["ɵdid"](4571136,[[1,4],["table",4]],3,s.DatatableComponent,[p.ScrollbarHelper,o.ElementRef,o.KeyValueDiffers],{rows:[0,"rows"],scrollbarH:[1,"scrollbarH"],rowHeight:[2,"rowHeight"],columnMode:[3,"columnMode"],headerHeight:[4,"headerHeight"],footerHeight:[5,"footerHeight"],limit:[6,"limit"]},{activate:"activate"}),o["ɵqud"](603979776,2,{columnTemplates:1}),o["ɵqud"](335544320,3,{rowDetail:0}),o["ɵqud"](335544320,4,{footer:0}),o["ɵpid"](131072,f.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,7,"ngx-datatable-column",[["name","Timestamp"],["prop","timestamp"]],null,null,null,null,null)),o["ɵdid"](16384,[[2,4]],2,m.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,5,{cellTemplate:0}),o["ɵqud"](335544320,6,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n    "])),(n()(),o["ɵand"](0,[[5,2]],null,1,null,t)),o["ɵdid"](16384,null,0,v.DataTableColumnCellDirective,[o.TemplateRef],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Amount"]],null,null,null,null,null)),o["ɵdid"](16384,[[2,4]],2,m.DataTableColumnDirective,[],{name:[0,"name"],flexGrow:[1,"flexGrow"]},null),o["ɵqud"](335544320,7,{cellTemplate:0}),o["ɵqud"](335544320,8,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,3,"ngx-datatable-column",[["name","Transaction ID"],["prop","txid"]],null,null,null,null,null)),o["ɵdid"](16384,[[2,4]],2,m.DataTableColumnDirective,[],{name:[0,"name"],prop:[1,"prop"],flexGrow:[2,"flexGrow"]},null),o["ɵqud"](335544320,9,{cellTemplate:0}),o["ɵqud"](335544320,10,{headerTemplate:0}),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n\n"]))],function(n,l){var e=l.component;n(l,3,0,o["ɵunv"](l,3,0,o["ɵnov"](l,7).transform(e.walletService.history())),!0,50,"flex",50,50,10);n(l,10,0,"Timestamp","timestamp",2);n(l,19,0,"Amount",1);n(l,24,0,"Transaction ID","txid",5)},function(n,l){n(l,2,0,o["ɵnov"](l,3).isFixedHeader,o["ɵnov"](l,3).isFixedRow,o["ɵnov"](l,3).isVertScroll,o["ɵnov"](l,3).isHorScroll,o["ɵnov"](l,3).isSelectable,o["ɵnov"](l,3).isCheckboxSelection,o["ɵnov"](l,3).isCellSelection,o["ɵnov"](l,3).isSingleSelection,o["ɵnov"](l,3).isMultiSelection,o["ɵnov"](l,3).isMultiClickSelection)})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-history",[],null,null,null,u,y)),o["ɵdid"](114688,null,0,d.a,[b.c,h.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("Ymo7"),o=e("/oeL"),a=e("Caxp"),d=e("iXFZ"),c=e("LwgZ"),s=e("f2O0"),p=(e.n(s),e("7i8B")),f=(e.n(p),e("qbdv")),m=e("47oE"),v=(e.n(m),e("GHh8")),b=(e.n(v),e("BkNc")),h=e("cP51");e.d(l,"a",function(){return _});var g=[r.a],y=o["ɵcrt"]({encapsulation:0,styles:g,data:{}}),_=o["ɵccf"]("app-history",d.a,i,{},{},[])},pCa7:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["md-select[_ngcontent-%COMP%]{width:100%;padding:40px 0 20px}"]},q4dy:function(n,l,e){"use strict";function t(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,1,"span",[["class","syncing"]],null,null,null,null,null)),(n()(),c["ɵted"](null,["\n    Syncing blocks ","\n  "]))],null,function(n,l){var e=l.component;n(l,1,0,e.current&&e.highest?"("+e.current+"/"+e.highest+")":"..")})}function u(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,3,"span",[],null,null,null,null,null)),(n()(),c["ɵted"](null,["",""])),c["ɵpid"](131072,s.AsyncPipe,[c.ChangeDetectorRef]),c["ɵppd"](1)],null,function(n,l){var e=l.component;n(l,1,0,c["ɵunv"](l,1,0,n(l,3,0,c["ɵnov"](l.parent,0),c["ɵunv"](l,1,0,c["ɵnov"](l,2).transform(e.walletService.sum())))))})}function i(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,4).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[8,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,8,{links:1}),c["ɵqud"](603979776,9,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Buy"]))],function(n,l){n(l,4,0,n(l,5,0,"/buy"));n(l,6,0,"active")},function(n,l){n(l,0,0,c["ɵnov"](l,2).disabled||null)})}function r(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,2,"md-progress-bar",[["aria-valuemax","100"],["aria-valuemin","0"],["class","example-margin mat-progress-bar"],["color","primary"],["mode","determinate"],["role","progressbar"]],[[1,"aria-valuenow",0],[1,"mode",0],[2,"mat-primary",null],[2,"mat-accent",null],[2,"mat-warn",null]],null,null,p.g,p.h)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](49152,null,0,f.X,[],{color:[0,"color"],value:[1,"value"],mode:[2,"mode"]},null)],function(n,l){n(l,2,0,"primary",l.component.percentage,"determinate")},function(n,l){n(l,0,0,c["ɵnov"](l,2).value,c["ɵnov"](l,2).mode,"primary"==c["ɵnov"](l,2).color,"accent"==c["ɵnov"](l,2).color,"warn"==c["ɵnov"](l,2).color)})}function o(n){return c["ɵvid"](0,[c["ɵpid"](0,b.a,[]),(n()(),c["ɵeld"](0,null,null,62,"md-toolbar",[["class","mat-toolbar"],["color","primary"],["id","top-menu"],["role","toolbar"]],null,null,null,p.i,p.j)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](49152,null,0,f.Y,[c.Renderer2,c.ElementRef],{color:[0,"color"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,0,"img",[["class","logo"],["src","/assets/logo-white.png"]],null,null,null,null,null)),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,0,"span",[["class","fill-remaining-space"]],null,null,null,null,null)),(n()(),c["ɵted"](0,["\n\n  "])),(n()(),c["ɵand"](16777216,null,0,1,null,t)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵand"](16777216,null,0,1,null,u)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,38,"md-menu",[],null,null,null,p.k,p.l)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](1228800,[["settingsMenu",4]],1,f.Z,[c.ElementRef,f.P],null,null),c["ɵqud"](603979776,1,{items:1}),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,2,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,23)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,23)._emitHoverEvent()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],{disabled:[0,"disabled"]},null),(n()(),c["ɵted"](0,[" Version "," "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,27)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,27)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,28).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Networking "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,33)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,33)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,34).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Blockchain "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,39)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,39)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,40).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Outputs "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,45)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,45)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,46).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Pending Transactions "])),(n()(),c["ɵted"](0,["\n    "])),(n()(),c["ɵeld"](0,null,0,4,"button",[["class","mat-menu-item"],["md-menu-item",""],["role","menuitem"]],[[2,"mat-menu-item-highlighted",null],[2,"mat-menu-item-submenu-trigger",null],[1,"tabindex",0],[1,"aria-disabled",0],[1,"disabled",0]],[[null,"click"],[null,"mouseenter"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,51)._checkDisabled(e)&&t}if("mouseenter"===l){t=!1!==c["ɵnov"](n,51)._emitHoverEvent()&&t}if("click"===l){t=!1!==c["ɵnov"](n,52).onClick()&&t}return t},p.m,p.n)),c["ɵdid"](180224,[[1,4]],0,f._0,[c.ElementRef],null,null),c["ɵdid"](16384,null,0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),(n()(),c["ɵted"](0,[" Back-up wallet "])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵted"](0,["\n\n  "])),(n()(),c["ɵeld"](16777216,null,0,5,"button",[["aria-haspopup","true"],["class","mat-button"],["md-button",""]],[[8,"disabled",0]],[[null,"mousedown"],[null,"keydown"],[null,"click"]],function(n,l,e){var t=!0;if("mousedown"===l){t=!1!==c["ɵnov"](n,61)._handleMousedown(e)&&t}if("keydown"===l){t=!1!==c["ɵnov"](n,61)._handleKeydown(e)&&t}if("click"===l){t=!1!==c["ɵnov"](n,61)._handleClick(e)&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](4341760,null,0,f._1,[h.e,c.ElementRef,c.ViewContainerRef,f.j,[2,f.Z],[8,null],[2,g.b]],{menu:[0,"menu"]},null),(n()(),c["ɵted"](0,["Settings"])),(n()(),c["ɵted"](0,["\n"])),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵeld"](0,null,null,52,"md-toolbar",[["class","main-menu mat-toolbar"],["role","toolbar"]],null,null,null,p.i,p.j)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](49152,null,0,f.Y,[c.Renderer2,c.ElementRef],null,null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,73).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[2,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,2,{links:1}),c["ɵqud"](603979776,3,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Wallets"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,84).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[4,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,4,{links:1}),c["ɵqud"](603979776,5,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Send"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,95).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[6,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,6,{links:1}),c["ɵqud"](603979776,7,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["History"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵand"](16777216,null,0,1,null,i)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,9,"button",[["class","mat-button"],["md-button",""],["routerLinkActive","active"]],[[8,"disabled",0]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==c["ɵnov"](n,109).onClick()&&t}return t},p.e,p.f)),c["ɵdid"](16384,null,0,f.T,[[2,f.U],c.ElementRef],null,null),c["ɵdid"](180224,null,0,f.V,[c.Renderer2,c.ElementRef,m.a,f.a],null,null),c["ɵdid"](16384,null,0,f.W,[],null,null),c["ɵdid"](16384,[[10,4]],0,v.y,[v.c,v.a,[8,null],c.Renderer2,c.ElementRef],{routerLink:[0,"routerLink"]},null),c["ɵpad"](1),c["ɵdid"](1720320,null,2,v.z,[v.c,c.ElementRef,c.Renderer2,c.ChangeDetectorRef],{routerLinkActive:[0,"routerLinkActive"]},null),c["ɵqud"](603979776,10,{links:1}),c["ɵqud"](603979776,11,{linksWithHrefs:1}),(n()(),c["ɵted"](0,["Explorer"])),(n()(),c["ɵted"](0,["\n  "])),(n()(),c["ɵeld"](0,null,0,0,"span",[["class","fill-remaining-space"]],null,null,null,null,null)),(n()(),c["ɵted"](0,["\n\n"])),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵand"](16777216,null,null,1,null,r)),c["ɵdid"](16384,null,0,s.NgIf,[c.ViewContainerRef,c.TemplateRef],{ngIf:[0,"ngIf"]},null),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵeld"](0,null,null,4,"div",[["class","sky-container"]],null,null,null,null,null)),(n()(),c["ɵted"](null,["\n  "])),(n()(),c["ɵeld"](16777216,null,null,1,"router-outlet",[],null,null,null,null,null)),c["ɵdid"](212992,null,0,v.A,[v.q,c.ViewContainerRef,c.ComponentFactoryResolver,[8,null],c.ChangeDetectorRef],null,null),(n()(),c["ɵted"](null,["\n"])),(n()(),c["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,3,0,"primary"),n(l,12,0,e.loading()),n(l,15,0,!e.loading());n(l,23,0,!0),n(l,28,0,n(l,29,0,"/settings/network")),n(l,34,0,n(l,35,0,"/settings/blockchain")),n(l,40,0,n(l,41,0,"/settings/outputs")),n(l,46,0,n(l,47,0,"/settings/pending-transactions")),n(l,52,0,n(l,53,0,"/settings/backup")),n(l,61,0,c["ɵnov"](l,19)),n(l,73,0,n(l,74,0,"/wallets"));n(l,75,0,"active"),n(l,84,0,n(l,85,0,"/send"));n(l,86,0,"active"),n(l,95,0,n(l,96,0,"/history"));n(l,97,0,"active"),n(l,103,0,e.otcEnabled),n(l,109,0,n(l,110,0,"/explorer"));n(l,111,0,"active"),n(l,120,0,e.loading()),n(l,125,0)},function(n,l){var e=l.component;n(l,22,0,c["ɵnov"](l,23)._highlighted,c["ɵnov"](l,23)._triggersSubmenu,c["ɵnov"](l,23)._getTabIndex(),c["ɵnov"](l,23).disabled.toString(),c["ɵnov"](l,23).disabled||null),n(l,24,0,e.version),n(l,26,0,c["ɵnov"](l,27)._highlighted,c["ɵnov"](l,27)._triggersSubmenu,c["ɵnov"](l,27)._getTabIndex(),c["ɵnov"](l,27).disabled.toString(),c["ɵnov"](l,27).disabled||null),n(l,32,0,c["ɵnov"](l,33)._highlighted,c["ɵnov"](l,33)._triggersSubmenu,c["ɵnov"](l,33)._getTabIndex(),c["ɵnov"](l,33).disabled.toString(),c["ɵnov"](l,33).disabled||null),n(l,38,0,c["ɵnov"](l,39)._highlighted,c["ɵnov"](l,39)._triggersSubmenu,c["ɵnov"](l,39)._getTabIndex(),c["ɵnov"](l,39).disabled.toString(),c["ɵnov"](l,39).disabled||null),n(l,44,0,c["ɵnov"](l,45)._highlighted,c["ɵnov"](l,45)._triggersSubmenu,c["ɵnov"](l,45)._getTabIndex(),c["ɵnov"](l,45).disabled.toString(),c["ɵnov"](l,45).disabled||null),n(l,50,0,c["ɵnov"](l,51)._highlighted,c["ɵnov"](l,51)._triggersSubmenu,c["ɵnov"](l,51)._getTabIndex(),c["ɵnov"](l,51).disabled.toString(),c["ɵnov"](l,51).disabled||null),n(l,57,0,c["ɵnov"](l,59).disabled||null),n(l,69,0,c["ɵnov"](l,71).disabled||null),n(l,80,0,c["ɵnov"](l,82).disabled||null),n(l,91,0,c["ɵnov"](l,93).disabled||null),n(l,105,0,c["ɵnov"](l,107).disabled||null)})}function a(n){return c["ɵvid"](0,[(n()(),c["ɵeld"](0,null,null,1,"app-root",[],null,null,null,o,R)),c["ɵdid"](114688,null,0,y.a,[_.a,x.a,k.a],null,null)],function(n,l){n(l,1,0)},null)}var d=e("k7ea"),c=e("/oeL"),s=e("qbdv"),p=e("v6Q/"),f=e("Z04r"),m=e("JYHx"),v=e("BkNc"),b=e("BruE"),h=e("z1Gz"),g=e("UPmf"),y=e("wQAS"),_=e("cP51"),x=e("1Zxj"),k=e("SJy7");e.d(l,"a",function(){return C});var w=[d.a],R=c["ɵcrt"]({encapsulation:0,styles:w,data:{}}),C=c["ɵccf"]("app-root",y.a,a,{},{},[])},qtrl:function(n,l){function e(n){throw new Error("Cannot find module '"+n+"'.")}e.keys=function(){return[]},e.resolve=e,n.exports=e,e.id="qtrl"},qwxJ:function(n,l){},sfWi:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,46,"div",[],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==r["ɵnov"](n,1).onSubmit(e)&&t}if("reset"===l){t=!1!==r["ɵnov"](n,1).onReset()&&t}return t},null,null)),r["ɵdid"](540672,null,0,o.i,[[8,null],[8,null]],{form:[0,"form"]},null),r["ɵprd"](2048,null,o.l,null,[o.i]),r["ɵdid"](16384,null,0,o.m,[o.l],null,null),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,19,"md-input-container",[["class","mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==r["ɵnov"](n,7)._control.focus()&&t}return t},a.o,a.p)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](7389184,null,6,d._65,[r.ElementRef,r.ChangeDetectorRef,[2,d._66]],null,null),r["ɵqud"](335544320,1,{_control:0}),r["ɵqud"](335544320,2,{_placeholderChild:0}),r["ɵqud"](603979776,3,{_errorChildren:1}),r["ɵqud"](603979776,4,{_hintChildren:1}),r["ɵqud"](603979776,5,{_prefixChildren:1}),r["ɵqud"](603979776,6,{_suffixChildren:1}),(n()(),r["ɵted"](1,["\n    "])),(n()(),r["ɵeld"](0,null,1,8,"input",[["class","mat-input-element"],["formControlName","label"],["mdInput",""],["placeholder","Label"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==r["ɵnov"](n,17)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,17).onTouched()&&t}if("compositionstart"===l){t=!1!==r["ɵnov"](n,17)._compositionStart()&&t}if("compositionend"===l){t=!1!==r["ɵnov"](n,17)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!1)&&t}if("focus"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!0)&&t}if("input"===l){t=!1!==r["ɵnov"](n,21)._onInput()&&t}return t},null,null)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](16384,null,0,o.n,[r.Renderer2,r.ElementRef,[2,o.o]],null,null),r["ɵprd"](1024,null,o.e,function(n){return[n]},[o.n]),r["ɵdid"](671744,null,0,o.p,[[3,o.l],[8,null],[8,null],[2,o.e]],{name:[0,"name"]},null),r["ɵprd"](2048,null,o.j,null,[o.p]),r["ɵdid"](933888,null,0,d._104,[r.ElementRef,r.Renderer2,c.a,[2,o.j],[2,o.h],[2,o.i],[2,d._105]],{placeholder:[0,"placeholder"]},null),r["ɵdid"](16384,null,0,o.q,[o.j],null,null),r["ɵprd"](2048,[[1,4]],d._106,null,[d._104]),(n()(),r["ɵted"](1,["\n  "])),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,19,"md-input-container",[["class","mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==r["ɵnov"](n,28)._control.focus()&&t}return t},a.o,a.p)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](7389184,null,6,d._65,[r.ElementRef,r.ChangeDetectorRef,[2,d._66]],null,null),r["ɵqud"](335544320,7,{_control:0}),r["ɵqud"](335544320,8,{_placeholderChild:0}),r["ɵqud"](603979776,9,{_errorChildren:1}),r["ɵqud"](603979776,10,{_hintChildren:1}),r["ɵqud"](603979776,11,{_prefixChildren:1}),r["ɵqud"](603979776,12,{_suffixChildren:1}),(n()(),r["ɵted"](1,["\n    "])),(n()(),r["ɵeld"](0,null,1,8,"textarea",[["class","mat-input-element"],["formControlName","seed"],["mdInput",""],["placeholder","Seed"],["row","5"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==r["ɵnov"](n,38)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,38).onTouched()&&t}if("compositionstart"===l){t=!1!==r["ɵnov"](n,38)._compositionStart()&&t}if("compositionend"===l){t=!1!==r["ɵnov"](n,38)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,42)._focusChanged(!1)&&t}if("focus"===l){t=!1!==r["ɵnov"](n,42)._focusChanged(!0)&&t}if("input"===l){t=!1!==r["ɵnov"](n,42)._onInput()&&t}return t},null,null)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](16384,null,0,o.n,[r.Renderer2,r.ElementRef,[2,o.o]],null,null),r["ɵprd"](1024,null,o.e,function(n){return[n]},[o.n]),r["ɵdid"](671744,null,0,o.p,[[3,o.l],[8,null],[8,null],[2,o.e]],{name:[0,"name"]},null),r["ɵprd"](2048,null,o.j,null,[o.p]),r["ɵdid"](933888,null,0,d._104,[r.ElementRef,r.Renderer2,c.a,[2,o.j],[2,o.h],[2,o.i],[2,d._105]],{placeholder:[0,"placeholder"]},null),r["ɵdid"](16384,null,0,o.q,[o.j],null,null),r["ɵprd"](2048,[[7,4]],d._106,null,[d._104]),(n()(),r["ɵted"](1,["\n  "])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵeld"](0,null,null,13,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,52)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.generateSeed()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],null,null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["New Seed"])),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,58)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.createWallet()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],{color:[0,"color"]},null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["Create"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,1,0,l.component.form);n(l,19,0,"label");n(l,21,0,"Label");n(l,40,0,"seed");n(l,42,0,"Seed");n(l,58,0,"primary")},function(n,l){n(l,0,0,r["ɵnov"](l,3).ngClassUntouched,r["ɵnov"](l,3).ngClassTouched,r["ɵnov"](l,3).ngClassPristine,r["ɵnov"](l,3).ngClassDirty,r["ɵnov"](l,3).ngClassValid,r["ɵnov"](l,3).ngClassInvalid,r["ɵnov"](l,3).ngClassPending),n(l,5,0,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.focused,r["ɵnov"](l,7)._shouldForward("untouched"),r["ɵnov"](l,7)._shouldForward("touched"),r["ɵnov"](l,7)._shouldForward("pristine"),r["ɵnov"](l,7)._shouldForward("dirty"),r["ɵnov"](l,7)._shouldForward("valid"),r["ɵnov"](l,7)._shouldForward("invalid"),r["ɵnov"](l,7)._shouldForward("pending")),n(l,15,1,[r["ɵnov"](l,21).id,r["ɵnov"](l,21).placeholder,r["ɵnov"](l,21).disabled,r["ɵnov"](l,21).required,r["ɵnov"](l,21)._ariaDescribedby||null,r["ɵnov"](l,21).errorState,r["ɵnov"](l,22).ngClassUntouched,r["ɵnov"](l,22).ngClassTouched,r["ɵnov"](l,22).ngClassPristine,r["ɵnov"](l,22).ngClassDirty,r["ɵnov"](l,22).ngClassValid,r["ɵnov"](l,22).ngClassInvalid,r["ɵnov"](l,22).ngClassPending]),n(l,26,0,r["ɵnov"](l,28)._control.errorState,r["ɵnov"](l,28)._control.errorState,r["ɵnov"](l,28)._control.focused,r["ɵnov"](l,28)._shouldForward("untouched"),r["ɵnov"](l,28)._shouldForward("touched"),r["ɵnov"](l,28)._shouldForward("pristine"),r["ɵnov"](l,28)._shouldForward("dirty"),r["ɵnov"](l,28)._shouldForward("valid"),r["ɵnov"](l,28)._shouldForward("invalid"),r["ɵnov"](l,28)._shouldForward("pending")),n(l,36,1,[r["ɵnov"](l,42).id,r["ɵnov"](l,42).placeholder,r["ɵnov"](l,42).disabled,r["ɵnov"](l,42).required,r["ɵnov"](l,42)._ariaDescribedby||null,r["ɵnov"](l,42).errorState,r["ɵnov"](l,43).ngClassUntouched,r["ɵnov"](l,43).ngClassTouched,r["ɵnov"](l,43).ngClassPristine,r["ɵnov"](l,43).ngClassDirty,r["ɵnov"](l,43).ngClassValid,r["ɵnov"](l,43).ngClassInvalid,r["ɵnov"](l,43).ngClassPending]),n(l,50,0,r["ɵnov"](l,52).disabled?-1:0,r["ɵnov"](l,52).disabled||null,r["ɵnov"](l,52).disabled.toString()),n(l,56,0,r["ɵnov"](l,58).disabled?-1:0,r["ɵnov"](l,58).disabled||null,r["ɵnov"](l,58).disabled.toString())})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-create-wallet",[],null,null,null,t,m)),r["ɵdid"](114688,null,0,s.a,[d.R,o.a,p.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("Aq/i"),r=e("/oeL"),o=e("bm2B"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("kRbD"),p=e("cP51");e.d(l,"a",function(){return v});var f=[i.a],m=r["ɵcrt"]({encapsulation:0,styles:f,data:{}}),v=r["ɵccf"]("app-create-wallet",s.a,u,{},{},[])},sxGq:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[r["ɵqud"](402653184,1,{qr:0}),(n()(),r["ɵeld"](0,[[1,0],["qr",1]],null,0,"div",[],null,null,null,null,null)),(n()(),r["ɵted"](null,["\n\n"]))],null,null)}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-qr-code",[],null,null,null,t,c)),r["ɵdid"](114688,null,0,o.a,[a._103,r.ElementRef],null,null)],function(n,l){n(l,1,0)},null)}var i=e("mZbS"),r=e("/oeL"),o=e("Qm7a"),a=e("Z04r");e.d(l,"a",function(){return s});var d=[i.a],c=r["ɵcrt"]({encapsulation:0,styles:d,data:{}}),s=r["ɵccf"]("app-qr-code",o.a,u,{},{},[])},"uv+1":function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=["a[_ngcontent-%COMP%]{float:right}"]},vXTO:function(n,l,e){"use strict";function t(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,3,"md-option",[["class","mat-option"],["role","option"]],[[1,"tabindex",0],[2,"mat-selected",null],[2,"mat-option-multiple",null],[2,"mat-active",null],[8,"id",0],[1,"aria-selected",0],[1,"aria-disabled",0],[2,"mat-option-disabled",null]],[[null,"click"],[null,"keydown"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==o["ɵnov"](n,2)._selectViaInteraction()&&t}if("keydown"===l){t=!1!==o["ɵnov"](n,2)._handleKeydown(e)&&t}return t},a.s,a.t)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](49152,[[1,4]],0,d._35,[o.ElementRef,o.ChangeDetectorRef,[2,d._36],[2,d.U]],{value:[0,"value"]},null),(n()(),o["ɵted"](0,["\n      ","\n    "]))],function(n,l){n(l,2,0,l.context.$implicit.address)},function(n,l){n(l,0,0,o["ɵnov"](l,2)._getTabIndex(),o["ɵnov"](l,2).selected,o["ɵnov"](l,2).multiple,o["ɵnov"](l,2).active,o["ɵnov"](l,2).id,o["ɵnov"](l,2).selected.toString(),o["ɵnov"](l,2).disabled.toString(),o["ɵnov"](l,2).disabled),n(l,3,0,l.context.$implicit.address)})}function u(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"p",[],null,null,null,null,null)),(n()(),o["ɵted"](null,["Choose an address to generate a BTC deposit link for:"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵeld"](0,null,null,19,"div",[],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==o["ɵnov"](n,4).onSubmit(e)&&t}if("reset"===l){t=!1!==o["ɵnov"](n,4).onReset()&&t}return t},null,null)),o["ɵdid"](540672,null,0,c.i,[[8,null],[8,null]],{form:[0,"form"]},null),o["ɵprd"](2048,null,c.l,null,[c.i]),o["ɵdid"](16384,null,0,c.m,[c.l],null,null),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,13,"md-select",[["class","input-field mat-select"],["formControlName","address"],["placeholder","Select Address"],["role","listbox"]],[[1,"tabindex",0],[1,"aria-label",0],[1,"aria-labelledby",0],[1,"aria-required",0],[1,"aria-disabled",0],[1,"aria-invalid",0],[1,"aria-owns",0],[1,"aria-multiselectable",0],[2,"mat-select-disabled",null],[2,"mat-select-invalid",null],[2,"mat-select-required",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"keydown"],[null,"blur"]],function(n,l,e){var t=!0;if("keydown"===l){t=!1!==o["ɵnov"](n,12)._handleClosedKeydown(e)&&t}if("blur"===l){t=!1!==o["ɵnov"](n,12)._onBlur()&&t}return t},a.u,a.v)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](671744,null,0,c.p,[[3,c.l],[8,null],[8,null],[8,null]],{name:[0,"name"]},null),o["ɵprd"](2048,null,c.j,null,[c.p]),o["ɵdid"](1294336,null,3,d._75,[s.c,o.ChangeDetectorRef,p.e,f.a,o.Renderer2,o.ElementRef,[2,m.b],[2,c.h],[2,c.i],[2,c.j],[8,null],[2,d._66],d.l],{placeholder:[0,"placeholder"]},null),o["ɵqud"](603979776,1,{options:1}),o["ɵqud"](603979776,2,{optionGroups:1}),o["ɵqud"](335544320,3,{customTrigger:0}),o["ɵdid"](16384,null,0,c.q,[c.j],null,null),(n()(),o["ɵted"](1,["\n    "])),(n()(),o["ɵand"](16777216,null,1,2,null,t)),o["ɵdid"](802816,null,0,v.NgForOf,[o.ViewContainerRef,o.TemplateRef,o.IterableDiffers],{ngForOf:[0,"ngForOf"]},null),o["ɵpid"](131072,v.AsyncPipe,[o.ChangeDetectorRef]),(n()(),o["ɵted"](1,["\n  "])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),o["ɵted"](null,["\n  "])),(n()(),o["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==o["ɵnov"](n,28)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.generate()&&t}return t},a.q,a.r)),o["ɵdid"](16384,null,0,d.T,[[2,d.U],o.ElementRef],null,null),o["ɵdid"](180224,null,0,d._38,[f.a,d.a,o.ElementRef,o.Renderer2],null,null),o["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),o["ɵted"](0,["Generate"])),(n()(),o["ɵted"](null,["\n"])),(n()(),o["ɵted"](null,["\n"]))],function(n,l){var e=l.component;n(l,4,0,e.form);n(l,10,0,"address");n(l,12,0,"Select Address"),n(l,19,0,o["ɵunv"](l,19,0,o["ɵnov"](l,20).transform(e.walletService.allAddresses())))},function(n,l){n(l,3,0,o["ɵnov"](l,6).ngClassUntouched,o["ɵnov"](l,6).ngClassTouched,o["ɵnov"](l,6).ngClassPristine,o["ɵnov"](l,6).ngClassDirty,o["ɵnov"](l,6).ngClassValid,o["ɵnov"](l,6).ngClassInvalid,o["ɵnov"](l,6).ngClassPending),n(l,8,1,[o["ɵnov"](l,12).tabIndex,o["ɵnov"](l,12)._ariaLabel,o["ɵnov"](l,12).ariaLabelledby,o["ɵnov"](l,12).required.toString(),o["ɵnov"](l,12).disabled.toString(),o["ɵnov"](l,12)._isErrorState(),o["ɵnov"](l,12)._optionIds,o["ɵnov"](l,12).multiple,o["ɵnov"](l,12).disabled,o["ɵnov"](l,12)._isErrorState(),o["ɵnov"](l,12).required,o["ɵnov"](l,16).ngClassUntouched,o["ɵnov"](l,16).ngClassTouched,o["ɵnov"](l,16).ngClassPristine,o["ɵnov"](l,16).ngClassDirty,o["ɵnov"](l,16).ngClassValid,o["ɵnov"](l,16).ngClassInvalid,o["ɵnov"](l,16).ngClassPending]),n(l,26,0,o["ɵnov"](l,28).disabled?-1:0,o["ɵnov"](l,28).disabled||null,o["ɵnov"](l,28).disabled.toString())})}function i(n){return o["ɵvid"](0,[(n()(),o["ɵeld"](0,null,null,1,"app-add-deposit-address",[],null,null,null,u,_)),o["ɵdid"](114688,null,0,b.a,[h.a,d.R,c.a,g.a],null,null)],function(n,l){n(l,1,0)},null)}var r=e("pCa7"),o=e("/oeL"),a=e("v6Q/"),d=e("Z04r"),c=e("bm2B"),s=e("vVgA"),p=e("z1Gz"),f=e("JYHx"),m=e("UPmf"),v=e("qbdv"),b=e("B4F0"),h=e("cP51"),g=e("ScY5");e.d(l,"a",function(){return x});var y=[r.a],_=o["ɵcrt"]({encapsulation:0,styles:y,data:{}}),x=o["ɵccf"]("app-add-deposit-address",b.a,i,{},{},[])},wQAS:function(n,l,e){"use strict";var t=e("cP51"),u=e("SJy7"),i=e("q+5A"),r=(e.n(i),e("86mp")),o=(e.n(r),e("1Zxj")),a=e("5ERs");e.d(l,"a",function(){return d});var d=function(){function n(n,l,e){this.walletService=n,this.apiService=l,this.blockchainService=e,this.otcEnabled=a.a.otcEnabled}return n.prototype.ngOnInit=function(){var n=this;this.setVersion(),i.IntervalObservable.create(3e3).flatMap(function(){return n.blockchainService.progress()}).takeWhile(function(n){return!n.current||n.current!==n.highest}).subscribe(function(l){n.highest=l.highest,n.current=l.current,n.percentage=n.current&&n.highest?n.current/n.highest*100:0,console.log(l)},function(n){return console.log(n)},function(){return n.completeLoading()})},n.prototype.loading=function(){return!this.current||!this.highest||this.current!=this.highest},n.prototype.completeLoading=function(){this.current=999999999999,this.highest=999999999999,this.walletService.refreshBalances()},n.prototype.setVersion=function(){var n=this;return this.apiService.get("version").subscribe(function(l){return n.version=l.version})},n.ctorParameters=function(){return[{type:t.a},{type:o.a},{type:u.a}]},n}()},xdkH:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,25,"div",[],[[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"submit"],[null,"reset"]],function(n,l,e){var t=!0;if("submit"===l){t=!1!==r["ɵnov"](n,1).onSubmit(e)&&t}if("reset"===l){t=!1!==r["ɵnov"](n,1).onReset()&&t}return t},null,null)),r["ɵdid"](540672,null,0,o.i,[[8,null],[8,null]],{form:[0,"form"]},null),r["ɵprd"](2048,null,o.l,null,[o.i]),r["ɵdid"](16384,null,0,o.m,[o.l],null,null),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,19,"md-input-container",[["class","mat-input-container mat-form-field"]],[[2,"mat-input-invalid",null],[2,"mat-form-field-invalid",null],[2,"mat-focused",null],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"click"]],function(n,l,e){var t=!0;if("click"===l){t=!1!==r["ɵnov"](n,7)._control.focus()&&t}return t},a.o,a.p)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](7389184,null,6,d._65,[r.ElementRef,r.ChangeDetectorRef,[2,d._66]],null,null),r["ɵqud"](335544320,1,{_control:0}),r["ɵqud"](335544320,2,{_placeholderChild:0}),r["ɵqud"](603979776,3,{_errorChildren:1}),r["ɵqud"](603979776,4,{_hintChildren:1}),r["ɵqud"](603979776,5,{_prefixChildren:1}),r["ɵqud"](603979776,6,{_suffixChildren:1}),(n()(),r["ɵted"](1,["\n    "])),(n()(),r["ɵeld"](0,null,1,8,"input",[["class","mat-input-element"],["formControlName","label"],["mdInput",""],["placeholder","Label"]],[[8,"id",0],[8,"placeholder",0],[8,"disabled",0],[8,"required",0],[1,"aria-describedby",0],[1,"aria-invalid",0],[2,"ng-untouched",null],[2,"ng-touched",null],[2,"ng-pristine",null],[2,"ng-dirty",null],[2,"ng-valid",null],[2,"ng-invalid",null],[2,"ng-pending",null]],[[null,"input"],[null,"blur"],[null,"compositionstart"],[null,"compositionend"],[null,"focus"]],function(n,l,e){var t=!0;if("input"===l){t=!1!==r["ɵnov"](n,17)._handleInput(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,17).onTouched()&&t}if("compositionstart"===l){t=!1!==r["ɵnov"](n,17)._compositionStart()&&t}if("compositionend"===l){t=!1!==r["ɵnov"](n,17)._compositionEnd(e.target.value)&&t}if("blur"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!1)&&t}if("focus"===l){t=!1!==r["ɵnov"](n,21)._focusChanged(!0)&&t}if("input"===l){t=!1!==r["ɵnov"](n,21)._onInput()&&t}return t},null,null)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](16384,null,0,o.n,[r.Renderer2,r.ElementRef,[2,o.o]],null,null),r["ɵprd"](1024,null,o.e,function(n){return[n]},[o.n]),r["ɵdid"](671744,null,0,o.p,[[3,o.l],[8,null],[8,null],[2,o.e]],{name:[0,"name"]},null),r["ɵprd"](2048,null,o.j,null,[o.p]),r["ɵdid"](933888,null,0,d._104,[r.ElementRef,r.Renderer2,c.a,[2,o.j],[2,o.h],[2,o.i],[2,d._105]],{placeholder:[0,"placeholder"]},null),r["ɵdid"](16384,null,0,o.q,[o.j],null,null),r["ɵprd"](2048,[[1,4]],d._106,null,[d._104]),(n()(),r["ɵted"](1,["\n  "])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵeld"](0,null,null,7,"div",[["class","button-line"]],null,null,null,null,null)),(n()(),r["ɵted"](null,["\n  "])),(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,31)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.rename()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],null,null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["Rename"])),(n()(),r["ɵted"](null,["\n"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,1,0,l.component.form);n(l,19,0,"label");n(l,21,0,"Label")},function(n,l){n(l,0,0,r["ɵnov"](l,3).ngClassUntouched,r["ɵnov"](l,3).ngClassTouched,r["ɵnov"](l,3).ngClassPristine,r["ɵnov"](l,3).ngClassDirty,r["ɵnov"](l,3).ngClassValid,r["ɵnov"](l,3).ngClassInvalid,r["ɵnov"](l,3).ngClassPending),n(l,5,0,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.errorState,r["ɵnov"](l,7)._control.focused,r["ɵnov"](l,7)._shouldForward("untouched"),r["ɵnov"](l,7)._shouldForward("touched"),r["ɵnov"](l,7)._shouldForward("pristine"),r["ɵnov"](l,7)._shouldForward("dirty"),r["ɵnov"](l,7)._shouldForward("valid"),r["ɵnov"](l,7)._shouldForward("invalid"),r["ɵnov"](l,7)._shouldForward("pending")),n(l,15,1,[r["ɵnov"](l,21).id,r["ɵnov"](l,21).placeholder,r["ɵnov"](l,21).disabled,r["ɵnov"](l,21).required,r["ɵnov"](l,21)._ariaDescribedby||null,r["ɵnov"](l,21).errorState,r["ɵnov"](l,22).ngClassUntouched,r["ɵnov"](l,22).ngClassTouched,r["ɵnov"](l,22).ngClassPristine,r["ɵnov"](l,22).ngClassDirty,r["ɵnov"](l,22).ngClassValid,r["ɵnov"](l,22).ngClassInvalid,r["ɵnov"](l,22).ngClassPending]),n(l,29,0,r["ɵnov"](l,31).disabled?-1:0,r["ɵnov"](l,31).disabled||null,r["ɵnov"](l,31).disabled.toString())})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-change-name",[],null,null,null,t,m)),r["ɵdid"](114688,null,0,s.a,[d._103,d.R,o.a,p.a],null,null)],function(n,l){n(l,1,0)},null)}var i=e("Pdzc"),r=e("/oeL"),o=e("bm2B"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("3VAl"),p=e("cP51");e.d(l,"a",function(){return v});var f=[i.a],m=r["ɵcrt"]({encapsulation:0,styles:f,data:{}}),v=r["ɵccf"]("app-change-name",s.a,u,{},{},[])},xrX6:function(n,l,e){"use strict";e.d(l,"a",function(){return t});var t=[""]},zVgQ:function(n,l,e){"use strict";function t(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,4,"a",[["class","mat-raised-button"],["color","primary"],["md-raised-button",""]],[[1,"tabindex",0],[1,"disabled",0],[1,"aria-disabled",0]],[[null,"click"]],function(n,l,e){var t=!0,u=n.component;if("click"===l){t=!1!==r["ɵnov"](n,2)._haltDisabledEvents(e)&&t}if("click"===l){t=!1!==u.onClick()&&t}return t},a.q,a.r)),r["ɵdid"](16384,null,0,d.T,[[2,d.U],r.ElementRef],null,null),r["ɵdid"](180224,null,0,d._38,[c.a,d.a,r.ElementRef,r.Renderer2],{color:[0,"color"]},null),r["ɵdid"](16384,null,0,d._107,[],null,null),(n()(),r["ɵted"](0,["Back"])),(n()(),r["ɵted"](null,["\n"]))],function(n,l){n(l,2,0,"primary")},function(n,l){n(l,0,0,r["ɵnov"](l,2).disabled?-1:0,r["ɵnov"](l,2).disabled||null,r["ɵnov"](l,2).disabled.toString())})}function u(n){return r["ɵvid"](0,[(n()(),r["ɵeld"](0,null,null,1,"app-back-button",[],null,null,null,t,f)),r["ɵdid"](49152,null,0,o.a,[s.Location],null,null)],null,null)}var i=e("uv+1"),r=e("/oeL"),o=e("/Tz7"),a=e("v6Q/"),d=e("Z04r"),c=e("JYHx"),s=e("qbdv");e.d(l,"b",function(){return f}),l.a=t;var p=[i.a],f=r["ɵcrt"]({encapsulation:0,styles:p,data:{}});r["ɵccf"]("app-back-button",o.a,u,{},{},[])}},[1]);
\ No newline at end of file
@@ -4,6 +4,7 @@ import { Observable } from 'rxjs/Observable';
 import 'rxjs/add/observable/throw';
 import 'rxjs/add/operator/catch';
 import 'rxjs/add/operator/map';
+import 'rxjs/add/operator/mergeMap';
 
 @Injectable()
 export class ApiService {
@@ -20,11 +21,20 @@ export class ApiService {
   }
 
   post(url, options = {}) {
-    return this.http.post(this.getUrl(url), this.getQueryString(options), this.returnRequestOptions())
+    return this.getCsrfToken()
+      .flatMap(token => {
+        const requestOptions = this.returnRequestOptions();
+        requestOptions.headers.append('X-CSRF-Token', token);
+        return this.http.post(this.getUrl(url), this.getQueryString(options), requestOptions);
+      })
       .map((res: any) => res.json())
       .catch((error: any) => Observable.throw(error || 'Server error'));
   }
 
+  private getCsrfToken() {
+    return this.get('csrf').map(response => response.csrf_token);
+  }
+
   private getHeaders() {
     const headers = new Headers();
     headers.append('Content-Type', 'application/x-www-form-urlencoded');
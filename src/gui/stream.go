@@ -0,0 +1,547 @@
+package gui
+
+// Event stream of new blocks, unconfirmed transactions, transaction
+// confirmations and address activity, served as server-sent events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/util/droplet"
+	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+const (
+	// streamBufferSize is the number of notifications queued for a stream.  A
+	// stream that falls further behind is closed, and the client resumes it
+	// from the last block seq it received.
+	streamBufferSize = 256
+	// maxStreams limits the number of open streams
+	maxStreams = 100
+	// maxStreamFilters limits the number of txids and of addresses of a stream
+	maxStreamFilters = 1000
+	// maxStreamReplay limits the number of blocks replayed when a stream resumes
+	maxStreamReplay = 1000
+	// streamKeepAlive is how often a comment is sent on an idle stream, so that
+	// proxies don't close it
+	streamKeepAlive = 30 * time.Second
+)
+
+var errTooManyStreams = errors.New("Too many event streams")
+
+// notification is a new block or a new unconfirmed transaction
+type notification struct {
+	block *coin.Block
+	txn   *coin.Transaction
+}
+
+// stream receives the notifications of the hub
+type stream struct {
+	c chan notification
+	// closed when the stream is dropped for falling behind
+	overflow chan struct{}
+}
+
+// eventHub fans out the notifications of the node to the open streams.  It
+// never blocks the node: the notifications are queued for each stream, and a
+// stream whose queue is full is dropped.
+type eventHub struct {
+	mu      sync.Mutex
+	streams map[*stream]struct{}
+
+	// unregister the listeners bound by bind
+	unbind []func()
+
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{
+		streams: make(map[*stream]struct{}),
+		quit:    make(chan struct{}),
+	}
+}
+
+// bind registers the hub as a block and txn listener of the gateway
+func (h *eventHub) bind(gateway Gatewayer) {
+	h.unbind = append(h.unbind, gateway.BindBlockListener(func(b coin.Block) {
+		h.publish(notification{block: &b})
+	}), gateway.BindTxnListener(func(txn coin.Transaction) {
+		h.publish(notification{txn: &txn})
+	}))
+}
+
+func (h *eventHub) subscribe() (*stream, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.streams) >= maxStreams {
+		return nil, errTooManyStreams
+	}
+
+	s := &stream{
+		c:        make(chan notification, streamBufferSize),
+		overflow: make(chan struct{}),
+	}
+	h.streams[s] = struct{}{}
+	return s, nil
+}
+
+func (h *eventHub) unsubscribe(s *stream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.streams, s)
+}
+
+func (h *eventHub) publish(n notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.streams {
+		select {
+		case s.c <- n:
+		default:
+			delete(h.streams, s)
+			close(s.overflow)
+		}
+	}
+}
+
+// close ends the open streams and unregisters the listeners of the hub
+func (h *eventHub) close() {
+	h.closeOnce.Do(func() {
+		close(h.quit)
+		for _, unbind := range h.unbind {
+			unbind()
+		}
+	})
+}
+
+// streamFilter selects the events sent on a stream
+type streamFilter struct {
+	blocks bool
+	txns   bool
+	txids  map[cipher.SHA256]struct{}
+	addrs  map[cipher.Address]struct{}
+	// block seq to resume from, -1 if not set
+	since int64
+}
+
+func parseStreamFilter(r *http.Request) (*streamFilter, error) {
+	f := &streamFilter{
+		txids: make(map[cipher.SHA256]struct{}),
+		addrs: make(map[cipher.Address]struct{}),
+		since: -1,
+	}
+
+	var err error
+	if v := r.FormValue("blocks"); v != "" {
+		if f.blocks, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid blocks value: %v", err)
+		}
+	}
+
+	if v := r.FormValue("txns"); v != "" {
+		if f.txns, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid txns value: %v", err)
+		}
+	}
+
+	for _, v := range splitList(r.FormValue("txids")) {
+		txid, err := cipher.SHA256FromHex(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid txid %s: %v", v, err)
+		}
+		f.txids[txid] = struct{}{}
+	}
+
+	for _, v := range splitList(r.FormValue("addrs")) {
+		addr, err := cipher.DecodeBase58Address(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %v", v, err)
+		}
+		f.addrs[addr] = struct{}{}
+	}
+
+	if len(f.txids) > maxStreamFilters || len(f.addrs) > maxStreamFilters {
+		return nil, fmt.Errorf("at most %d txids and %d addrs can be watched", maxStreamFilters, maxStreamFilters)
+	}
+
+	if !f.blocks && !f.txns && len(f.txids) == 0 && len(f.addrs) == 0 {
+		return nil, errors.New("nothing to subscribe to, set blocks, txns, txids or addrs")
+	}
+
+	// an EventSource that reconnects sends the id of the last event it received
+	since := r.FormValue("since")
+	if since == "" {
+		since = r.Header.Get("Last-Event-ID")
+	}
+	if since != "" {
+		seq, err := strconv.ParseUint(since, 10, 63)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since value: %v", err)
+		}
+		f.since = int64(seq)
+	}
+
+	return f, nil
+}
+
+// splitList splits a comma separated list, ignoring empty items
+func splitList(s string) []string {
+	var items []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+// ConfirmedEvent is sent when a watched transaction is included in a block
+type ConfirmedEvent struct {
+	Txid     string `json:"txid"`
+	BlockSeq uint64 `json:"block_seq"`
+}
+
+// AddressEvent is sent when a transaction spends from or sends to a watched
+// address
+type AddressEvent struct {
+	Address   string `json:"address"`
+	Txid      string `json:"txid"`
+	Confirmed bool   `json:"confirmed"`
+	// seq of the block that contains the transaction, if confirmed
+	BlockSeq uint64 `json:"block_seq,omitempty"`
+	// coins sent from and received by the address
+	Sent     string `json:"sent"`
+	Received string `json:"received"`
+}
+
+// StreamErrorEvent is sent before the stream is closed by the node.  A client
+// should reconnect with since set to Seq.
+type StreamErrorEvent struct {
+	Error string `json:"error"`
+	Seq   uint64 `json:"seq"`
+}
+
+// event is a server-sent event
+type event struct {
+	name string
+	data interface{}
+}
+
+// eventWriter writes the events of a stream
+type eventWriter struct {
+	w       http.ResponseWriter
+	gateway Gatewayer
+	filter  *streamFilter
+}
+
+func (ew *eventWriter) write(id string, e event) error {
+	b, err := json.Marshal(e.data)
+	if err != nil {
+		return err
+	}
+
+	if id != "" {
+		if _, err := fmt.Fprintf(ew.w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(ew.w, "event: %s\ndata: %s\n\n", e.name, b)
+	return err
+}
+
+func (ew *eventWriter) comment(c string) error {
+	_, err := fmt.Fprintf(ew.w, ": %s\n\n", c)
+	return err
+}
+
+func (ew *eventWriter) flush() {
+	if f, ok := ew.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// block writes the events of a new block.  The last event of the block
+// carries the block seq as id, so that a client resumes after the block.
+func (ew *eventWriter) block(b *coin.Block) error {
+	var events []event
+	if ew.filter.blocks {
+		rb, err := visor.NewReadableBlock(b)
+		if err != nil {
+			return err
+		}
+		events = append(events, event{"block", rb})
+	}
+
+	for i := range b.Body.Transactions {
+		txn := &b.Body.Transactions[i]
+		txid := txn.Hash()
+		if _, ok := ew.filter.txids[txid]; ok {
+			events = append(events, event{"confirmed", ConfirmedEvent{
+				Txid:     txid.Hex(),
+				BlockSeq: b.Seq(),
+			}})
+			delete(ew.filter.txids, txid)
+		}
+
+		aes, err := ew.addressEvents(txn, b.Seq())
+		if err != nil {
+			return err
+		}
+		for _, ae := range aes {
+			events = append(events, event{"address", ae})
+		}
+	}
+
+	for i, e := range events {
+		var id string
+		if i == len(events)-1 {
+			id = strconv.FormatUint(b.Seq(), 10)
+		}
+		if err := ew.write(id, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// txn writes the events of a new unconfirmed transaction
+func (ew *eventWriter) txn(txn *coin.Transaction) error {
+	if ew.filter.txns {
+		rt, err := visor.NewReadableTransaction(&visor.Transaction{Txn: *txn})
+		if err != nil {
+			return err
+		}
+		if err := ew.write("", event{"txn", rt}); err != nil {
+			return err
+		}
+	}
+
+	aes, err := ew.addressEvents(txn, 0)
+	if err != nil {
+		return err
+	}
+	for _, ae := range aes {
+		if err := ew.write("", event{"address", ae}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addressEvents returns the activity of the watched addresses in txn.  seq is
+// the seq of the block that contains txn, 0 if txn is unconfirmed.
+func (ew *eventWriter) addressEvents(txn *coin.Transaction, seq uint64) ([]AddressEvent, error) {
+	if len(ew.filter.addrs) == 0 {
+		return nil, nil
+	}
+
+	type activity struct {
+		sent, received uint64
+	}
+	var addrs []cipher.Address
+	acts := make(map[cipher.Address]*activity)
+	get := func(addr cipher.Address) *activity {
+		a, ok := acts[addr]
+		if !ok {
+			a = &activity{}
+			acts[addr] = a
+			addrs = append(addrs, addr)
+		}
+		return a
+	}
+
+	for _, in := range txn.In {
+		// the spent output was created by an earlier block, which is in the history db
+		ux, err := ew.gateway.GetUxOutByID(in)
+		if err != nil {
+			return nil, err
+		}
+		if ux == nil {
+			logger.Warning("Spent output %s not found in the history db", in.Hex())
+			continue
+		}
+		if _, ok := ew.filter.addrs[ux.Out.Body.Address]; ok {
+			get(ux.Out.Body.Address).sent += ux.Out.Body.Coins
+		}
+	}
+
+	for _, out := range txn.Out {
+		if _, ok := ew.filter.addrs[out.Address]; ok {
+			get(out.Address).received += out.Coins
+		}
+	}
+
+	txid := txn.Hash().Hex()
+	aes := make([]AddressEvent, 0, len(addrs))
+	for _, addr := range addrs {
+		sent, err := droplet.ToString(acts[addr].sent)
+		if err != nil {
+			return nil, err
+		}
+		received, err := droplet.ToString(acts[addr].received)
+		if err != nil {
+			return nil, err
+		}
+
+		aes = append(aes, AddressEvent{
+			Address:   addr.String(),
+			Txid:      txid,
+			Confirmed: seq != 0,
+			BlockSeq:  seq,
+			Sent:      sent,
+			Received:  received,
+		})
+	}
+	return aes, nil
+}
+
+// confirmedBefore writes the confirmations of the watched txids that are in
+// the blocks up to seq
+func (ew *eventWriter) confirmedBefore(seq uint64) error {
+	for txid := range ew.filter.txids {
+		txn, err := ew.gateway.GetTransaction(txid)
+		if err != nil {
+			return err
+		}
+		if txn == nil || !txn.Status.Confirmed || txn.Status.BlockSeq > seq {
+			continue
+		}
+
+		if err := ew.write("", event{"confirmed", ConfirmedEvent{
+			Txid:     txid.Hex(),
+			BlockSeq: txn.Status.BlockSeq,
+		}}); err != nil {
+			return err
+		}
+		delete(ew.filter.txids, txid)
+	}
+	return nil
+}
+
+// eventsHandler streams new blocks, unconfirmed transactions, confirmations of
+// transactions and activity of addresses as server-sent events.  Events
+// derived from a block have the block seq as event id.  A client that
+// reconnects with since, or the Last-Event-ID header, receives the events of
+// the blocks after that seq first; the unconfirmed transactions it missed are
+// not replayed.  A client that can't keep up is disconnected with an error
+// event, and resumes from the seq in the event.
+// method: GET
+// url: /events?blocks=[:bool]&txns=[:bool]&txids=[:txids]&addrs=[:addrs]&since=[:seq]
+// events: block, txn, confirmed, address, error
+func eventsHandler(gateway Gatewayer, hub *eventHub) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
+		filter, err := parseStreamFilter(r)
+		if err != nil {
+			wh.Error400(w, err.Error())
+			return
+		}
+
+		// subscribe before reading the head, so that no block is missed
+		s, err := hub.subscribe()
+		if err != nil {
+			wh.HTTPError(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		defer hub.unsubscribe(s)
+
+		head := gateway.GetParsedHeight()
+		since := filter.since
+		if since < 0 {
+			since = head
+		}
+		if head-since > maxStreamReplay {
+			wh.Error400(w, fmt.Sprintf("can't resume more than %d blocks back, get the blocks from /blocks", maxStreamReplay))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		ew := &eventWriter{
+			w:       w,
+			gateway: gateway,
+			filter:  filter,
+		}
+
+		last := uint64(0)
+		if since > 0 {
+			last = uint64(since)
+		}
+		if err := ew.confirmedBefore(last); err != nil {
+			logger.Error("Event stream: %v", err)
+			return
+		}
+
+		for seq := last + 1; int64(seq) <= head; seq++ {
+			b, ok := gateway.GetBlockBySeq(seq)
+			if !ok {
+				logger.Error("Event stream: block %d not found", seq)
+				return
+			}
+			if err := ew.block(&b.Block); err != nil {
+				logger.Debug("Event stream: %v", err)
+				return
+			}
+			last = seq
+		}
+		ew.flush()
+
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case n := <-s.c:
+				switch {
+				case n.block != nil:
+					if n.block.Seq() <= last {
+						continue
+					}
+					err = ew.block(n.block)
+					last = n.block.Seq()
+				case n.txn != nil:
+					err = ew.txn(n.txn)
+				}
+			case <-s.overflow:
+				ew.write("", event{"error", StreamErrorEvent{ // nolint: errcheck
+					Error: "stream fell behind",
+					Seq:   last,
+				}})
+				ew.flush()
+				return
+			case <-keepAlive.C:
+				err = ew.comment("keep-alive")
+			case <-r.Context().Done():
+				return
+			case <-hub.quit:
+				return
+			}
+
+			if err != nil {
+				logger.Debug("Event stream: %v", err)
+				return
+			}
+			ew.flush()
+		}
+	}
+}
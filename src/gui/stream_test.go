@@ -0,0 +1,241 @@
+package gui
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/testutil/harness"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+type sseEvent struct {
+	id   string
+	name string
+	data string
+}
+
+// eventReader reads the server-sent events of a stream
+type eventReader struct {
+	t      *testing.T
+	body   io.ReadCloser
+	events chan sseEvent
+}
+
+func openEvents(t *testing.T, url string) *eventReader {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	er := &eventReader{
+		t:      t,
+		body:   resp.Body,
+		events: make(chan sseEvent, 100),
+	}
+
+	go func() {
+		defer close(er.events)
+		var e sseEvent
+		s := bufio.NewScanner(resp.Body)
+		for s.Scan() {
+			line := s.Text()
+			switch {
+			case line == "":
+				er.events <- e
+				e = sseEvent{}
+			case strings.HasPrefix(line, "id: "):
+				e.id = line[len("id: "):]
+			case strings.HasPrefix(line, "event: "):
+				e.name = line[len("event: "):]
+			case strings.HasPrefix(line, "data: "):
+				e.data = line[len("data: "):]
+			}
+		}
+	}()
+
+	return er
+}
+
+// next returns the next event, skipping the events with other names
+func (er *eventReader) next(name string, v interface{}) sseEvent {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case e, ok := <-er.events:
+			require.True(er.t, ok, "stream closed waiting for %s event", name)
+			if e.name != name {
+				continue
+			}
+			require.NoError(er.t, json.Unmarshal([]byte(e.data), v))
+			return e
+		case <-timeout:
+			er.t.Fatalf("timeout waiting for %s event", name)
+		}
+	}
+}
+
+func (er *eventReader) Close() {
+	er.body.Close()
+}
+
+func TestEventStream(t *testing.T) {
+	c := harness.New(t, 1, nil)
+	defer c.Close()
+
+	dir, err := ioutil.TempDir("", "gui")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	mux := NewGUIMux(dir, c.Master().Daemon.Gateway, MuxConfig{})
+	srv := httptest.NewServer(instrument(mux.ServeMux))
+	defer srv.Close()
+	defer mux.Close()
+
+	pub, sec := cipher.GenerateKeyPair()
+	addr := cipher.AddressFromPubKey(pub)
+
+	// new blocks and address activity
+	es := openEvents(t, srv.URL+"/events?blocks=true&addrs="+addr.String())
+	defer es.Close()
+
+	txn, _ := c.FundAddress(addr, 10e6)
+
+	var rb visor.ReadableBlock
+	es.next("block", &rb)
+	require.Equal(t, uint64(1), rb.Head.BkSeq)
+
+	var ae AddressEvent
+	e := es.next("address", &ae)
+	require.Equal(t, "1", e.id)
+	require.Equal(t, AddressEvent{
+		Address:   addr.String(),
+		Txid:      txn.Hash().Hex(),
+		Confirmed: true,
+		BlockSeq:  1,
+		Sent:      "0.000000",
+		Received:  "10.000000",
+	}, ae)
+
+	// a resumed stream replays the blocks after since
+	rs := openEvents(t, srv.URL+"/events?blocks=1&since=0")
+	defer rs.Close()
+	e = rs.next("block", &rb)
+	require.Equal(t, "1", e.id)
+	require.Equal(t, uint64(1), rb.Head.BkSeq)
+
+	// a txn confirmed before the stream opened is reported right away
+	cs := openEvents(t, srv.URL+"/events?txids="+txn.Hash().Hex())
+	defer cs.Close()
+	var ce ConfirmedEvent
+	cs.next("confirmed", &ce)
+	require.Equal(t, ConfirmedEvent{Txid: txn.Hash().Hex(), BlockSeq: 1}, ce)
+
+	// unconfirmed txns and their confirmation
+	txn2 := c.MakeTransaction(0, sec, testutil.MakeAddress(), 4e6)
+	us := openEvents(t, srv.URL+"/events?txns=1&addrs="+addr.String()+"&txids="+txn2.Hash().Hex())
+	defer us.Close()
+
+	c.InjectTransaction(0, txn2)
+
+	var rt visor.ReadableTransaction
+	us.next("txn", &rt)
+	require.Equal(t, txn2.Hash().Hex(), rt.Hash)
+
+	us.next("address", &ae)
+	require.False(t, ae.Confirmed)
+	require.Equal(t, "10.000000", ae.Sent)
+	require.Equal(t, "6.000000", ae.Received)
+
+	c.GenerateBlocks(1)
+	e = us.next("confirmed", &ce)
+	require.Equal(t, ConfirmedEvent{Txid: txn2.Hash().Hex(), BlockSeq: 2}, ce)
+	us.next("address", &ae)
+	require.True(t, ae.Confirmed)
+	require.Equal(t, uint64(2), ae.BlockSeq)
+}
+
+func TestEventHubOverflow(t *testing.T) {
+	h := newEventHub()
+	slow, err := h.subscribe()
+	require.NoError(t, err)
+	fast, err := h.subscribe()
+	require.NoError(t, err)
+
+	b := &coin.Block{}
+	for i := 0; i < streamBufferSize; i++ {
+		h.publish(notification{block: b})
+		<-fast.c
+	}
+
+	// the slow stream is dropped instead of blocking the hub
+	h.publish(notification{block: b})
+	<-fast.c
+	<-slow.overflow
+	require.Len(t, h.streams, 1)
+
+	h.unsubscribe(fast)
+	require.Len(t, h.streams, 0)
+}
+
+func TestGUIMuxClose(t *testing.T) {
+	gateway := NewGatewayerMock()
+	unbound := 0
+	gateway.On("BindBlockListener", mock.Anything).Return(func() { unbound++ })
+	gateway.On("BindTxnListener", mock.Anything).Return(func() { unbound++ })
+
+	mux1 := NewGUIMux("", gateway, MuxConfig{})
+	mux2 := NewGUIMux("", gateway, MuxConfig{})
+
+	mux1.Close()
+	mux1.Close()
+	require.Equal(t, 2, unbound)
+
+	// the streams of the other mux are still open
+	select {
+	case <-mux2.streams.quit:
+		t.Fatal("closing a mux closed the streams of another one")
+	default:
+	}
+
+	mux2.Close()
+	require.Equal(t, 4, unbound)
+	<-mux2.streams.quit
+}
+
+func TestEventStreamBadRequest(t *testing.T) {
+	cases := []struct {
+		query string
+		msg   string
+	}{
+		{"", "nothing to subscribe to"},
+		{"blocks=x", "invalid blocks value"},
+		{"txids=abc", "invalid txid abc"},
+		{"addrs=abc", "invalid address abc"},
+		{"blocks=1&since=-1", "invalid since value"},
+	}
+
+	h := newEventHub()
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/events?"+tc.query, nil)
+			eventsHandler(nil, h)(w, r)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), tc.msg)
+		})
+	}
+}
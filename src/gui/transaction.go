@@ -8,14 +8,13 @@ import (
 
 	"github.com/skycoin/skycoin/src/cipher"
 	"github.com/skycoin/skycoin/src/coin"
-	"github.com/skycoin/skycoin/src/daemon"
 	"github.com/skycoin/skycoin/src/visor"
 
 	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
 )
 
 // RegisterTxHandlers registers transaction handlers
-func RegisterTxHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
+func RegisterTxHandlers(mux Mux, gateway Gatewayer) {
 	// get set of pending transactions
 	mux.HandleFunc("/pendingTxs", getPendingTxs(gateway))
 	// get latest confirmed transactions
@@ -30,7 +29,7 @@ func RegisterTxHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
 }
 
 // Returns pending transactions
-func getPendingTxs(gateway *daemon.Gateway) http.HandlerFunc {
+func getPendingTxs(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -55,7 +54,7 @@ func getPendingTxs(gateway *daemon.Gateway) http.HandlerFunc {
 
 // DEPRECATED: last txs can't recover from db when restart
 // , and it's not used actually
-func getLastTxs(gateway *daemon.Gateway) http.HandlerFunc {
+func getLastTxs(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -87,7 +86,7 @@ func getLastTxs(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func getTransactionByID(gate *daemon.Gateway) http.HandlerFunc {
+func getTransactionByID(gate Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -131,7 +130,7 @@ func getTransactionByID(gate *daemon.Gateway) http.HandlerFunc {
 }
 
 //Implement
-func injectTransaction(gateway *daemon.Gateway) http.HandlerFunc {
+func injectTransaction(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			wh.Error405(w)
@@ -155,7 +154,12 @@ func injectTransaction(gateway *daemon.Gateway) http.HandlerFunc {
 			return
 		}
 
-		txn := coin.TransactionDeserialize(b)
+		txn, err := coin.TransactionDeserialize(b)
+		if err != nil {
+			wh.Error400(w, err.Error())
+			return
+		}
+
 		if err := gateway.InjectTransaction(txn); err != nil {
 			wh.Error400(w, fmt.Sprintf("inject tx failed:%v", err))
 			return
@@ -165,22 +169,24 @@ func injectTransaction(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func resendUnconfirmedTxns(gate *daemon.Gateway) http.HandlerFunc {
+func resendUnconfirmedTxns(gate Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
+		if r.Method != http.MethodPost {
 			wh.Error405(w)
 			return
 		}
 
-		rlt := gate.ResendUnconfirmedTxns()
-		v, _ := json.MarshalIndent(rlt, "", "    ")
-		fmt.Println(v)
+		rlt, err := gate.ResendUnconfirmedTxns()
+		if err != nil {
+			logger.Error("resend unconfirmed txns failed: %v", err)
+			wh.Error500(w)
+			return
+		}
 		wh.SendOr404(w, rlt)
-		return
 	}
 }
 
-func getRawTx(gate *daemon.Gateway) http.HandlerFunc {
+func getRawTx(gate Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
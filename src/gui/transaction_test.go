@@ -0,0 +1,214 @@
+package gui
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/visor"
+)
+
+func TestTxHandlers(t *testing.T) {
+	txn := makeTransaction(t)
+	txid := txn.Hash()
+	rawtx := hex.EncodeToString(txn.Serialize())
+
+	unconfirmed := visor.UnconfirmedTxn{Txn: txn, Received: 1500000000, IsValid: 1}
+	rpending, err := visor.NewReadableUnconfirmedTxn(&unconfirmed)
+	require.NoError(t, err)
+
+	tx := &visor.Transaction{Txn: txn, Status: visor.NewConfirmedTransactionStatus(2, 5)}
+	rtx, err := visor.NewReadableTransaction(tx)
+	require.NoError(t, err)
+	result := visor.TransactionResult{Transaction: *rtx, Status: tx.Status}
+
+	_, errHash := cipher.SHA256FromHex("abc")
+	_, errHex := hex.DecodeString("zz")
+	_, errDeserialize := coin.TransactionDeserialize([]byte{0})
+
+	testHandlers(t, []handlerCase{
+		{
+			name: "pending",
+			path: "/api/v1/pending_txs",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAllUnconfirmedTxns").Return([]visor.UnconfirmedTxn{unconfirmed})
+			},
+			status: http.StatusOK,
+			rsp:    []*visor.ReadableUnconfirmedTxn{rpending},
+		},
+		{
+			name: "no pending",
+			path: "/api/v1/pending_txs",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAllUnconfirmedTxns").Return(nil)
+			},
+			status: http.StatusOK,
+			rsp:    []*visor.ReadableUnconfirmedTxn{},
+		},
+		{
+			name: "last txs",
+			path: "/lastTxs",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetLastTxs").Return([]*visor.Transaction{tx}, nil)
+			},
+			status: http.StatusOK,
+			rsp:    []visor.TransactionResult{result},
+		},
+		{
+			name: "last txs error",
+			path: "/lastTxs",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetLastTxs").Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name:   "transaction without txid",
+			path:   "/api/v1/transaction",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - txid is empty",
+		},
+		{
+			name:   "transaction invalid txid",
+			path:   "/api/v1/transaction?txid=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - " + errHash.Error(),
+		},
+		{
+			name: "transaction error",
+			path: "/api/v1/transaction?txid=" + txid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetTransaction", txid).Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - db closed",
+		},
+		{
+			name: "transaction not found",
+			path: "/api/v1/transaction?txid=" + txid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetTransaction", txid).Return(nil, nil)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name: "transaction",
+			path: "/api/v1/transaction?txid=" + txid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetTransaction", txid).Return(tx, nil)
+			},
+			status: http.StatusOK,
+			rsp:    result,
+		},
+		{
+			name:   "inject method",
+			path:   "/api/v1/inject_transaction",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name:   "inject without body",
+			method: http.MethodPost,
+			path:   "/api/v1/inject_transaction",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - EOF",
+		},
+		{
+			name:   "inject invalid hex",
+			method: http.MethodPost,
+			path:   "/api/v1/inject_transaction",
+			body:   `{"rawtx": "zz"}`,
+			status: http.StatusBadRequest,
+			err:    "Bad Request - " + errHex.Error(),
+		},
+		{
+			name:   "inject invalid transaction",
+			method: http.MethodPost,
+			path:   "/api/v1/inject_transaction",
+			body:   `{"rawtx": "00"}`,
+			status: http.StatusBadRequest,
+			err:    "Bad Request - " + errDeserialize.Error(),
+		},
+		{
+			name:   "inject rejected",
+			method: http.MethodPost,
+			path:   "/api/v1/inject_transaction",
+			body:   `{"rawtx": "` + rawtx + `"}`,
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("InjectTransaction", mock.AnythingOfType("coin.Transaction")).Return(errors.New("double spend"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - inject tx failed:double spend",
+		},
+		{
+			name:   "inject",
+			method: http.MethodPost,
+			path:   "/api/v1/inject_transaction",
+			body:   `{"rawtx": "` + rawtx + `"}`,
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("InjectTransaction", mock.AnythingOfType("coin.Transaction")).Return(nil)
+			},
+			status: http.StatusOK,
+			rsp:    txid.Hex(),
+		},
+		{
+			name:   "resend method",
+			path:   "/api/v1/resend_unconfirmed_txns",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name:   "resend",
+			method: http.MethodPost,
+			path:   "/api/v1/resend_unconfirmed_txns",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("ResendUnconfirmedTxns").Return(&daemon.ResendResult{Txids: []string{txid.Hex()}}, nil)
+			},
+			status: http.StatusOK,
+			rsp:    daemon.ResendResult{Txids: []string{txid.Hex()}},
+		},
+		{
+			name:   "resend while shutting down",
+			method: http.MethodPost,
+			path:   "/api/v1/resend_unconfirmed_txns",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("ResendUnconfirmedTxns").Return(nil, daemon.ErrDaemonClosed)
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name:   "raw transaction without txid",
+			path:   "/api/v1/rawtx",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - txid is empty",
+		},
+		{
+			name: "raw transaction not found",
+			path: "/api/v1/rawtx?txid=" + txid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetTransaction", txid).Return(nil, nil)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name: "raw transaction",
+			path: "/api/v1/rawtx?txid=" + txid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetTransaction", txid).Return(tx, nil)
+			},
+			status: http.StatusOK,
+			rsp:    rawtx,
+		},
+	})
+}
@@ -4,20 +4,19 @@ import (
 	"net/http"
 
 	"github.com/skycoin/skycoin/src/cipher"
-	"github.com/skycoin/skycoin/src/daemon"
 	wh "github.com/skycoin/skycoin/src/util/http" //http,json helpers
 	"github.com/skycoin/skycoin/src/visor/historydb"
 )
 
 // RegisterUxOutHandlers binds uxout entries.
-func RegisterUxOutHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
+func RegisterUxOutHandlers(mux Mux, gateway Gatewayer) {
 	// get uxout by id.
 	mux.HandleFunc("/uxout", getUxOutByID(gateway))
 	// get all the address affected uxouts.
 	mux.HandleFunc("/address_uxouts", getAddrUxOuts(gateway))
 }
 
-func getUxOutByID(gateway *daemon.Gateway) http.HandlerFunc {
+func getUxOutByID(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -51,7 +50,7 @@ func getUxOutByID(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func getAddrUxOuts(gateway *daemon.Gateway) http.HandlerFunc {
+func getAddrUxOuts(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
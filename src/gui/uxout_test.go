@@ -0,0 +1,104 @@
+package gui
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+)
+
+func TestUxOutHandlers(t *testing.T) {
+	addr := testutil.MakeAddress()
+	ux := &historydb.UxOut{
+		Out: coin.UxOut{
+			Head: coin.UxHead{Time: 1500000000, BkSeq: 2},
+			Body: coin.UxBody{
+				SrcTransaction: cipher.SumSHA256([]byte("txn")),
+				Address:        addr,
+				Coins:          1e6,
+				Hours:          10,
+			},
+		},
+	}
+	uxid := ux.Hash()
+	uxs := []*historydb.UxOutJSON{historydb.NewUxOutJSON(ux)}
+
+	_, errHash := cipher.SHA256FromHex("abc")
+	_, errAddr := cipher.DecodeBase58Address("abc")
+
+	testHandlers(t, []handlerCase{
+		{
+			name:   "uxout without uxid",
+			path:   "/api/v1/uxout",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - uxid is empty",
+		},
+		{
+			name:   "uxout invalid uxid",
+			path:   "/api/v1/uxout?uxid=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - " + errHash.Error(),
+		},
+		{
+			name: "uxout error",
+			path: "/api/v1/uxout?uxid=" + uxid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUxOutByID", uxid).Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - db closed",
+		},
+		{
+			name: "uxout not found",
+			path: "/api/v1/uxout?uxid=" + uxid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUxOutByID", uxid).Return(nil, nil)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found",
+		},
+		{
+			name: "uxout",
+			path: "/api/v1/uxout?uxid=" + uxid.Hex(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetUxOutByID", uxid).Return(ux, nil)
+			},
+			status: http.StatusOK,
+			rsp:    uxs[0],
+		},
+		{
+			name:   "address uxouts without address",
+			path:   "/api/v1/address_uxouts",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - address is empty",
+		},
+		{
+			name:   "address uxouts invalid address",
+			path:   "/api/v1/address_uxouts?address=abc",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - " + errAddr.Error(),
+		},
+		{
+			name: "address uxouts error",
+			path: "/api/v1/address_uxouts?address=" + addr.String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddrUxOuts", addr).Return(nil, errors.New("db closed"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - db closed",
+		},
+		{
+			name: "address uxouts",
+			path: "/api/v1/address_uxouts?address=" + addr.String(),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetAddrUxOuts", addr).Return(uxs, nil)
+			},
+			status: http.StatusOK,
+			rsp:    uxs,
+		},
+	})
+}
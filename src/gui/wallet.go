@@ -10,7 +10,6 @@ import (
 	"github.com/skycoin/skycoin/src/cipher"
 	bip39 "github.com/skycoin/skycoin/src/cipher/go-bip39"
 	"github.com/skycoin/skycoin/src/coin"
-	"github.com/skycoin/skycoin/src/daemon"
 	"github.com/skycoin/skycoin/src/visor"
 	"github.com/skycoin/skycoin/src/wallet"
 
@@ -25,7 +24,7 @@ type SpendResult struct {
 }
 
 // Spend spend coins from specific wallet
-func Spend(gateway *daemon.Gateway,
+func Spend(gateway Gatewayer,
 	walletID string,
 	amt wallet.Balance,
 	dest cipher.Address) *SpendResult {
@@ -75,7 +74,7 @@ func Spend(gateway *daemon.Gateway,
 
 // Returns the wallet's balance, both confirmed and predicted.  The predicted
 // balance is the confirmed balance minus the pending spends.
-func walletBalanceHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func walletBalanceHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -90,7 +89,7 @@ func walletBalanceHandler(gateway *daemon.Gateway) http.HandlerFunc {
 
 		b, err := gateway.GetWalletBalance(wltID)
 		if err != nil {
-			logger.Error("Get wallet balance failed: %v", err)
+			wh.Error400(w, fmt.Sprintf("get wallet balance failed: %v", err))
 			return
 		}
 		wh.SendOr404(w, b)
@@ -105,7 +104,7 @@ func walletBalanceHandler(gateway *daemon.Gateway) http.HandlerFunc {
 //  id: wallet id
 //	dst: recipient address
 // 	coins: the number of droplet you will send
-func walletSpendHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func walletSpendHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			wh.Error405(w)
@@ -146,6 +145,8 @@ func walletSpendHandler(gateway *daemon.Gateway) http.HandlerFunc {
 		ret := Spend(gateway, wltID, wallet.NewBalance(coins, hours), dst)
 		if ret.Error != "" {
 			logger.Error(ret.Error)
+			wh.Error400(w, ret.Error)
+			return
 		}
 
 		wh.SendOr404(w, ret)
@@ -153,8 +154,13 @@ func walletSpendHandler(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // Create a wallet Name is set by creation date
-func walletCreate(gateway *daemon.Gateway) http.HandlerFunc {
+func walletCreate(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			wh.Error405(w)
+			return
+		}
+
 		seed := r.FormValue("seed")
 		label := r.FormValue("label")
 
@@ -191,12 +197,17 @@ func walletCreate(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
+// NewAddressesResponse is the response of /wallet/newAddress
+type NewAddressesResponse struct {
+	Addresses []string `json:"addresses"`
+}
+
 // method: POST
 // url: /wallet/newAddress
 // params:
 // 		id: wallet id
 // 	   num: number of address need to create, if not set the default value is 1
-func walletNewAddresses(gateway *daemon.Gateway) http.HandlerFunc {
+func walletNewAddresses(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			wh.Error405(w)
@@ -227,12 +238,9 @@ func walletNewAddresses(gateway *daemon.Gateway) http.HandlerFunc {
 			return
 		}
 
-		var rlt = struct {
-			Address []string `json:"addresses"`
-		}{}
-
+		var rlt NewAddressesResponse
 		for _, a := range addrs {
-			rlt.Address = append(rlt.Address, a.String())
+			rlt.Addresses = append(rlt.Addresses, a.String())
 		}
 
 		wh.SendOr404(w, rlt)
@@ -241,8 +249,13 @@ func walletNewAddresses(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // Update wallet label
-func walletUpdateHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func walletUpdateHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			wh.Error405(w)
+			return
+		}
+
 		// Update wallet
 		wltID := r.FormValue("id")
 		if wltID == "" {
@@ -266,7 +279,7 @@ func walletUpdateHandler(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // Returns a wallet by id
-func walletGet(gateway *daemon.Gateway) http.HandlerFunc {
+func walletGet(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -281,7 +294,7 @@ func walletGet(gateway *daemon.Gateway) http.HandlerFunc {
 
 		wlt, ok := gateway.GetWallet(wltID)
 		if !ok {
-			wh.Error400(w, fmt.Sprintf("wallet %s doesn't exist", wltID))
+			wh.HTTPError(w, http.StatusNotFound, fmt.Sprintf("Not Found - wallet %s doesn't exist", wltID))
 			return
 		}
 
@@ -290,7 +303,7 @@ func walletGet(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // Returns JSON of unconfirmed transactions for user's wallet
-func walletTransactionsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func walletTransactionsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			wh.Error405(w)
@@ -314,16 +327,26 @@ func walletTransactionsHandler(gateway *daemon.Gateway) http.HandlerFunc {
 }
 
 // Returns all loaded wallets
-func walletsHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func walletsHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		wlts := gateway.GetWallets().ToReadable()
 		wh.SendOr404(w, wlts)
 	}
 }
 
 // Loads/unloads wallets from the wallet directory
-func walletsReloadHandler(gateway *daemon.Gateway) http.HandlerFunc {
+func walletsReloadHandler(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			wh.Error405(w)
+			return
+		}
+
 		if err := gateway.ReloadWallets(); err != nil {
 			logger.Error("reload wallet failed: %v", err)
 			wh.Error500(w)
@@ -340,8 +363,13 @@ type WalletFolder struct {
 }
 
 // Loads/unloads wallets from the wallet directory
-func getWalletFolder(gateway *daemon.Gateway) http.HandlerFunc {
+func getWalletFolder(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		ret := WalletFolder{
 			Address: gateway.GetWalletDir(),
 		}
@@ -349,8 +377,18 @@ func getWalletFolder(gateway *daemon.Gateway) http.HandlerFunc {
 	}
 }
 
-func newWalletSeed(gateway *daemon.Gateway) http.HandlerFunc {
+// NewSeedResponse is the response of /wallet/newSeed
+type NewSeedResponse struct {
+	Seed string `json:"seed"`
+}
+
+func newWalletSeed(gateway Gatewayer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			wh.Error405(w)
+			return
+		}
+
 		entropy, err := bip39.NewEntropy(128)
 		if err != nil {
 			logger.Error("new entropy failed when new wallet seed: %v", err)
@@ -365,18 +403,12 @@ func newWalletSeed(gateway *daemon.Gateway) http.HandlerFunc {
 			return
 		}
 
-		var rlt = struct {
-			Seed string `json:"seed"`
-		}{
-			mnemonic,
-		}
-
-		wh.SendOr404(w, rlt)
+		wh.SendOr404(w, NewSeedResponse{Seed: mnemonic})
 	}
 }
 
 // RegisterWalletHandlers registers wallet handlers
-func RegisterWalletHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
+func RegisterWalletHandlers(mux Mux, gateway Gatewayer) {
 	// Returns wallet info
 	// GET Arguments:
 	//      id - Wallet ID.
@@ -384,8 +416,9 @@ func RegisterWalletHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
 	//  Gets a wallet .  Will be assigned name if present.
 	mux.HandleFunc("/wallet", walletGet(gateway))
 
-	// POST/GET Arguments:
-	//		seed [optional]
+	// POST Arguments:
+	//		seed
+	//		label
 	//create new wallet
 	mux.HandleFunc("/wallet/create", walletCreate(gateway))
 
@@ -414,7 +447,7 @@ func RegisterWalletHandlers(mux *http.ServeMux, gateway *daemon.Gateway) {
 	mux.HandleFunc("/wallet/transactions", walletTransactionsHandler(gateway))
 
 	// Update wallet label
-	// 		GET Arguments:
+	// 		POST Arguments:
 	// 			id: wallet id
 	// 			label: wallet label
 	mux.HandleFunc("/wallet/update", walletUpdateHandler(gateway))
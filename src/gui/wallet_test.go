@@ -0,0 +1,383 @@
+package gui
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+func TestWalletHandlers(t *testing.T) {
+	w, err := wallet.NewWallet("test.wlt", wallet.OptSeed("seed"), wallet.OptLabel("label"))
+	require.NoError(t, err)
+	wlt := *w
+
+	bal := wallet.BalancePair{
+		Confirmed: wallet.NewBalance(10e6, 100),
+		Predicted: wallet.NewBalance(9e6, 90),
+	}
+
+	txn := makeTransaction(t)
+	rtx, err := visor.NewReadableTransaction(&visor.Transaction{Txn: txn})
+	require.NoError(t, err)
+	unconfirmed := []visor.UnconfirmedTxnResult{{
+		UnconfirmedTxn: visor.UnconfirmedTxn{Txn: txn, Received: 1500000000, IsValid: 1},
+		Status:         visor.NewUnconfirmedTransactionStatus(true),
+	}}
+
+	addr := testutil.MakeAddress()
+	_, errAddr := cipher.DecodeBase58Address("abc")
+	spend := func(coins string) url.Values {
+		return url.Values{"id": {"test.wlt"}, "dst": {addr.String()}, "coins": {coins}}
+	}
+
+	testHandlers(t, []handlerCase{
+		{
+			name:   "wallet without id",
+			path:   "/api/v1/wallet",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing wallet id",
+		},
+		{
+			name: "wallet not found",
+			path: "/api/v1/wallet?id=missing.wlt",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWallet", "missing.wlt").Return(wallet.Wallet{}, false)
+			},
+			status: http.StatusNotFound,
+			err:    "Not Found - wallet missing.wlt doesn't exist",
+		},
+		{
+			name: "wallet",
+			path: "/api/v1/wallet?id=test.wlt",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWallet", "test.wlt").Return(wlt, true)
+			},
+			status: http.StatusOK,
+			rsp:    wlt,
+		},
+		{
+			name:   "create method",
+			path:   "/api/v1/wallet/create",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name:   "create without seed",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/create",
+			form:   url.Values{"label": {"label"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing seed",
+		},
+		{
+			name:   "create without label",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/create",
+			form:   url.Values{"seed": {"seed"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing label",
+		},
+		{
+			name:   "create error",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/create",
+			form:   url.Values{"seed": {"seed"}, "label": {"label"}},
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("NewWallet", mock.Anything, mock.Anything).Return(wallet.Wallet{}, errors.New("seed already used"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - seed already used",
+		},
+		{
+			name:   "create renamed",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/create",
+			form:   url.Values{"seed": {"seed"}, "label": {"label"}},
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("NewWallet", mock.Anything, mock.Anything).Return(wallet.Wallet{}, errors.New("wallet exists, renaming")).Once()
+				gateway.On("NewWallet", mock.Anything, mock.Anything).Return(wlt, nil).Once()
+			},
+			status: http.StatusOK,
+			rsp:    wallet.NewReadableWallet(wlt),
+		},
+		{
+			name:   "new addresses without id",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/new_address",
+			form:   url.Values{"num": {"2"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing wallet id",
+		},
+		{
+			name:   "new addresses invalid num",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/new_address",
+			form:   url.Values{"id": {"test.wlt"}, "num": {"x"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - invalid num value",
+		},
+		{
+			name:   "new addresses error",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/new_address",
+			form:   url.Values{"id": {"missing.wlt"}},
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("NewAddresses", "missing.wlt", 1).Return(nil, errors.New("wallet doesn't exist"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - wallet doesn't exist",
+		},
+		{
+			name:   "new addresses",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/new_address",
+			form:   url.Values{"id": {"test.wlt"}, "num": {"1"}},
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("NewAddresses", "test.wlt", 1).Return([]cipher.Address{addr}, nil)
+			},
+			status: http.StatusOK,
+			rsp:    NewAddressesResponse{Addresses: []string{addr.String()}},
+		},
+		{
+			name:   "balance without id",
+			path:   "/api/v1/wallet/balance",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing wallet id",
+		},
+		{
+			name: "balance error",
+			path: "/api/v1/wallet/balance?id=missing.wlt",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWalletBalance", "missing.wlt").Return(wallet.BalancePair{}, errors.New("wallet doesn't exist"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - get wallet balance failed: wallet doesn't exist",
+		},
+		{
+			name: "balance",
+			path: "/api/v1/wallet/balance?id=test.wlt",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWalletBalance", "test.wlt").Return(bal, nil)
+			},
+			status: http.StatusOK,
+			rsp:    bal,
+		},
+		{
+			name:   "spend method",
+			path:   "/api/v1/wallet/spend",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name:   "spend without id",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   url.Values{"dst": {addr.String()}, "coins": {"1000000"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing wallet id",
+		},
+		{
+			name:   "spend without destination",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   url.Values{"id": {"test.wlt"}, "coins": {"1000000"}},
+			status: http.StatusBadRequest,
+			err:    `Bad Request - missing destination address "dst"`,
+		},
+		{
+			name:   "spend invalid destination",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   url.Values{"id": {"test.wlt"}, "dst": {"abc"}, "coins": {"1000000"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - invalid destination address: " + errAddr.Error(),
+		},
+		{
+			name:   "spend invalid coins",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   spend("1.5"),
+			status: http.StatusBadRequest,
+			err:    `Bad Request - invalid "coins" value`,
+		},
+		{
+			name:   "spend no coins",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   spend("0"),
+			status: http.StatusBadRequest,
+			err:    `Bad Request - invalid "coins" value, must > 0`,
+		},
+		{
+			name:   "spend error",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   spend("1000000"),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("Spend", "test.wlt", wallet.NewBalance(1e6, 0), addr).Return(nil, errors.New("balance is not sufficient"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - balance is not sufficient",
+		},
+		{
+			name:   "spend balance error",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   spend("1000000"),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("Spend", "test.wlt", wallet.NewBalance(1e6, 0), addr).Return(&txn, nil)
+				gateway.On("GetWalletBalance", "test.wlt").Return(wallet.BalancePair{}, errors.New("wallet doesn't exist"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - Get wallet balance failed: wallet doesn't exist",
+		},
+		{
+			name:   "spend",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/spend",
+			form:   spend("1000000"),
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("Spend", "test.wlt", wallet.NewBalance(1e6, 0), addr).Return(&txn, nil)
+				gateway.On("GetWalletBalance", "test.wlt").Return(bal, nil)
+			},
+			status: http.StatusOK,
+			rsp:    SpendResult{Balance: &bal, Transaction: rtx},
+		},
+		{
+			name:   "transactions without id",
+			path:   "/api/v1/wallet/transactions",
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing wallet id",
+		},
+		{
+			name: "transactions error",
+			path: "/api/v1/wallet/transactions?id=missing.wlt",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWalletUnconfirmedTxns", "missing.wlt").Return(nil, errors.New("wallet doesn't exist"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - get wallet unconfirmed transactions failed: wallet doesn't exist",
+		},
+		{
+			name: "transactions",
+			path: "/api/v1/wallet/transactions?id=test.wlt",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWalletUnconfirmedTxns", "test.wlt").Return(unconfirmed, nil)
+			},
+			status: http.StatusOK,
+			rsp:    unconfirmed,
+		},
+		{
+			name:   "update without id",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/update",
+			form:   url.Values{"label": {"new"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing wallet id",
+		},
+		{
+			name:   "update without label",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/update",
+			form:   url.Values{"id": {"test.wlt"}},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - missing label",
+		},
+		{
+			name:   "update error",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/update",
+			form:   url.Values{"id": {"missing.wlt"}, "label": {"new"}},
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("UpdateWalletLabel", "missing.wlt", "new").Return(errors.New("wallet doesn't exist"))
+			},
+			status: http.StatusBadRequest,
+			err:    "Bad Request - update wallet label failed: wallet doesn't exist",
+		},
+		{
+			name:   "update",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/update",
+			form:   url.Values{"id": {"test.wlt"}, "label": {"new"}},
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("UpdateWalletLabel", "test.wlt", "new").Return(nil)
+			},
+			status: http.StatusOK,
+			rsp:    "success",
+		},
+		{
+			name: "wallets",
+			path: "/api/v1/wallets",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWallets").Return(wallet.Wallets{"test.wlt": w})
+			},
+			status: http.StatusOK,
+			rsp:    []*wallet.ReadableWallet{wallet.NewReadableWallet(wlt)},
+		},
+		{
+			name:   "reload method",
+			path:   "/api/v1/wallets/reload",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+		{
+			name:   "reload error",
+			method: http.MethodPost,
+			path:   "/api/v1/wallets/reload",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("ReloadWallets").Return(errors.New("permission denied"))
+			},
+			status: http.StatusInternalServerError,
+			err:    "Internal Server Error",
+		},
+		{
+			name:   "reload",
+			method: http.MethodPost,
+			path:   "/api/v1/wallets/reload",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("ReloadWallets").Return(nil)
+			},
+			status: http.StatusOK,
+			rsp:    "success",
+		},
+		{
+			name: "folder",
+			path: "/api/v1/wallets/folder_name",
+			gateway: func(gateway *GatewayerMock) {
+				gateway.On("GetWalletDir").Return("/home/user/.shellcoin/wallets")
+			},
+			status: http.StatusOK,
+			rsp:    WalletFolder{Address: "/home/user/.shellcoin/wallets"},
+		},
+		{
+			name:   "new seed method",
+			method: http.MethodPost,
+			path:   "/api/v1/wallet/new_seed",
+			status: http.StatusMethodNotAllowed,
+			err:    "Method Not Allowed",
+		},
+	})
+}
+
+func TestNewWalletSeed(t *testing.T) {
+	// the seeds are random, the handler doesn't use the gateway
+	w := httptest.NewRecorder()
+	newWalletSeed(nil).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/wallet/newSeed", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var rsp NewSeedResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&rsp))
+	require.Len(t, strings.Fields(rsp.Seed), 12)
+}
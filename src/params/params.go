@@ -0,0 +1,166 @@
+// Package params defines the parameters of the shellcoin networks
+package params
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ChainParams are the parameters of a network: its genesis block, the key
+// of the blockchain master, the peers and ports to use, and the values that
+// keep the nodes and addresses of different networks apart.
+type ChainParams struct {
+	// Name of the network, selected with -network
+	Name string
+
+	// Address the genesis coins are sent to
+	GenesisAddress string
+	// Signature of the genesis block by the master
+	GenesisSignature string
+	// Timestamp of the genesis block
+	GenesisTimestamp uint64
+	// Number of droplets created in the genesis block
+	GenesisCoinVolume uint64
+
+	// Public key of the blockchain master
+	BlockchainPubkey string
+	// Secret key of the blockchain master, only set for networks that
+	// anyone can create blocks on
+	BlockchainSeckey string
+
+	// Peers to connect to on first start
+	DefaultConnections []string
+	// Host names resolved to bootstrap peers
+	DNSSeeds []string
+
+	// Default port of the peer connections
+	Port int
+	// Default port of the web interface
+	WebInterfacePort int
+	// Default port of the JSON-RPC interface
+	RPCInterfacePort int
+	// Default port of the metrics interface
+	MetricsInterfacePort int
+
+	// Appended to the default data directory, so that the nodes of
+	// different networks on a host don't share a blockchain
+	DataDirSuffix string
+	// Written over the first bytes of every message ID, so that a node
+	// can't talk to the peers of another network
+	MessagePrefix string
+	// Version of the addresses, so that the addresses of one network are
+	// rejected by the others
+	AddressVersion byte
+
+	// Blocks are created on request by the node, which is the master and
+	// doesn't connect to peers.  The genesis block is created from the
+	// master key.
+	Regtest bool
+}
+
+// MainNet is the shellcoin network
+var MainNet = ChainParams{
+	Name: "mainnet",
+
+	GenesisAddress:    "EmQkiYpw14SHHkVFVeMqnPouPERKtvtF1A",
+	GenesisSignature:  "133067c26b92641433dd6be12c3898d14646a07a29fe51547c69f76da0bbfd2973aa48d4cb41c282866c1bda09a979c2ccd9fd53ad8ac98fcbe9033d53bb75eb01",
+	GenesisTimestamp:  1489844528,
+	GenesisCoinVolume: 300e12,
+
+	BlockchainPubkey: "02af0b8addc4e0be5922e98a1d8ebd91cf5f034ccd8756f126f9714507fd178a78",
+
+	DefaultConnections: []string{
+		"120.55.114.17:7100",
+		"97.64.46.87:7100",
+	},
+	DNSSeeds: []string{},
+
+	Port:                 7100,
+	WebInterfacePort:     7520,
+	RPCInterfacePort:     7530,
+	MetricsInterfacePort: 7540,
+
+	DataDirSuffix:  "",
+	MessagePrefix:  "",
+	AddressVersion: 0,
+}
+
+// TestNet is the public test network.  Its master key is derived from the
+// seed "shellcoin testnet" with cipher.GenerateDeterministicKeyPair, testnet
+// coins have no value.
+var TestNet = ChainParams{
+	Name: "testnet",
+
+	GenesisAddress:    "ALKqqSKBW7L8TgPojtL2wUFyXLfqH3tWmF",
+	GenesisSignature:  "52faa9e4cba21f5d14198891fbb87355d277b343166d4f01cb097e65954a1a4b4c132d841a6da94081e9dbf40c3da7524dd12879e5800731588c184e2f72aa6901",
+	GenesisTimestamp:  1508371200,
+	GenesisCoinVolume: 300e12,
+
+	BlockchainPubkey: "032fd6590cd118adbd4d78757948b8c5d65cadb807a2e6c8a8ba5e143f4346a37b",
+
+	DefaultConnections: []string{},
+	DNSSeeds:           []string{},
+
+	Port:                 17100,
+	WebInterfacePort:     17520,
+	RPCInterfacePort:     17530,
+	MetricsInterfacePort: 17540,
+
+	DataDirSuffix:  "-testnet",
+	MessagePrefix:  "t",
+	AddressVersion: 1,
+}
+
+// RegTest is a private network for local testing.  Its master key is
+// derived from the seed "shellcoin regtest" and published, so that any node
+// can create blocks.
+var RegTest = ChainParams{
+	Name: "regtest",
+
+	GenesisAddress:    "6nUpSYERmXQf6WxVSN3sJTSip7vrnRNf9U",
+	GenesisSignature:  "1d2b50dd3322bfeb6c0e0dcd729c75f408aafdeda9c02c4209d331f81d83b1890e9e2c52e9f8a9f38bf2632cc51fa449f8f65bbd67773e08482bb18f1d9960b300",
+	GenesisTimestamp:  1508371200,
+	GenesisCoinVolume: 300e12,
+
+	BlockchainPubkey: "0270ae335ee997d0e6342f0f7e968e62aa80917441d441425b6af2db0d227817c5",
+	BlockchainSeckey: "b5b2c72861fdb4e5d61fd532607437cf4b7d6107a357eaa793dfd44f1e81f3da",
+
+	DefaultConnections: []string{},
+	DNSSeeds:           []string{},
+
+	Port:                 27100,
+	WebInterfacePort:     27520,
+	RPCInterfacePort:     27530,
+	MetricsInterfacePort: 27540,
+
+	DataDirSuffix:  "-regtest",
+	MessagePrefix:  "r",
+	AddressVersion: 2,
+
+	Regtest: true,
+}
+
+var networks = map[string]ChainParams{
+	MainNet.Name: MainNet,
+	TestNet.Name: TestNet,
+	RegTest.Name: RegTest,
+}
+
+// Get returns the parameters of the network name
+func Get(name string) (ChainParams, error) {
+	p, ok := networks[name]
+	if !ok {
+		return ChainParams{}, fmt.Errorf("unknown network %s, expected one of %v", name, Names())
+	}
+	return p, nil
+}
+
+// Names returns the names of the networks
+func Names() []string {
+	names := make([]string, 0, len(networks))
+	for name := range networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
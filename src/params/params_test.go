@@ -0,0 +1,63 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+)
+
+func TestGenesis(t *testing.T) {
+	defer cipher.SetAddressVersion(0)
+
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			p, err := Get(name)
+			require.NoError(t, err)
+			require.Equal(t, name, p.Name)
+
+			cipher.SetAddressVersion(p.AddressVersion)
+			addr, err := cipher.DecodeBase58Address(p.GenesisAddress)
+			require.NoError(t, err)
+
+			pubkey, err := cipher.PubKeyFromHex(p.BlockchainPubkey)
+			require.NoError(t, err)
+			sig, err := cipher.SigFromHex(p.GenesisSignature)
+			require.NoError(t, err)
+
+			gb, err := coin.NewGenesisBlock(addr, p.GenesisCoinVolume, p.GenesisTimestamp)
+			require.NoError(t, err)
+			require.NoError(t, cipher.VerifySignature(pubkey, sig, gb.HashHeader()))
+
+			if p.BlockchainSeckey != "" {
+				seckey, err := cipher.SecKeyFromHex(p.BlockchainSeckey)
+				require.NoError(t, err)
+				require.Equal(t, pubkey, cipher.PubKeyFromSecKey(seckey))
+			}
+		})
+	}
+}
+
+func TestNetworksApart(t *testing.T) {
+	versions := make(map[byte]string)
+	prefixes := make(map[string]string)
+	ports := make(map[int]string)
+	for _, name := range Names() {
+		p, err := Get(name)
+		require.NoError(t, err)
+
+		require.NotContains(t, versions, p.AddressVersion, name)
+		versions[p.AddressVersion] = name
+		require.NotContains(t, prefixes, p.MessagePrefix, name)
+		prefixes[p.MessagePrefix] = name
+		for _, port := range []int{p.Port, p.WebInterfacePort, p.RPCInterfacePort, p.MetricsInterfacePort} {
+			require.NotContains(t, ports, port, name)
+			ports[port] = name
+		}
+	}
+
+	_, err := Get("devnet")
+	require.Error(t, err)
+}
@@ -0,0 +1,475 @@
+// Package harness runs a network of full daemons in one process, so that
+// sync, relay and fork scenarios can be covered by go test.
+//
+// The nodes listen on loopback and keep their data in temporary directories.
+// Node 0 is the master of a regtest chain, it creates blocks on request with
+// GenerateBlocks and FundAddress; the other nodes follow it.  Every pair of
+// nodes is connected through a link, which the harness cuts to partition the
+// network and restores to heal it.  Nodes can be killed and restarted on
+// their data directory.
+package harness
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/daemon"
+	"github.com/skycoin/skycoin/src/params"
+)
+
+// DefaultTimeout is how long the Wait methods wait for the nodes
+var DefaultTimeout = 30 * time.Second
+
+// pollRate is how often the Wait methods check the nodes
+const pollRate = 50 * time.Millisecond
+
+// Cluster is a network of nodes running in this process
+type Cluster struct {
+	// Nodes of the cluster, the master first
+	Nodes []*Node
+	// Timeout of the Wait methods
+	Timeout time.Duration
+
+	t     *testing.T
+	dir   string
+	links map[[2]int]*link
+	// address version to restore on Close
+	addressVersion byte
+}
+
+// Node is a daemon of the cluster
+type Node struct {
+	// Index of the node in the cluster
+	Index int
+	// Daemon is nil while the node is killed
+	Daemon *daemon.Daemon
+
+	config daemon.Config
+	// listener of the next start, bound ahead so that the port of the node
+	// can't be taken by someone else
+	listener net.Listener
+	// addresses of the links this node dials
+	dials []string
+	errC  chan error
+}
+
+// New starts n nodes, node 0 being the master, and connects each node to the
+// others.  configure, if not nil, is called with the config of each node
+// before it starts.  Close must be called once the test is done.  Until then
+// addresses have the version of the regtest network, see
+// cipher.SetAddressVersion, so tests creating a cluster must not run in
+// parallel with tests using other address versions.
+func New(t *testing.T, n int, configure func(i int, c *daemon.Config)) *Cluster {
+	require.True(t, n > 0, "a cluster needs at least one node")
+
+	dir, err := ioutil.TempDir("", "harness")
+	require.NoError(t, err)
+
+	p := params.RegTest
+	c := &Cluster{
+		Timeout:        DefaultTimeout,
+		t:              t,
+		dir:            dir,
+		links:          make(map[[2]int]*link),
+		addressVersion: cipher.AddressVersion(),
+	}
+	cipher.SetAddressVersion(p.AddressVersion)
+
+	seckey := cipher.MustSecKeyFromHex(p.BlockchainSeckey)
+	pubkey := cipher.PubKeyFromSecKey(seckey)
+	genesisAddr := cipher.AddressFromPubKey(pubkey)
+	gb, err := coin.NewGenesisBlock(genesisAddr, p.GenesisCoinVolume, p.GenesisTimestamp)
+	require.NoError(t, err)
+	genesisSig := cipher.SignHash(gb.HashHeader(), seckey)
+
+	for i := 0; i < n; i++ {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			c.Close()
+			require.NoError(t, err)
+		}
+		port := ln.Addr().(*net.TCPAddr).Port
+
+		nodeDir := filepath.Join(dir, fmt.Sprintf("node%d", i))
+		require.NoError(t, os.MkdirAll(nodeDir, 0700))
+
+		dc := daemon.NewConfig()
+		dc.Daemon.Address = "127.0.0.1"
+		dc.Daemon.Port = port
+		dc.Daemon.LocalhostOnly = true
+		dc.Daemon.DataDirectory = nodeDir
+		// Only the links are dialed, as private peers.  The peers learnt
+		// from introductions are the real addresses of the nodes, dialing
+		// them would bypass the links and their partitions.
+		dc.Daemon.OutgoingRate = time.Hour
+		dc.Daemon.PrivateRate = 100 * time.Millisecond
+		dc.Daemon.IPCountsMax = 2 * n
+		dc.Daemon.LogPings = false
+		dc.Daemon.ShutdownTimeout = 5 * time.Second
+		dc.Peers.DataDirectory = nodeDir
+		dc.Peers.Disabled = true
+		dc.Peers.Sources = nil
+		dc.Messages.NetworkPrefix = p.MessagePrefix
+
+		dc.Visor.BlocksRequestRate = 200 * time.Millisecond
+		dc.Visor.BlocksAnnounceRate = 200 * time.Millisecond
+		dc.Visor.TxnsAnnounceRate = 200 * time.Millisecond
+		dc.Visor.ShutdownTimeout = 5 * time.Second
+		dc.Visor.Config.UnconfirmedRefreshRate = time.Second
+		dc.Visor.Config.BlockchainPubkey = pubkey
+		dc.Visor.Config.GenesisAddress = genesisAddr
+		dc.Visor.Config.GenesisSignature = genesisSig
+		dc.Visor.Config.GenesisTimestamp = p.GenesisTimestamp
+		dc.Visor.Config.GenesisCoinVolume = p.GenesisCoinVolume
+		dc.Visor.Config.DBPath = filepath.Join(nodeDir, "data.db")
+		dc.Visor.Config.WalletDirectory = filepath.Join(nodeDir, "wallets")
+		if i == 0 {
+			dc.Visor.Config.IsMaster = true
+			dc.Visor.Config.BlockchainSeckey = seckey
+			dc.Visor.Regtest = true
+		}
+
+		if configure != nil {
+			configure(i, &dc)
+		}
+
+		c.Nodes = append(c.Nodes, &Node{
+			Index:    i,
+			config:   dc,
+			listener: ln,
+		})
+	}
+
+	// node j dials node i through the link of the pair, never the other
+	// way round, the nodes would disconnect the second connection
+	for j := range c.Nodes {
+		for i := 0; i < j; i++ {
+			l, err := newLink(c.Nodes[i].Addr())
+			if err != nil {
+				c.Close()
+				require.NoError(t, err)
+			}
+			c.links[[2]int{i, j}] = l
+			c.Nodes[j].dials = append(c.Nodes[j].dials, l.Addr())
+		}
+	}
+
+	for _, nd := range c.Nodes {
+		if err := nd.start(); err != nil {
+			c.Close()
+			require.NoError(t, err)
+		}
+	}
+	for _, nd := range c.Nodes {
+		c.waitGenesis(nd.Index)
+	}
+
+	return c
+}
+
+// Addr returns the address the node listens on
+func (nd *Node) Addr() string {
+	return daemon.JoinAddr(nd.config.Daemon.Address, uint16(nd.config.Daemon.Port))
+}
+
+// Running returns whether the node runs, it doesn't after Kill
+func (nd *Node) Running() bool {
+	return nd.Daemon != nil
+}
+
+// start creates and runs the daemon of the node, which dials its links.  The
+// daemon accepts connections on the listener bound by New, or after a
+// restart on a new listener on the same port.
+func (nd *Node) start() error {
+	ln := nd.listener
+	nd.listener = nil
+	if ln == nil {
+		var err error
+		if ln, err = net.Listen("tcp", nd.Addr()); err != nil {
+			return err
+		}
+	}
+
+	config := nd.config
+	config.Pool.Gnet.Listener = ln
+	d, err := daemon.NewDaemon(config)
+	if err != nil {
+		ln.Close()
+		return err
+	}
+
+	for _, addr := range nd.dials {
+		if _, err := d.Peers.Peers.AddPeer(addr); err != nil {
+			d.Shutdown()
+			return err
+		}
+		if err := d.Peers.Peers.SetPrivate(addr, true); err != nil {
+			d.Shutdown()
+			return err
+		}
+	}
+
+	nd.Daemon = d
+	nd.errC = make(chan error, 1)
+	go func() {
+		nd.errC <- d.Run()
+	}()
+
+	return nil
+}
+
+// stop shuts the daemon of the node down
+func (nd *Node) stop() error {
+	if nd.Daemon == nil {
+		return nil
+	}
+
+	nd.Daemon.Shutdown()
+	err := <-nd.errC
+	nd.Daemon = nil
+	return err
+}
+
+// Master returns the node creating the blocks
+func (c *Cluster) Master() *Node {
+	return c.Nodes[0]
+}
+
+// running returns the nodes that weren't killed
+func (c *Cluster) running() []*Node {
+	var nodes []*Node
+	for _, nd := range c.Nodes {
+		if nd.Running() {
+			nodes = append(nodes, nd)
+		}
+	}
+	return nodes
+}
+
+// Close shuts the nodes and the links down and removes the data directories
+func (c *Cluster) Close() {
+	for _, nd := range c.Nodes {
+		if err := nd.stop(); err != nil {
+			c.t.Logf("node %d stopped with error: %v", nd.Index, err)
+		}
+		if nd.listener != nil {
+			nd.listener.Close()
+		}
+	}
+	for _, l := range c.links {
+		l.Close()
+	}
+	os.RemoveAll(c.dir)
+	cipher.SetAddressVersion(c.addressVersion)
+}
+
+// Kill shuts node i down, its data directory is kept for Restart
+func (c *Cluster) Kill(i int) {
+	require.True(c.t, c.Nodes[i].Running(), "node %d is not running", i)
+	require.NoError(c.t, c.Nodes[i].stop())
+}
+
+// Restart starts node i again on its data directory
+func (c *Cluster) Restart(i int) {
+	require.False(c.t, c.Nodes[i].Running(), "node %d is running", i)
+	require.NoError(c.t, c.Nodes[i].start())
+	c.waitGenesis(i)
+}
+
+// Partition cuts the links between the groups of nodes, the nodes of a group
+// stay connected.  The nodes not in any group are cut off from all nodes.
+func (c *Cluster) Partition(groups ...[]int) {
+	group := make(map[int]int)
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			group[i] = g + 1
+		}
+	}
+
+	for pair, l := range c.links {
+		g, ok := group[pair[0]]
+		if ok && g == group[pair[1]] {
+			l.Restore()
+		} else {
+			l.Cut()
+		}
+	}
+}
+
+// Heal restores the links between all nodes
+func (c *Cluster) Heal() {
+	for _, l := range c.links {
+		l.Restore()
+	}
+
+	// don't wait for the backoff of the failed connections
+	for _, nd := range c.running() {
+		nd.Daemon.Peers.Peers.ResetAllRetryTimes()
+	}
+}
+
+// GenerateBlocks makes the master create n blocks right away
+func (c *Cluster) GenerateBlocks(n int) []coin.SignedBlock {
+	sbs, err := c.Master().Daemon.Gateway.GenerateBlocks(n)
+	require.NoError(c.t, err)
+	return sbs
+}
+
+// FundAddress makes the master send coins from the genesis address to addr
+// in a new block
+func (c *Cluster) FundAddress(addr cipher.Address, coins uint64) (coin.Transaction, coin.SignedBlock) {
+	txn, sb, err := c.Master().Daemon.Gateway.FundAddress(addr, coins)
+	require.NoError(c.t, err)
+	return txn, sb
+}
+
+// InjectTransaction adds txn to the unconfirmed pool of node i, which
+// announces it to its peers
+func (c *Cluster) InjectTransaction(i int, txn coin.Transaction) {
+	require.NoError(c.t, c.Nodes[i].Daemon.Gateway.InjectTransaction(txn))
+}
+
+// MakeTransaction creates a txn spending the outputs of the address of
+// seckey known to node i, which sends coins to addr and the rest back.  The
+// coin hours of the inputs are burned.
+func (c *Cluster) MakeTransaction(i int, seckey cipher.SecKey, addr cipher.Address, coins uint64) coin.Transaction {
+	from := cipher.AddressFromSecKey(seckey)
+	uxs := c.Nodes[i].Daemon.Gateway.GetUnspent().GetUnspentsOfAddrs([]cipher.Address{from})[from]
+
+	var txn coin.Transaction
+	var total uint64
+	keys := make([]cipher.SecKey, 0, len(uxs))
+	for _, ux := range uxs {
+		txn.PushInput(ux.Hash())
+		keys = append(keys, seckey)
+		total += ux.Body.Coins
+	}
+	require.True(c.t, total >= coins, "%s has %d coins, can't send %d", from, total, coins)
+
+	txn.PushOutput(addr, coins, 0)
+	if total > coins {
+		txn.PushOutput(from, total-coins, 0)
+	}
+
+	txn.SignInputs(keys)
+	txn.UpdateHeader()
+	return txn
+}
+
+// HeadSeq returns the sequence of the head block of node i
+func (c *Cluster) HeadSeq(i int) uint64 {
+	return c.Nodes[i].Daemon.Visor.HeadBkSeq()
+}
+
+// headHash returns the hash of the head block of node i
+func (c *Cluster) headHash(i int) (string, error) {
+	rbs, err := c.Nodes[i].Daemon.Gateway.GetLastBlocks(1)
+	if err != nil {
+		return "", err
+	}
+	if len(rbs.Blocks) == 0 {
+		return "", errors.New("no blocks")
+	}
+	return rbs.Blocks[0].Head.BlockHash, nil
+}
+
+// waitGenesis waits for node i to load its chain, or to create the genesis
+// block on a new data directory
+func (c *Cluster) waitGenesis(i int) {
+	c.wait(fmt.Sprintf("genesis block of node %d", i), func() error {
+		_, err := c.headHash(i)
+		return err
+	})
+}
+
+// wait calls check until it returns nil, fails the test if it doesn't within
+// the timeout
+func (c *Cluster) wait(what string, check func() error) {
+	deadline := time.Now().Add(c.Timeout)
+	for {
+		err := check()
+		if err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			require.FailNow(c.t, fmt.Sprintf("timed out waiting for %s: %v", what, err))
+		}
+		time.Sleep(pollRate)
+	}
+}
+
+// WaitForHeight waits for the given nodes, or all running nodes if none are
+// given, to reach the block seq
+func (c *Cluster) WaitForHeight(seq uint64, nodes ...int) {
+	if len(nodes) == 0 {
+		for _, nd := range c.running() {
+			nodes = append(nodes, nd.Index)
+		}
+	}
+
+	c.wait(fmt.Sprintf("height %d", seq), func() error {
+		for _, i := range nodes {
+			if head := c.HeadSeq(i); head < seq {
+				return fmt.Errorf("node %d is at height %d", i, head)
+			}
+		}
+		return nil
+	})
+}
+
+// WaitConverged waits for all running nodes to have the same head block
+func (c *Cluster) WaitConverged() {
+	nodes := c.running()
+	require.NotEmpty(c.t, nodes, "no node is running")
+
+	c.wait("convergence", func() error {
+		want, err := c.headHash(nodes[0].Index)
+		if err != nil {
+			return err
+		}
+		for _, nd := range nodes[1:] {
+			got, err := c.headHash(nd.Index)
+			if err != nil {
+				return err
+			}
+			if got != want {
+				return fmt.Errorf("node %d has head %s, node %d has head %s",
+					nd.Index, got, nodes[0].Index, want)
+			}
+		}
+		return nil
+	})
+}
+
+// WaitForTransaction waits for the given nodes, or all running nodes if none
+// are given, to know the txn, confirmed or not
+func (c *Cluster) WaitForTransaction(txid cipher.SHA256, nodes ...int) {
+	if len(nodes) == 0 {
+		for _, nd := range c.running() {
+			nodes = append(nodes, nd.Index)
+		}
+	}
+
+	c.wait(fmt.Sprintf("transaction %s", txid.Hex()), func() error {
+		for _, i := range nodes {
+			txn, err := c.Nodes[i].Daemon.Gateway.GetTransaction(txid)
+			if err != nil {
+				return err
+			}
+			if txn == nil {
+				return fmt.Errorf("node %d doesn't know the transaction", i)
+			}
+		}
+		return nil
+	})
+}
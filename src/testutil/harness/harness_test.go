@@ -0,0 +1,102 @@
+package harness
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/testutil"
+)
+
+func TestSync(t *testing.T) {
+	c := New(t, 3, nil)
+	defer c.Close()
+
+	c.GenerateBlocks(3)
+	c.WaitForHeight(3)
+	c.WaitConverged()
+}
+
+func TestTransactionRelay(t *testing.T) {
+	c := New(t, 3, nil)
+	defer c.Close()
+
+	pub, sec := cipher.GenerateKeyPair()
+	addr := cipher.AddressFromPubKey(pub)
+	c.FundAddress(addr, 10e6)
+	c.WaitForHeight(1)
+
+	// a follower's txn reaches the master through the network
+	txn := c.MakeTransaction(2, sec, testutil.MakeAddress(), 4e6)
+	c.InjectTransaction(2, txn)
+	c.WaitForTransaction(txn.Hash())
+
+	sbs := c.GenerateBlocks(1)
+	require.Equal(t, txn.Hash(), sbs[0].Block.Body.Transactions[0].Hash())
+	c.WaitForHeight(2)
+	c.WaitConverged()
+}
+
+func TestPartitionHeal(t *testing.T) {
+	c := New(t, 3, nil)
+	defer c.Close()
+
+	c.GenerateBlocks(1)
+	c.WaitConverged()
+
+	// node 2 misses the blocks created while it is cut off
+	c.Partition([]int{0, 1}, []int{2})
+	c.GenerateBlocks(2)
+	c.WaitForHeight(3, 0, 1)
+	require.Equal(t, uint64(1), c.HeadSeq(2))
+
+	c.Heal()
+	c.WaitForHeight(3)
+	c.WaitConverged()
+}
+
+func TestCompressionNegotiated(t *testing.T) {
+	c := New(t, 2, nil)
+	defer c.Close()
+
+	// both ends of the connection learn that the other accepts compressed
+	// messages from the features exchanged after the introduction
+	c.wait("compression", func() error {
+		for _, nd := range c.Nodes {
+			conns, err := nd.Daemon.Pool.Pool.GetConnections()
+			if err != nil {
+				return err
+			}
+			if len(conns) == 0 {
+				return errors.New("no connection")
+			}
+			for _, conn := range conns {
+				if !conn.Compression() {
+					return fmt.Errorf("node %d doesn't compress messages to %s", nd.Index, conn.Addr())
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func TestKillRestart(t *testing.T) {
+	c := New(t, 2, nil)
+	defer c.Close()
+
+	c.GenerateBlocks(1)
+	c.WaitConverged()
+
+	c.Kill(1)
+	require.False(t, c.Nodes[1].Running())
+	c.GenerateBlocks(2)
+
+	// the node keeps its chain and syncs the blocks it missed
+	c.Restart(1)
+	require.True(t, c.HeadSeq(1) >= 1)
+	c.WaitForHeight(3)
+	c.WaitConverged()
+}
@@ -0,0 +1,140 @@
+package harness
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// link forwards the connections of one node to another node over loopback.
+// The dialing node connects to the link instead of the other node, so that
+// the link can be cut to partition the network.
+type link struct {
+	ln     net.Listener
+	target string
+
+	mu    sync.Mutex
+	cut   bool
+	conns map[net.Conn]struct{}
+	done  chan struct{}
+}
+
+// newLink listens on a free loopback port and forwards to target
+func newLink(target string) (*link, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	l := &link{
+		ln:     ln,
+		target: target,
+		conns:  make(map[net.Conn]struct{}),
+		done:   make(chan struct{}),
+	}
+	go l.serve()
+	return l, nil
+}
+
+// Addr returns the address the dialing node connects to
+func (l *link) Addr() string {
+	return l.ln.Addr().String()
+}
+
+func (l *link) serve() {
+	defer close(l.done)
+	for {
+		c, err := l.ln.Accept()
+		if err != nil {
+			return
+		}
+		go l.forward(c)
+	}
+}
+
+// forward copies the traffic of c to the target and back, until either side
+// closes or the link is cut
+func (l *link) forward(c net.Conn) {
+	l.mu.Lock()
+	cut := l.cut
+	l.mu.Unlock()
+	if cut {
+		c.Close()
+		return
+	}
+
+	t, err := net.Dial("tcp", l.target)
+	if err != nil {
+		// the target node is down
+		c.Close()
+		return
+	}
+
+	if !l.track(c, t) {
+		c.Close()
+		t.Close()
+		return
+	}
+	defer l.untrack(c, t)
+
+	errC := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(t, c)
+		errC <- err
+	}()
+	go func() {
+		_, err := io.Copy(c, t)
+		errC <- err
+	}()
+
+	// closing both ends stops the other copy
+	<-errC
+	c.Close()
+	t.Close()
+	<-errC
+}
+
+// track records the connections, returns false if the link was cut meanwhile
+func (l *link) track(conns ...net.Conn) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cut {
+		return false
+	}
+	for _, c := range conns {
+		l.conns[c] = struct{}{}
+	}
+	return true
+}
+
+func (l *link) untrack(conns ...net.Conn) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, c := range conns {
+		delete(l.conns, c)
+	}
+}
+
+// Cut closes the forwarded connections and refuses new ones until Restore
+func (l *link) Cut() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cut = true
+	for c := range l.conns {
+		c.Close()
+	}
+}
+
+// Restore forwards new connections again
+func (l *link) Restore() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cut = false
+}
+
+// Close stops listening and closes the forwarded connections
+func (l *link) Close() {
+	l.Cut()
+	l.ln.Close()
+	<-l.done
+}
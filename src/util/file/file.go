@@ -54,6 +54,12 @@ func InitDataDir(dir string) (string, error) {
 	return dir, nil
 }
 
+// ResolveDataDir returns the data directory InitDataDir would create for
+// dir, without creating it
+func ResolveDataDir(dir string) (string, error) {
+	return buildDataDir(dir)
+}
+
 // Construct the full data directory by adding to $HOME or ./
 func buildDataDir(dir string) (string, error) {
 	if dir == "" {
@@ -0,0 +1,257 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	logging "github.com/op/go-logging"
+)
+
+// Field is a key/value pair attached to a log message.  Fields are passed
+// after the format arguments, they are not used by the format string:
+//
+//	logger.Info("Connected to %s", addr, logging.F("peer", addr))
+//
+// Text output appends them as key=value, JSON output adds them as keys of
+// the line.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F creates a Field
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+var (
+	// ErrNotInitialized is returned when changing levels before InitLogger
+	ErrNotInitialized = errors.New("logging is not initialized")
+
+	backendLk sync.Mutex
+	installed *backend
+)
+
+// backend writes records as text or JSON lines.  Unlike the module levels
+// of go-logging, its levels can be changed while other goroutines log.
+type backend struct {
+	levelsLk     sync.RWMutex
+	levels       map[string]logging.Level
+	defaultLevel logging.Level
+
+	json   bool
+	out    io.Writer
+	outLk  sync.Mutex
+	text   logging.Backend
+	format logging.Formatter
+}
+
+func newBackend(out io.Writer, defaultLevel logging.Level) *backend {
+	return &backend{
+		levels:       make(map[string]logging.Level),
+		defaultLevel: defaultLevel,
+		out:          out,
+	}
+}
+
+// GetLevel returns the level of module
+func (b *backend) GetLevel(module string) logging.Level {
+	b.levelsLk.RLock()
+	defer b.levelsLk.RUnlock()
+	if level, ok := b.levels[module]; ok {
+		return level
+	}
+	return b.defaultLevel
+}
+
+// SetLevel sets the level of module, "" sets the level of modules that
+// don't have one
+func (b *backend) SetLevel(level logging.Level, module string) {
+	b.levelsLk.Lock()
+	defer b.levelsLk.Unlock()
+	if module == "" {
+		b.defaultLevel = level
+		return
+	}
+	b.levels[module] = level
+}
+
+// IsEnabledFor returns whether records of module at level are logged
+func (b *backend) IsEnabledFor(level logging.Level, module string) bool {
+	return level <= b.GetLevel(module)
+}
+
+// Log writes the record if its module is enabled for level
+func (b *backend) Log(level logging.Level, calldepth int, rec *logging.Record) error {
+	if !b.IsEnabledFor(level, rec.Module) {
+		return nil
+	}
+
+	fields := extractFields(rec)
+
+	if b.json {
+		return b.writeJSON(rec, fields)
+	}
+
+	if len(fields) == 0 {
+		return logging.NewBackendFormatter(b.text, b.format).Log(level, calldepth+1, rec)
+	}
+
+	f := &fieldsFormatter{
+		Formatter: b.format,
+		fields:    fields,
+	}
+	return logging.NewBackendFormatter(b.text, f).Log(level, calldepth+1, rec)
+}
+
+func (b *backend) writeJSON(rec *logging.Record, fields []Field) error {
+	var buf bytes.Buffer
+	buf.WriteString(`{"time":`)
+	writeJSONValue(&buf, rec.Time.UTC().Format(time.RFC3339Nano))
+	buf.WriteString(`,"level":`)
+	writeJSONValue(&buf, rec.Level.String())
+	buf.WriteString(`,"module":`)
+	writeJSONValue(&buf, rec.Module)
+	buf.WriteString(`,"msg":`)
+	writeJSONValue(&buf, rec.Message())
+	for _, f := range fields {
+		switch f.Key {
+		case "time", "level", "module", "msg":
+			// don't let a field shadow the record keys
+			continue
+		}
+		buf.WriteByte(',')
+		writeJSONValue(&buf, f.Key)
+		buf.WriteByte(':')
+		writeJSONValue(&buf, fieldValue(f.Value))
+	}
+	buf.WriteString("}\n")
+
+	b.outLk.Lock()
+	defer b.outLk.Unlock()
+	_, err := b.out.Write(buf.Bytes())
+	return err
+}
+
+func writeJSONValue(buf *bytes.Buffer, v interface{}) {
+	d, err := json.Marshal(v)
+	if err != nil {
+		d, _ = json.Marshal(fmt.Sprint(v))
+	}
+	buf.Write(d)
+}
+
+// fieldValue converts errors and fmt.Stringers to strings, so that
+// e.g. a cipher.Address is not written as an object
+func fieldValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case error:
+		return x.Error()
+	case fmt.Stringer:
+		return x.String()
+	default:
+		return v
+	}
+}
+
+// extractFields removes the Fields from the arguments of rec and returns them
+func extractFields(rec *logging.Record) []Field {
+	var fields []Field
+	args := rec.Args[:0:0]
+	for _, a := range rec.Args {
+		if f, ok := a.(Field); ok {
+			fields = append(fields, f)
+			continue
+		}
+		args = append(args, a)
+	}
+	if fields != nil {
+		rec.Args = args
+	}
+	return fields
+}
+
+// fieldsFormatter appends the fields of a record to its text
+type fieldsFormatter struct {
+	logging.Formatter
+	fields []Field
+}
+
+func (f *fieldsFormatter) Format(calldepth int, r *logging.Record, w io.Writer) error {
+	if err := f.Formatter.Format(calldepth+1, r, w); err != nil {
+		return err
+	}
+	for _, fd := range f.fields {
+		if _, err := fmt.Fprintf(w, " %s=%v", fd.Key, fieldValue(fd.Value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetModuleLevel changes the level of module while running, "" changes the
+// level of modules that don't have their own
+func SetModuleLevel(module, level string) error {
+	lvl, err := logging.LogLevel(level)
+	if err != nil {
+		return err
+	}
+
+	backendLk.Lock()
+	b := installed
+	backendLk.Unlock()
+	if b == nil {
+		return ErrNotInitialized
+	}
+
+	b.SetLevel(lvl, module)
+	return nil
+}
+
+// ModuleLevels returns the level of each module that has one, "" is the
+// level of the others
+func ModuleLevels() map[string]string {
+	backendLk.Lock()
+	b := installed
+	backendLk.Unlock()
+	if b == nil {
+		return nil
+	}
+
+	b.levelsLk.RLock()
+	defer b.levelsLk.RUnlock()
+	levels := make(map[string]string, len(b.levels)+1)
+	for module, level := range b.levels {
+		levels[module] = level.String()
+	}
+	levels[""] = b.defaultLevel.String()
+	return levels
+}
+
+// ParseModuleLevels parses a list of module levels in the form
+// "gnet=debug,pex=warning"
+func ParseModuleLevels(s string) (map[string]string, error) {
+	levels := make(map[string]string)
+	if strings.TrimSpace(s) == "" {
+		return levels, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid module level %q, expected module=level", pair)
+		}
+		if _, err := logging.LogLevel(kv[1]); err != nil {
+			return nil, fmt.Errorf("invalid level of module %s: %v", kv[0], err)
+		}
+		levels[kv[0]] = kv[1]
+	}
+
+	return levels, nil
+}
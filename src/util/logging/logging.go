@@ -41,12 +41,16 @@ type LogConfig struct {
 	level Level
 	// Level convertes to level during initialization
 	Level string
-	// list of all modules
+	// list of all modules, they log at Level unless ModuleLevels has them
 	Modules []string
 	// format
 	Format string
 	// enable colors
 	Colors bool
+	// write JSON lines instead of Format, Colors is ignored
+	JSON bool
+	// levels of modules that differ from Level, by module name
+	ModuleLevels map[string]string
 	// output
 	Output io.Writer
 }
@@ -94,18 +98,55 @@ func (l *LogConfig) initLevel() {
 }
 
 // InitLogger initialize logging using this LogConfig;
-// it panics if l.Format is invalid or l.Level is invalid
+// it panics if l.Format is invalid or a level is invalid
 func (l *LogConfig) InitLogger() {
 	l.initLevel()
 
-	format := logging.MustStringFormatter(l.Format)
-	logging.SetFormatter(format)
-	for _, s := range l.Modules {
-		logging.SetLevel(logging.Level(l.level), s)
+	b := newBackend(l.Output, logging.Level(l.level))
+	if l.JSON {
+		b.json = true
+	} else {
+		format := logging.MustStringFormatter(l.Format)
+		logging.SetFormatter(format)
+		stdout := logging.NewLogBackend(l.Output, "", 0)
+		stdout.Color = l.Colors
+		b.text = stdout
+		b.format = format
 	}
-	stdout := logging.NewLogBackend(l.Output, "", 0)
-	stdout.Color = l.Colors
-	logging.SetBackend(stdout)
+
+	// modules without a level of their own follow the default, so that
+	// SetModuleLevel("", level) changes them
+	for module, level := range l.ModuleLevels {
+		lvl, err := logging.LogLevel(level)
+		if err != nil {
+			log.Panicf("Invalid level %s of module %s: %v", level, module, err)
+		}
+		b.SetLevel(lvl, module)
+	}
+
+	backendLk.Lock()
+	defer backendLk.Unlock()
+	installed = b
+	logging.SetBackend(b)
+}
+
+// useDefaultBackend logs to out like the default backend of go-logging,
+// but without the Fields in the message, until InitLogger is called
+func useDefaultBackend(out io.Writer) {
+	b := newBackend(out, logging.DEBUG)
+	b.text = logging.NewLogBackend(out, "", log.LstdFlags)
+	b.format = logging.DefaultFormatter
+
+	backendLk.Lock()
+	defer backendLk.Unlock()
+	installed = nil
+	logging.SetBackend(b)
+}
+
+func init() {
+	// The default backend of go-logging passes Fields to Sprintf,
+	// which prints them as %!(EXTRA ...)
+	useDefaultBackend(os.Stderr)
 }
 
 // MustGetLogger safe initialize global logger
@@ -115,5 +156,8 @@ func MustGetLogger(module string) *logging.Logger {
 
 // DisableLogging disables the logger completely
 func Disable() {
+	backendLk.Lock()
+	defer backendLk.Unlock()
+	installed = nil
 	logging.SetBackend(logging.NewLogBackend(ioutil.Discard, "", 0))
 }
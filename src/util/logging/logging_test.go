@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func initTestLogger(t *testing.T, jsonOutput bool, moduleLevels map[string]string) *bytes.Buffer {
+	var buf bytes.Buffer
+	cfg := DevLogConfig([]string{"test", "other"})
+	cfg.Level = "info"
+	cfg.Format = "[%{module}:%{level}] %{message}"
+	cfg.Colors = false
+	cfg.JSON = jsonOutput
+	cfg.ModuleLevels = moduleLevels
+	cfg.Output = &buf
+	cfg.InitLogger()
+	return &buf
+}
+
+func TestJSONOutput(t *testing.T) {
+	buf := initTestLogger(t, true, nil)
+	log := MustGetLogger("test")
+
+	log.Info("Added block %d from %s", 10, "1.2.3.4:6000",
+		F("seq", 10), F("peer", "1.2.3.4:6000"), F("err", errors.New("bad")), F("msg", "ignored"))
+	log.Debug("not logged")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1)
+
+	var v map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &v))
+	require.NotEmpty(t, v["time"])
+	delete(v, "time")
+	require.Equal(t, map[string]interface{}{
+		"level":  "INFO",
+		"module": "test",
+		"msg":    "Added block 10 from 1.2.3.4:6000",
+		"seq":    float64(10),
+		"peer":   "1.2.3.4:6000",
+		"err":    "bad",
+	}, v)
+}
+
+func TestTextOutputFields(t *testing.T) {
+	buf := initTestLogger(t, false, nil)
+	log := MustGetLogger("test")
+
+	log.Info("Connected to %s", "1.2.3.4:6000", F("peer", "1.2.3.4:6000"))
+	log.Warning("no fields")
+
+	require.Equal(t, "[test:INFO] Connected to 1.2.3.4:6000 peer=1.2.3.4:6000\n[test:WARNING] no fields\n", buf.String())
+}
+
+func TestSetModuleLevel(t *testing.T) {
+	buf := initTestLogger(t, false, map[string]string{"other": "error"})
+	log := MustGetLogger("test")
+	other := MustGetLogger("other")
+	unknown := MustGetLogger("unknown")
+
+	require.Equal(t, map[string]string{
+		"":      "INFO",
+		"other": "ERROR",
+	}, ModuleLevels())
+
+	log.Debug("a")
+	other.Info("b")
+	unknown.Info("c")
+	require.Equal(t, "[unknown:INFO] c\n", buf.String())
+	buf.Reset()
+
+	require.NoError(t, SetModuleLevel("test", "debug"))
+	require.NoError(t, SetModuleLevel("", "warning"))
+	require.Error(t, SetModuleLevel("test", "loud"))
+
+	log.Debug("a")
+	other.Info("b")
+	unknown.Info("c")
+	require.Equal(t, "[test:DEBUG] a\n", buf.String())
+	buf.Reset()
+
+	// modules without a level of their own follow the default
+	require.NoError(t, SetModuleLevel("", "debug"))
+	unknown.Debug("d")
+	other.Info("e")
+	require.Equal(t, "[unknown:DEBUG] d\n", buf.String())
+}
+
+func TestDisable(t *testing.T) {
+	initTestLogger(t, false, nil)
+	require.NoError(t, SetModuleLevel("test", "debug"))
+
+	Disable()
+	defer useDefaultBackend(os.Stderr)
+	require.Equal(t, ErrNotInitialized, SetModuleLevel("test", "debug"))
+	require.Nil(t, ModuleLevels())
+}
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := ParseModuleLevels("gnet=debug, pex=WARNING")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"gnet": "debug", "pex": "WARNING"}, levels)
+
+	levels, err = ParseModuleLevels("")
+	require.NoError(t, err)
+	require.Empty(t, levels)
+
+	_, err = ParseModuleLevels("gnet")
+	require.Error(t, err)
+	_, err = ParseModuleLevels("gnet=loud")
+	require.Error(t, err)
+}
+
+func TestDefaultBackendFields(t *testing.T) {
+	var buf bytes.Buffer
+	useDefaultBackend(&buf)
+	defer useDefaultBackend(os.Stderr)
+
+	log := MustGetLogger("test")
+	log.Info("Connected to %s", "1.2.3.4:6000", F("peer", "1.2.3.4:6000"))
+
+	require.NotContains(t, buf.String(), "%!(EXTRA")
+	require.True(t, strings.HasSuffix(buf.String(), " Connected to 1.2.3.4:6000 peer=1.2.3.4:6000\n"), buf.String())
+	require.Equal(t, ErrNotInitialized, SetModuleLevel("test", "debug"))
+}
@@ -0,0 +1,190 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// rename is replaced by tests
+var rename = os.Rename
+
+// RotatingFile is a log file that is rotated once it would grow over MaxSize
+// bytes or once it is older than MaxAge.  The rotated file is renamed with
+// the time of the rotation, e.g. shellcoin-2017-03-20T10-04-05.000.log, and
+// only the MaxBackups newest rotated files are kept.
+type RotatingFile struct {
+	// Path of the current log file
+	Path string
+	// Size in bytes the file is rotated at, 0 disables rotation by size
+	MaxSize int64
+	// Age the file is rotated at, 0 disables rotation by age
+	MaxAge time.Duration
+	// Number of rotated files to keep, 0 keeps all of them
+	MaxBackups int
+
+	mu      sync.Mutex
+	f       *os.File
+	size    int64
+	created time.Time
+}
+
+// OpenRotatingFile opens or creates the log file at path.  An existing file
+// is appended to, its age is counted from its last modification.
+func OpenRotatingFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*RotatingFile, error) {
+	rf := &RotatingFile{
+		Path:       path,
+		MaxSize:    maxSize,
+		MaxAge:     maxAge,
+		MaxBackups: maxBackups,
+	}
+
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+
+	return rf, nil
+}
+
+func (rf *RotatingFile) open() error {
+	f, err := os.OpenFile(rf.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	rf.f = f
+	rf.size = fi.Size()
+	rf.created = time.Now()
+	if rf.size > 0 {
+		rf.created = fi.ModTime()
+	}
+
+	return nil
+}
+
+// Write writes p to the log file, rotating it first if needed
+func (rf *RotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.f == nil {
+		return 0, os.ErrClosed
+	}
+
+	if rf.size > 0 && rf.shouldRotate(int64(len(p))) {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rf.f.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+func (rf *RotatingFile) shouldRotate(n int64) bool {
+	if rf.MaxSize > 0 && rf.size+n > rf.MaxSize {
+		return true
+	}
+	return rf.MaxAge > 0 && time.Since(rf.created) >= rf.MaxAge
+}
+
+// Rotate renames the current log file and starts a new one
+func (rf *RotatingFile) Rotate() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.f == nil {
+		return os.ErrClosed
+	}
+
+	return rf.rotate()
+}
+
+func (rf *RotatingFile) rotate() error {
+	if err := rf.f.Close(); err != nil {
+		return err
+	}
+	rf.f = nil
+
+	if err := rename(rf.Path, rf.backupName(time.Now())); err != nil {
+		// keep appending to the current file, Write would fail otherwise
+		if err := rf.open(); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if err := rf.open(); err != nil {
+		return err
+	}
+
+	return rf.removeOldBackups()
+}
+
+func (rf *RotatingFile) backupName(t time.Time) string {
+	ext := filepath.Ext(rf.Path)
+	base := strings.TrimSuffix(rf.Path, ext)
+	return base + "-" + t.Format(backupTimeFormat) + ext
+}
+
+// removeOldBackups removes the oldest rotated files over MaxBackups
+func (rf *RotatingFile) removeOldBackups() error {
+	if rf.MaxBackups <= 0 {
+		return nil
+	}
+
+	ext := filepath.Ext(rf.Path)
+	base := strings.TrimSuffix(rf.Path, ext)
+	backups, err := filepath.Glob(base + "-*" + ext)
+	if err != nil {
+		return err
+	}
+
+	var rotated []string
+	for _, b := range backups {
+		ts := strings.TrimSuffix(strings.TrimPrefix(b, base+"-"), ext)
+		if _, err := time.Parse(backupTimeFormat, ts); err == nil {
+			rotated = append(rotated, b)
+		}
+	}
+
+	if len(rotated) <= rf.MaxBackups {
+		return nil
+	}
+
+	// the time format sorts by time
+	sort.Strings(rotated)
+	for _, b := range rotated[:len(rotated)-rf.MaxBackups] {
+		if err := os.Remove(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close closes the log file
+func (rf *RotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.f == nil {
+		return nil
+	}
+
+	err := rf.f.Close()
+	rf.f = nil
+	return err
+}
@@ -0,0 +1,113 @@
+package logging
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func listDir(t *testing.T, dir string) []string {
+	fis, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	return names
+}
+
+func TestRotatingFileSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "shellcoin.log")
+	rf, err := OpenRotatingFile(path, 10, 0, 2)
+	require.NoError(t, err)
+	defer rf.Close()
+
+	for _, s := range []string{"aaaaaa\n", "bbbbbb\n", "cccccc\n", "dddddd\n"} {
+		_, err := rf.Write([]byte(s))
+		require.NoError(t, err)
+		// backups are named by millisecond
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	d, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "dddddd\n", string(d))
+
+	// "aaaaaa" was removed, only two backups are kept
+	names := listDir(t, dir)
+	require.Len(t, names, 3)
+	d, err = ioutil.ReadFile(filepath.Join(dir, names[0]))
+	require.NoError(t, err)
+	require.Equal(t, "bbbbbb\n", string(d))
+	require.Equal(t, "shellcoin.log", names[2])
+}
+
+func TestRotatingFileAge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "shellcoin.log")
+	require.NoError(t, ioutil.WriteFile(path, []byte("old\n"), 0644))
+	old := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+
+	rf, err := OpenRotatingFile(path, 0, time.Hour, 0)
+	require.NoError(t, err)
+	defer rf.Close()
+
+	_, err = rf.Write([]byte("new\n"))
+	require.NoError(t, err)
+	_, err = rf.Write([]byte("newer\n"))
+	require.NoError(t, err)
+
+	d, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "new\nnewer\n", string(d))
+	require.Len(t, listDir(t, dir), 2)
+
+	require.NoError(t, rf.Close())
+	_, err = rf.Write([]byte("closed\n"))
+	require.Equal(t, os.ErrClosed, err)
+}
+
+func TestRotatingFileRenameFails(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	renameErr := errors.New("rename failed")
+	rename = func(string, string) error {
+		return renameErr
+	}
+	defer func() {
+		rename = os.Rename
+	}()
+
+	path := filepath.Join(dir, "shellcoin.log")
+	rf, err := OpenRotatingFile(path, 10, 0, 2)
+	require.NoError(t, err)
+	defer rf.Close()
+
+	_, err = rf.Write([]byte("aaaaaa\n"))
+	require.NoError(t, err)
+	require.Equal(t, renameErr, rf.Rotate())
+
+	// the file is still open and appended to
+	_, err = rf.Write([]byte("b\n"))
+	require.NoError(t, err)
+
+	d, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "aaaaaa\nb\n", string(d))
+	require.Equal(t, []string{"shellcoin.log"}, listDir(t, dir))
+}
@@ -0,0 +1,448 @@
+// Package metrics collects counters, gauges and histograms and serves them in
+// the Prometheus text exposition format.
+//
+// Metrics are usually declared as package variables, which registers them in
+// DefaultRegistry:
+//
+//	var blocksExecuted = metrics.NewCounter("shellcoin_blocks_executed_total", "Number of blocks executed")
+//
+// Values that are expensive to keep up to date, such as the size of a
+// database, are set by a hook registered with OnCollect, which runs before the
+// metrics are written.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skycoin/skycoin/src/util/logging"
+)
+
+var logger = logging.MustGetLogger("metrics")
+
+// ContentType is the content type of the text exposition format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefBuckets are the default histogram buckets, in seconds, suitable for
+// request and processing latencies
+var DefBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// DefaultRegistry is the registry the New* functions register in
+var DefaultRegistry = NewRegistry()
+
+const (
+	kindCounter   = "counter"
+	kindGauge     = "gauge"
+	kindHistogram = "histogram"
+)
+
+// Registry holds metric families and writes them in the text exposition format
+type Registry struct {
+	lk       sync.Mutex
+	families map[string]*family
+	hooks    []func()
+}
+
+// NewRegistry creates Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		families: make(map[string]*family),
+	}
+}
+
+// OnCollect registers f to be called before the metrics are written
+func (r *Registry) OnCollect(f func()) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	r.hooks = append(r.hooks, f)
+}
+
+// OnCollect registers f in DefaultRegistry
+func OnCollect(f func()) {
+	DefaultRegistry.OnCollect(f)
+}
+
+// register adds a family, panics if the name is invalid or already registered
+func (r *Registry) register(f *family) *family {
+	if !validName(f.name) {
+		panic(fmt.Sprintf("metrics: invalid metric name %q", f.name))
+	}
+	for _, l := range f.labels {
+		if !validName(l) || l == "le" {
+			panic(fmt.Sprintf("metrics: invalid label name %q", l))
+		}
+	}
+
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	if _, ok := r.families[f.name]; ok {
+		panic(fmt.Sprintf("metrics: %s already registered", f.name))
+	}
+	r.families[f.name] = f
+	return f
+}
+
+// WriteText runs the collect hooks and writes all metrics in the text
+// exposition format, sorted by name
+func (r *Registry) WriteText(w io.Writer) error {
+	r.lk.Lock()
+	hooks := append([]func(){}, r.hooks...)
+	families := make([]*family, 0, len(r.families))
+	for _, f := range r.families {
+		families = append(families, f)
+	}
+	r.lk.Unlock()
+
+	for _, h := range hooks {
+		h()
+	}
+
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].name < families[j].name
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		f.write(bw)
+	}
+	return bw.Flush()
+}
+
+// ServeHTTP implements http.Handler
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	if err := r.WriteText(w); err != nil {
+		logger.Error("Write metrics failed: %v", err)
+	}
+}
+
+// Handler returns the http.Handler serving DefaultRegistry
+func Handler() http.Handler {
+	return DefaultRegistry
+}
+
+// family is a metric and its children, one per combination of label values
+type family struct {
+	name    string
+	help    string
+	kind    string
+	labels  []string
+	buckets []float64
+
+	lk       sync.Mutex
+	children map[string]*child
+}
+
+// child holds the values of one combination of label values
+type child struct {
+	labelValues []string
+
+	lk sync.Mutex
+	// value of counters and gauges, sum of histograms
+	value float64
+	// histogram bucket counts, not cumulative
+	counts []uint64
+	count  uint64
+}
+
+func newFamily(name, help, kind string, buckets []float64, labels []string) *family {
+	return &family{
+		name:     name,
+		help:     help,
+		kind:     kind,
+		labels:   labels,
+		buckets:  buckets,
+		children: make(map[string]*child),
+	}
+}
+
+func (f *family) with(values []string) *child {
+	if len(values) != len(f.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", f.name, len(f.labels), len(values)))
+	}
+
+	key := strings.Join(values, "\xff")
+	f.lk.Lock()
+	defer f.lk.Unlock()
+	c, ok := f.children[key]
+	if !ok {
+		c = &child{
+			labelValues: append([]string(nil), values...),
+			counts:      make([]uint64, len(f.buckets)),
+		}
+		f.children[key] = c
+	}
+	return c
+}
+
+func (f *family) write(w *bufio.Writer) {
+	f.lk.Lock()
+	children := make([]*child, 0, len(f.children))
+	for _, c := range f.children {
+		children = append(children, c)
+	}
+	f.lk.Unlock()
+
+	if len(children) == 0 {
+		return
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		a, b := children[i].labelValues, children[j].labelValues
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+
+	fmt.Fprintf(w, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.kind)
+	for _, c := range children {
+		c.lk.Lock()
+		switch f.kind {
+		case kindHistogram:
+			var cumulative uint64
+			for i, ub := range f.buckets {
+				cumulative += c.counts[i]
+				writeSample(w, f.name+"_bucket", f.labels, c.labelValues, "le", formatFloat(ub), float64(cumulative))
+			}
+			writeSample(w, f.name+"_bucket", f.labels, c.labelValues, "le", "+Inf", float64(c.count))
+			writeSample(w, f.name+"_sum", f.labels, c.labelValues, "", "", c.value)
+			writeSample(w, f.name+"_count", f.labels, c.labelValues, "", "", float64(c.count))
+		default:
+			writeSample(w, f.name, f.labels, c.labelValues, "", "", c.value)
+		}
+		c.lk.Unlock()
+	}
+}
+
+func writeSample(w *bufio.Writer, name string, labels, values []string, extraLabel, extraValue string, v float64) {
+	w.WriteString(name)
+	if len(labels) != 0 || extraLabel != "" {
+		w.WriteByte('{')
+		for i, l := range labels {
+			if i != 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", l, escapeLabelValue(values[i]))
+		}
+		if extraLabel != "" {
+			if len(labels) != 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", extraLabel, extraValue)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeLabelValue(s string) string {
+	return labelValueEscaper.Replace(s)
+}
+
+// validName reports whether s is a valid metric or label name
+func validName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Counter is a value that only goes up
+type Counter struct {
+	c *child
+}
+
+// Inc adds 1 to the counter
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add adds v to the counter, v must not be negative
+func (c *Counter) Add(v float64) {
+	if v < 0 {
+		panic("metrics: counter cannot decrease")
+	}
+	c.c.lk.Lock()
+	c.c.value += v
+	c.c.lk.Unlock()
+}
+
+// Gauge is a value that can go up and down
+type Gauge struct {
+	c *child
+}
+
+// Set sets the gauge to v
+func (g *Gauge) Set(v float64) {
+	g.c.lk.Lock()
+	g.c.value = v
+	g.c.lk.Unlock()
+}
+
+// Add adds v to the gauge
+func (g *Gauge) Add(v float64) {
+	g.c.lk.Lock()
+	g.c.value += v
+	g.c.lk.Unlock()
+}
+
+// Histogram counts observations in buckets
+type Histogram struct {
+	c       *child
+	buckets []float64
+}
+
+// Observe adds an observation
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.c.lk.Lock()
+	defer h.c.lk.Unlock()
+	if i < len(h.buckets) {
+		h.c.counts[i]++
+	}
+	h.c.count++
+	h.c.value += v
+}
+
+// ObserveSince observes the seconds elapsed since start
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// CounterVec is a counter partitioned by labels
+type CounterVec struct {
+	f *family
+}
+
+// With returns the counter of the label values, given in the order of the label names
+func (v *CounterVec) With(values ...string) *Counter {
+	return &Counter{c: v.f.with(values)}
+}
+
+// GaugeVec is a gauge partitioned by labels
+type GaugeVec struct {
+	f *family
+}
+
+// With returns the gauge of the label values, given in the order of the label names
+func (v *GaugeVec) With(values ...string) *Gauge {
+	return &Gauge{c: v.f.with(values)}
+}
+
+// HistogramVec is a histogram partitioned by labels
+type HistogramVec struct {
+	f *family
+}
+
+// With returns the histogram of the label values, given in the order of the label names
+func (v *HistogramVec) With(values ...string) *Histogram {
+	return &Histogram{c: v.f.with(values), buckets: v.f.buckets}
+}
+
+// NewCounter creates a Counter in r
+func (r *Registry) NewCounter(name, help string) *Counter {
+	return r.NewCounterVec(name, help).With()
+}
+
+// NewCounterVec creates a CounterVec in r
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{f: r.register(newFamily(name, help, kindCounter, nil, labels))}
+}
+
+// NewGauge creates a Gauge in r
+func (r *Registry) NewGauge(name, help string) *Gauge {
+	return r.NewGaugeVec(name, help).With()
+}
+
+// NewGaugeVec creates a GaugeVec in r
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{f: r.register(newFamily(name, help, kindGauge, nil, labels))}
+}
+
+// NewHistogram creates a Histogram in r, DefBuckets are used if buckets is nil
+func (r *Registry) NewHistogram(name, help string, buckets []float64) *Histogram {
+	return r.NewHistogramVec(name, help, buckets).With()
+}
+
+// NewHistogramVec creates a HistogramVec in r, DefBuckets are used if buckets is nil
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefBuckets
+	}
+	if !sort.Float64sAreSorted(buckets) {
+		panic(fmt.Sprintf("metrics: %s buckets are not sorted", name))
+	}
+	return &HistogramVec{f: r.register(newFamily(name, help, kindHistogram, buckets, labels))}
+}
+
+// NewCounter creates a Counter in DefaultRegistry
+func NewCounter(name, help string) *Counter {
+	return DefaultRegistry.NewCounter(name, help)
+}
+
+// NewCounterVec creates a CounterVec in DefaultRegistry
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return DefaultRegistry.NewCounterVec(name, help, labels...)
+}
+
+// NewGauge creates a Gauge in DefaultRegistry
+func NewGauge(name, help string) *Gauge {
+	return DefaultRegistry.NewGauge(name, help)
+}
+
+// NewGaugeVec creates a GaugeVec in DefaultRegistry
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return DefaultRegistry.NewGaugeVec(name, help, labels...)
+}
+
+// NewHistogram creates a Histogram in DefaultRegistry
+func NewHistogram(name, help string, buckets []float64) *Histogram {
+	return DefaultRegistry.NewHistogram(name, help, buckets)
+}
+
+// NewHistogramVec creates a HistogramVec in DefaultRegistry
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	return DefaultRegistry.NewHistogramVec(name, help, buckets, labels...)
+}
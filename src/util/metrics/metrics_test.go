@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_events_total", "Number of events")
+	g := r.NewGaugeVec("test_peers", "Number of peers", "direction")
+	h := r.NewHistogram("test_latency_seconds", "Latency", []float64{0.1, 1})
+	// families without children are not written
+	r.NewCounterVec("test_unused_total", "Unused", "kind")
+
+	c.Inc()
+	c.Add(2)
+	g.With("outgoing").Set(3)
+	g.With("incoming").Set(1)
+	g.With("incoming").Add(1)
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(5)
+
+	collected := 0
+	r.OnCollect(func() {
+		collected++
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteText(&buf))
+	require.Equal(t, 1, collected)
+	require.Equal(t, `# HELP test_events_total Number of events
+# TYPE test_events_total counter
+test_events_total 3
+# HELP test_latency_seconds Latency
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{le="0.1"} 1
+test_latency_seconds_bucket{le="1"} 2
+test_latency_seconds_bucket{le="+Inf"} 3
+test_latency_seconds_sum 5.55
+test_latency_seconds_count 3
+# HELP test_peers Number of peers
+# TYPE test_peers gauge
+test_peers{direction="incoming"} 2
+test_peers{direction="outgoing"} 3
+`, buf.String())
+}
+
+func TestHistogramVecLabels(t *testing.T) {
+	r := NewRegistry()
+	h := r.NewHistogramVec("test_request_seconds", "Request latency", []float64{1}, "method", "status")
+	h.With("get_status", "200").Observe(0.5)
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteText(&buf))
+	require.Equal(t, `# HELP test_request_seconds Request latency
+# TYPE test_request_seconds histogram
+test_request_seconds_bucket{method="get_status",status="200",le="1"} 1
+test_request_seconds_bucket{method="get_status",status="200",le="+Inf"} 1
+test_request_seconds_sum{method="get_status",status="200"} 0.5
+test_request_seconds_count{method="get_status",status="200"} 1
+`, buf.String())
+}
+
+func TestEscaping(t *testing.T) {
+	r := NewRegistry()
+	r.NewGaugeVec("test_gauge", "Help with \\ and\nnewline", "path").With("a\"b\\c\nd").Set(1)
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteText(&buf))
+	require.Equal(t, `# HELP test_gauge Help with \\ and\nnewline
+# TYPE test_gauge gauge
+test_gauge{path="a\"b\\c\nd"} 1
+`, buf.String())
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("test_total", "")
+	require.Panics(t, func() { r.NewGauge("test_total", "") })
+	require.Panics(t, func() { r.NewGauge("1test", "") })
+	require.Panics(t, func() { r.NewGaugeVec("test_gauge", "", "bad-label") })
+	require.Panics(t, func() { r.NewHistogramVec("test_hist", "", nil, "le") })
+	require.Panics(t, func() { r.NewHistogram("test_unsorted", "", []float64{2, 1}) })
+	require.Panics(t, func() { r.NewCounterVec("test_vec", "", "a").With("x", "y") })
+	require.Panics(t, func() { r.NewCounter("test_neg", "").Add(-1) })
+}
+
+func TestServeHTTP(t *testing.T) {
+	r := NewRegistry()
+	r.NewGauge("test_gauge", "A gauge").Set(1.5)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, 200, w.Code)
+	require.Equal(t, ContentType, w.Header().Get("Content-Type"))
+	require.Contains(t, w.Body.String(), "test_gauge 1.5\n")
+}
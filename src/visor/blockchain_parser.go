@@ -0,0 +1,174 @@
+package visor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+)
+
+// ParserOption option type which will be used when creating parser instance
+type ParserOption func(*BlockchainParser)
+
+// BlockchainParser parses the blockchain and stores the data into historydb.
+type BlockchainParser struct {
+	historyDB *historydb.HistoryDB
+	blkC      chan coin.Block
+	closing   chan chan struct{}
+	done      chan struct{}
+	bc        *Blockchain
+
+	isStart bool
+
+	lsMu      sync.Mutex
+	listeners []*BlockListener
+}
+
+// NewBlockchainParser create and init the parser instance.
+func NewBlockchainParser(hisDB *historydb.HistoryDB, bc *Blockchain, ops ...ParserOption) *BlockchainParser {
+	bp := &BlockchainParser{
+		bc:        bc,
+		historyDB: hisDB,
+		closing:   make(chan chan struct{}),
+		done:      make(chan struct{}),
+		blkC:      make(chan coin.Block, 10),
+	}
+
+	for _, op := range ops {
+		op(bp)
+	}
+
+	return bp
+}
+
+// FeedBlock feeds block to the parser
+func (bcp *BlockchainParser) FeedBlock(b coin.Block) {
+	bcp.blkC <- b
+}
+
+// BindListener registers a listener that is invoked with each block after the
+// block is stored in the history db.  It is invoked on the parser goroutine,
+// so it must not block.  The returned function unregisters it.
+func (bcp *BlockchainParser) BindListener(ls BlockListener) func() {
+	bcp.lsMu.Lock()
+	defer bcp.lsMu.Unlock()
+	l := &ls
+	bcp.listeners = append(bcp.listeners, l)
+	return func() {
+		bcp.lsMu.Lock()
+		defer bcp.lsMu.Unlock()
+		for i, v := range bcp.listeners {
+			if v == l {
+				bcp.listeners = append(bcp.listeners[:i:i], bcp.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// parse stores the block in the history db and notifies the listeners
+func (bcp *BlockchainParser) parse(b *coin.Block) error {
+	if err := bcp.historyDB.ParseBlock(b); err != nil {
+		return err
+	}
+
+	bcp.lsMu.Lock()
+	defer bcp.lsMu.Unlock()
+	for _, l := range bcp.listeners {
+		(*l)(*b)
+	}
+	return nil
+}
+
+// Run starts blockchain parser
+func (bcp *BlockchainParser) Run() error {
+	logger.Info("Blockchain parser start")
+	defer logger.Info("Blockchain parser closed")
+	defer close(bcp.done)
+
+	if err := bcp.historyDB.ResetIfNeed(); err != nil {
+		return err
+	}
+
+	// parse to the blockchain head
+	headSeq := bcp.bc.HeadSeq()
+	if err := bcp.parseTo(headSeq); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case cc := <-bcp.closing:
+			err := bcp.parseQueued()
+			cc <- struct{}{}
+			return err
+		case b := <-bcp.blkC:
+			if err := bcp.parse(&b); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseQueued parses the blocks that were fed but not parsed yet
+func (bcp *BlockchainParser) parseQueued() error {
+	for {
+		select {
+		case b := <-bcp.blkC:
+			if err := bcp.parse(&b); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// Stop closes the block parsing process.  The block being parsed and the
+// blocks already fed are parsed before the parser stops.  Returns an error if
+// the parser doesn't stop within timeout.
+func (bcp *BlockchainParser) Stop(timeout time.Duration) error {
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+
+	cc := make(chan struct{}, 1)
+	select {
+	case bcp.closing <- cc:
+	case <-bcp.done:
+		// the parser has already stopped
+		return nil
+	case <-t.C:
+		return errors.New("timeout waiting for the blockchain parser to stop")
+	}
+
+	select {
+	case <-cc:
+		return nil
+	case <-t.C:
+		return errors.New("timeout waiting for the blockchain parser to stop")
+	}
+}
+
+func (bcp *BlockchainParser) parseTo(bcHeight uint64) error {
+	parsedHeight := bcp.historyDB.ParsedHeight()
+
+	for i := int64(0); i < int64(bcHeight)-parsedHeight; i++ {
+		b, err := bcp.bc.GetBlockBySeq(uint64(parsedHeight + i + 1))
+		if err != nil {
+			return err
+		}
+
+		if b == nil {
+			return fmt.Errorf("no block exist in depth:%d", parsedHeight+i+1)
+		}
+
+		if err := bcp.parse(&b.Block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
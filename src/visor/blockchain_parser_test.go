@@ -0,0 +1,58 @@
+package visor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skycoin/skycoin/src/testutil"
+	"github.com/skycoin/skycoin/src/visor/historydb"
+)
+
+func TestBlockchainParserStopParsesQueuedBlocks(t *testing.T) {
+	db, close := testutil.PrepareDB(t)
+	defer close()
+
+	bc, err := NewBlockchain(db, genPublic)
+	require.NoError(t, err)
+	gb := addGenesisBlock(t, bc)
+
+	history, err := historydb.New(db)
+	require.NoError(t, err)
+
+	bp := NewBlockchainParser(history, bc)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- bp.Run()
+	}()
+
+	preBlock := gb.Block
+	for i := uint64(1); i <= 3; i++ {
+		b := makeBlock(t, preBlock, _genTime+i*100)
+		bp.FeedBlock(*b)
+		preBlock = *b
+	}
+
+	require.NoError(t, bp.Stop(time.Second))
+	require.NoError(t, <-errC)
+	require.Equal(t, int64(3), history.ParsedHeight())
+
+	// stopping a stopped parser returns right away
+	require.NoError(t, bp.Stop(time.Second))
+}
+
+func TestBlockchainParserStopTimeout(t *testing.T) {
+	db, close := testutil.PrepareDB(t)
+	defer close()
+
+	bc, err := NewBlockchain(db, genPublic)
+	require.NoError(t, err)
+
+	history, err := historydb.New(db)
+	require.NoError(t, err)
+
+	// the parser is not running
+	bp := NewBlockchainParser(history, bc)
+	testutil.RequireError(t, bp.Stop(10*time.Millisecond), "timeout waiting for the blockchain parser to stop")
+}
@@ -0,0 +1,13 @@
+package visor
+
+import (
+	"github.com/skycoin/skycoin/src/util/metrics"
+)
+
+var (
+	blockExecutionSeconds = metrics.NewHistogram("shellcoin_block_execution_seconds",
+		"Time to verify and execute a block", nil)
+	signatureVerificationSeconds = metrics.NewHistogramVec("shellcoin_signature_verification_seconds",
+		"Time to verify the signature of a block or the signatures of a transaction's inputs",
+		[]float64{.00005, .0001, .00025, .0005, .001, .0025, .005, .01, .025, .05}, "kind")
+)
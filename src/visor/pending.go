@@ -0,0 +1,137 @@
+package visor
+
+import (
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/wallet"
+)
+
+// PendingOutput is an output of an address spent or created by an
+// unconfirmed txn
+type PendingOutput struct {
+	ReadableOutput
+	// Txid of the unconfirmed txn spending or creating the output
+	Txid string `json:"txid"`
+}
+
+// AddressPending are the outputs of an address that the unconfirmed txns
+// spend and create, and the coins and hours they move
+type AddressPending struct {
+	Address  string          `json:"address"`
+	Spends   []PendingOutput `json:"spends"`
+	Receipts []PendingOutput `json:"receipts"`
+	Outgoing wallet.Balance  `json:"outgoing"`
+	Incoming wallet.Balance  `json:"incoming"`
+}
+
+// PendingTxns are the pending spends and receipts of addresses, and the
+// unconfirmed txns that cause them
+type PendingTxns struct {
+	Addresses    []AddressPending    `json:"addresses"`
+	Transactions []TransactionResult `json:"transactions"`
+}
+
+// MaxPendingAddresses limits the number of addresses of the API queries of
+// GetPendingOfAddresses
+const MaxPendingAddresses = 1000
+
+// GetPendingOfAddresses returns the outputs of addrs spent and created by the
+// unconfirmed txns.  Unlike the wallets, the addresses don't need to be
+// owned by the node.
+func (vs *Visor) GetPendingOfAddresses(addrs []cipher.Address) (*PendingTxns, error) {
+	head, err := vs.Blockchain.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	unspent := vs.Blockchain.Unspent()
+	spends := make(map[cipher.Address][]PendingOutput, len(addrs))
+	receipts := make(map[cipher.Address][]PendingOutput, len(addrs))
+	spendUxs := make(coin.AddressUxOuts, len(addrs))
+	recvUxs := make(coin.AddressUxOuts, len(addrs))
+	for _, a := range addrs {
+		spends[a] = []PendingOutput{}
+		receipts[a] = []PendingOutput{}
+	}
+
+	pending := PendingTxns{
+		Addresses:    []AddressPending{},
+		Transactions: []TransactionResult{},
+	}
+	for _, tx := range vs.Unconfirmed.GetTxns(All) {
+		txid := tx.Hash().Hex()
+		related := false
+
+		for _, h := range tx.Txn.In {
+			// an input missing from the unspent pool was spent by a block
+			// since, the txn can't be confirmed anymore
+			ux, ok := unspent.Get(h)
+			if !ok {
+				continue
+			}
+			if _, ok := spends[ux.Body.Address]; !ok {
+				continue
+			}
+
+			out, err := NewReadableOutput(ux)
+			if err != nil {
+				return nil, err
+			}
+			spends[ux.Body.Address] = append(spends[ux.Body.Address], PendingOutput{out, txid})
+			spendUxs[ux.Body.Address] = append(spendUxs[ux.Body.Address], ux)
+			related = true
+		}
+
+		for i, o := range tx.Txn.Out {
+			if _, ok := receipts[o.Address]; !ok {
+				continue
+			}
+
+			ux, err := coin.CreateUnspent(head.Block.Head, tx.Txn, i)
+			if err != nil {
+				return nil, err
+			}
+			out, err := NewReadableOutput(ux)
+			if err != nil {
+				return nil, err
+			}
+			receipts[o.Address] = append(receipts[o.Address], PendingOutput{out, txid})
+			recvUxs[o.Address] = append(recvUxs[o.Address], ux)
+			related = true
+		}
+
+		if !related {
+			continue
+		}
+
+		rtx, err := NewTransactionResult(&Transaction{
+			Txn:    tx.Txn,
+			Status: vs.unconfirmedStatus(&tx),
+			Time:   uint64(nanoToTime(tx.Received).Unix()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		pending.Transactions = append(pending.Transactions, *rtx)
+	}
+
+	seen := make(map[cipher.Address]struct{}, len(addrs))
+	for _, a := range addrs {
+		if _, ok := seen[a]; ok {
+			continue
+		}
+		seen[a] = struct{}{}
+
+		outCoins, outHours := vs.AddressBalance(coin.AddressUxOuts{a: spendUxs[a]})
+		inCoins, inHours := vs.AddressBalance(coin.AddressUxOuts{a: recvUxs[a]})
+		pending.Addresses = append(pending.Addresses, AddressPending{
+			Address:  a.String(),
+			Spends:   spends[a],
+			Receipts: receipts[a],
+			Outgoing: wallet.NewBalance(outCoins, outHours),
+			Incoming: wallet.NewBalance(inCoins, inHours),
+		})
+	}
+
+	return &pending, nil
+}
@@ -0,0 +1,298 @@
+package visor
+
+// Lifecycle of the transactions that go through the unconfirmed pool
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/boltdb/bolt"
+
+	"github.com/skycoin/skycoin/src/cipher"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+	"github.com/skycoin/skycoin/src/coin"
+	"github.com/skycoin/skycoin/src/util/logging"
+	"github.com/skycoin/skycoin/src/util/utc"
+	"github.com/skycoin/skycoin/src/visor/bucket"
+)
+
+// UnconfirmedTxnResult is an unconfirmed txn with its status
+type UnconfirmedTxnResult struct {
+	UnconfirmedTxn
+	Status TransactionStatus `json:"status"`
+}
+
+// txnRecord is what the visor remembers of a txn of the unconfirmed pool,
+// until UnconfirmedMaxAge after the txn left the pool.  The times are unix
+// nanoseconds by the clock of the visor, which AdvanceTime moves forward.
+type txnRecord struct {
+	FirstSeen int64
+	// last time the txn was received
+	Received  int64
+	Announced int64
+	Evicted   int64
+	// Txn is kept once the txn is evicted, the pool doesn't have it anymore
+	Txn coin.Transaction
+}
+
+// lastActive returns the last time something happened to the txn
+func (r txnRecord) lastActive() int64 {
+	t := r.Received
+	if r.Announced > t {
+		t = r.Announced
+	}
+	if r.Evicted > t {
+		t = r.Evicted
+	}
+	return t
+}
+
+// txnRecords bucket, a nil txnRecords records nothing for the visors not
+// created by NewVisor
+type txnRecords struct {
+	db  *bolt.DB
+	bkt *bucket.Bucket
+}
+
+func newTxnRecords(db *bolt.DB) (*txnRecords, error) {
+	bkt, err := bucket.New([]byte("txn_records"), db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txnRecords{db: db, bkt: bkt}, nil
+}
+
+func (tr *txnRecords) get(h cipher.SHA256) (*txnRecord, bool) {
+	if tr == nil {
+		return nil, false
+	}
+
+	v := tr.bkt.Get([]byte(h.Hex()))
+	if v == nil {
+		return nil, false
+	}
+
+	var r txnRecord
+	if err := encoder.DeserializeRaw(v, &r); err != nil {
+		logger.Error("Decode txn record %s failed: %v", h.Hex(), err)
+		return nil, false
+	}
+	return &r, true
+}
+
+func (tr *txnRecords) put(h cipher.SHA256, r *txnRecord) error {
+	if tr == nil {
+		return nil
+	}
+	return tr.bkt.Put([]byte(h.Hex()), encoder.Serialize(*r))
+}
+
+// putAll writes the records in one db transaction
+func (tr *txnRecords) putAll(records map[cipher.SHA256]*txnRecord) error {
+	if tr == nil || len(records) == 0 {
+		return nil
+	}
+	return tr.db.Update(func(tx *bolt.Tx) error {
+		for h, r := range records {
+			if err := tr.bkt.PutWithTx(tx, []byte(h.Hex()), encoder.Serialize(*r)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// deleteAll deletes the records of hashes in one db transaction
+func (tr *txnRecords) deleteAll(hashes []cipher.SHA256) error {
+	if tr == nil || len(hashes) == 0 {
+		return nil
+	}
+	return tr.db.Update(func(tx *bolt.Tx) error {
+		for _, h := range hashes {
+			if err := tr.bkt.DeleteWithTx(tx, []byte(h.Hex())); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (tr *txnRecords) forEach(f func(h cipher.SHA256, r *txnRecord) error) error {
+	if tr == nil {
+		return nil
+	}
+	return tr.bkt.ForEach(func(k, v []byte) error {
+		h, err := cipher.SHA256FromHex(string(k))
+		if err != nil {
+			return err
+		}
+
+		var r txnRecord
+		if err := encoder.DeserializeRaw(v, &r); err != nil {
+			return fmt.Errorf("decode txn record %s failed: %v", k, err)
+		}
+		return f(h, &r)
+	})
+}
+
+// record returns the record of txn h, a new one made from the unconfirmed
+// pool if there is none
+func (vs *Visor) record(h cipher.SHA256) *txnRecord {
+	if r, ok := vs.txnRecords.get(h); ok {
+		return r
+	}
+
+	var r txnRecord
+	if tx, ok := vs.Unconfirmed.Get(h); ok {
+		r.FirstSeen = tx.Received
+		r.Received = tx.Received
+		r.Announced = tx.Announced
+	}
+	return &r
+}
+
+// clock returns t moved forward by AdvanceTime, in unix nanoseconds
+func (vs *Visor) clock(t time.Time) int64 {
+	return t.UnixNano() + atomic.LoadInt64(&vs.timeOffset)*int64(time.Second)
+}
+
+// recordReceived records that the txn h was received and is in the
+// unconfirmed pool, no longer evicted if it came back
+func (vs *Visor) recordReceived(h cipher.SHA256) {
+	now := vs.clock(utc.Now())
+	r := vs.record(h)
+	if r.FirstSeen <= 0 {
+		r.FirstSeen = now
+	}
+	r.Received = now
+	r.Evicted = 0
+	r.Txn = coin.Transaction{}
+	if err := vs.txnRecords.put(h, r); err != nil {
+		logger.Error("Record txn %s failed: %v", h.Hex(), err)
+	}
+}
+
+// SetTxnsAnnounced records that the txns were announced to peers at t
+func (vs *Visor) SetTxnsAnnounced(hashes []cipher.SHA256, t time.Time) {
+	records := make(map[cipher.SHA256]*txnRecord, len(hashes))
+	for _, h := range hashes {
+		vs.Unconfirmed.SetAnnounced(h, t)
+
+		r := vs.record(h)
+		r.Announced = vs.clock(t)
+		records[h] = r
+	}
+
+	if err := vs.txnRecords.putAll(records); err != nil {
+		logger.Error("Record %d announced txns failed: %v", len(hashes), err)
+	}
+}
+
+// EvictUnconfirmed removes the txns of the unconfirmed pool that were not
+// received for UnconfirmedMaxAge, by the clock of the visor.  Their records
+// keep them as evicted for another UnconfirmedMaxAge, the records of the txns
+// that left the pool are forgotten after UnconfirmedMaxAge.  Returns the
+// evicted txns.
+func (vs *Visor) EvictUnconfirmed() []cipher.SHA256 {
+	now := vs.clock(utc.Now())
+	expired := now - int64(vs.Config.UnconfirmedMaxAge)
+
+	var evicted []cipher.SHA256
+	records := make(map[cipher.SHA256]*txnRecord)
+	for _, tx := range vs.Unconfirmed.GetTxns(All) {
+		h := tx.Hash()
+		r := vs.record(h)
+		if r.Received >= expired {
+			continue
+		}
+
+		r.Evicted = now
+		r.Txn = tx.Txn
+		records[h] = r
+		logger.Info("Evicted unconfirmed txn %s", h.Hex(), logging.F("txid", h.Hex()))
+		evicted = append(evicted, h)
+	}
+	if len(evicted) > 0 {
+		if err := vs.txnRecords.putAll(records); err != nil {
+			logger.Error("Record %d evicted txns failed: %v", len(evicted), err)
+		}
+		vs.Unconfirmed.RemoveTransactions(evicted)
+	}
+
+	var inactive []cipher.SHA256
+	if err := vs.txnRecords.forEach(func(h cipher.SHA256, r *txnRecord) error {
+		if r.lastActive() < expired {
+			inactive = append(inactive, h)
+		}
+		return nil
+	}); err != nil {
+		logger.Error("Prune txn records failed: %v", err)
+	}
+
+	// the txns still in the pool are remembered
+	if err := vs.txnRecords.deleteAll(vs.Unconfirmed.FilterKnown(inactive)); err != nil {
+		logger.Error("Delete txn records failed: %v", err)
+	}
+
+	return evicted
+}
+
+// setTimes sets the first seen and last announced times of status from the
+// record of txn h, if any
+func (vs *Visor) setTimes(h cipher.SHA256, status *TransactionStatus) {
+	if r, ok := vs.txnRecords.get(h); ok {
+		status.FirstSeen = nanoToUnix(r.FirstSeen)
+		status.LastAnnounced = nanoToUnix(r.Announced)
+	}
+}
+
+// unconfirmedStatus returns the status of a txn of the unconfirmed pool
+func (vs *Visor) unconfirmedStatus(tx *UnconfirmedTxn) TransactionStatus {
+	r := vs.record(tx.Hash())
+	status := NewUnconfirmedTransactionStatus(IsValid(*tx))
+	status.FirstSeen = nanoToUnix(r.FirstSeen)
+	status.LastAnnounced = nanoToUnix(r.Announced)
+	return status
+}
+
+// getEvictedTransaction returns the txn h if it was evicted from the
+// unconfirmed pool and not forgotten yet
+func (vs *Visor) getEvictedTransaction(h cipher.SHA256) *Transaction {
+	r, ok := vs.txnRecords.get(h)
+	if !ok || r.Evicted == 0 {
+		return nil
+	}
+
+	status := NewEvictedTransactionStatus(nanoToUnix(r.Evicted))
+	status.FirstSeen = nanoToUnix(r.FirstSeen)
+	status.LastAnnounced = nanoToUnix(r.Announced)
+	return &Transaction{
+		Txn:    r.Txn,
+		Status: status,
+		Time:   status.FirstSeen,
+	}
+}
+
+// GetUnconfirmedTxnResults returns the unconfirmed txns that pass filter,
+// with their status
+func (vs *Visor) GetUnconfirmedTxnResults(filter func(UnconfirmedTxn) bool) []UnconfirmedTxnResult {
+	txns := vs.Unconfirmed.GetTxns(filter)
+	rlts := make([]UnconfirmedTxnResult, len(txns))
+	for i := range txns {
+		rlts[i] = UnconfirmedTxnResult{
+			UnconfirmedTxn: txns[i],
+			Status:         vs.unconfirmedStatus(&txns[i]),
+		}
+	}
+	return rlts
+}
+
+// nanoToUnix converts unix nanoseconds to seconds, 0 for unset times
+func nanoToUnix(n int64) uint64 {
+	if n <= 0 {
+		return 0
+	}
+	return uint64(n / int64(time.Second))
+}
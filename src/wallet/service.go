@@ -57,6 +57,18 @@ func NewService(walletDir string, options ...Option) (*Service, error) {
 	return serv, nil
 }
 
+// Flush saves all wallets to the wallet directory
+func (serv *Service) Flush() error {
+	serv.RLock()
+	defer serv.RUnlock()
+
+	errs := serv.wallets.Save(serv.WalletDirectory)
+	for id, err := range errs {
+		return fmt.Errorf("failed to save %d wallets, %s: %v", len(errs), id, err)
+	}
+	return nil
+}
+
 // CreateWallet creates wallet
 func (serv *Service) CreateWallet(wltName string, options ...Option) (Wallet, error) {
 	ops := make([]Option, 0, len(serv.options)+len(options))